@@ -6,11 +6,11 @@ func (conn *ViewSonic) SetMute(mute bool) error {
 	if mute {
 		value = 0x01 // ON
 	}
-	return conn.Write(0x1400, value) // PDF #134, 135
+	return conn.Write(cmdMute.Code, value)
 }
 
 func (conn *ViewSonic) GetMute() (bool, error) {
-	value, err := conn.Read(0x1400) // PDF #136
+	value, err := conn.Read(cmdMute.Code)
 	if err != nil {
 		return false, err
 	}
@@ -19,22 +19,22 @@ func (conn *ViewSonic) GetMute() (bool, error) {
 
 // Volume
 func (conn *ViewSonic) IncreaseVolume() error {
-	return conn.Write(0x1401, 0x00) // PDF #137
+	return conn.Write(cmdVolumeUp.Code, 0x00)
 }
 
 func (conn *ViewSonic) DecreaseVolume() error {
-	return conn.Write(0x1402, 0x00) // PDF #138
+	return conn.Write(cmdVolumeDown.Code, 0x00)
 }
 
 func (conn *ViewSonic) SetVolume(level int8) error {
-	return conn.Write(0x132A, level) // PDF #139
+	return conn.Write(cmdSetVolume.Code, level)
 }
 
 func (conn *ViewSonic) GetVolume() (int8, error) {
-	return conn.Read(0x1403) // PDF #140
+	return conn.Read(cmdGetVolume.Code)
 }
 
 // Audio mode cycle
 func (conn *ViewSonic) CycleAudioMode() error {
-	return conn.Write(0x1335, 0x00) // PDF #225
+	return conn.Write(cmdCycleAudioMode.Code, 0x00)
 }
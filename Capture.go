@@ -0,0 +1,330 @@
+package viewsonic
+
+import (
+	"bufio"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net"
+	"strings"
+	"sync"
+	"time"
+)
+
+// FrameDirection tells whether a frame was sent to or received from the projector.
+type FrameDirection string
+
+const (
+	FrameRequest  FrameDirection = "tx"
+	FrameResponse FrameDirection = "rx"
+)
+
+// Frame is a packet on the wire, including the checksum.
+type Frame struct {
+	Time      time.Time
+	Direction FrameDirection
+	Data      []byte
+}
+
+type frameJSON struct {
+	Time      time.Time      `json:"time"`
+	Direction FrameDirection `json:"dir"`
+	Data      string         `json:"data"`
+}
+
+// MarshalJSON encodes the frame as {"time": "...", "dir": "tx", "data": "07 14 00 05 00 34 00 00 13 01 5F"}.
+func (f Frame) MarshalJSON() ([]byte, error) {
+	return json.Marshal(frameJSON{Time: f.Time, Direction: f.Direction, Data: fmt.Sprintf("% X", f.Data)})
+}
+
+func (f *Frame) UnmarshalJSON(data []byte) error {
+	var v frameJSON
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	b, err := hex.DecodeString(strings.ReplaceAll(v.Data, " ", ""))
+	if err != nil {
+		return fmt.Errorf("frame data: %w", err)
+	}
+	f.Time, f.Direction, f.Data = v.Time, v.Direction, b
+	return nil
+}
+
+func (f Frame) String() string {
+	return fmt.Sprintf("%v %v % X", f.Time.Format("15:04:05.000"), f.Direction, f.Data)
+}
+
+// frameSplitter cuts a byte stream into frames. Like the client it skips bytes that do not
+// start a frame with a valid head and checksum, so noise on the line does not shift the
+// following frames.
+type frameSplitter struct {
+	direction FrameDirection
+	buf       []byte
+}
+
+func (s *frameSplitter) write(p []byte, emit func(Frame)) {
+	heads := responseHeads
+	if s.direction == FrameRequest {
+		heads = requestHeads
+	}
+	s.buf = append(s.buf, p...)
+	for {
+		frame, rest, skipped := splitFrame(s.buf, heads)
+		s.buf = rest
+		if skipped > 0 {
+			log.Printf("Capture skipped %d bytes of %v to resync on a frame head", skipped, s.direction)
+		}
+		if frame == nil {
+			return
+		}
+		emit(Frame{Time: time.Now(), Direction: s.direction, Data: frame})
+	}
+}
+
+// tapConn passes the frames written and read on a connection to record.
+type tapConn struct {
+	net.Conn
+	record func(Frame)
+
+	mutex    sync.Mutex
+	requests frameSplitter
+	replies  frameSplitter
+}
+
+func (c *tapConn) Write(p []byte) (int, error) {
+	n, err := c.Conn.Write(p)
+	c.mutex.Lock()
+	c.requests.write(p[:n], c.record)
+	c.mutex.Unlock()
+	return n, err
+}
+
+func (c *tapConn) Read(p []byte) (int, error) {
+	n, err := c.Conn.Read(p)
+	c.mutex.Lock()
+	c.replies.write(p[:n], c.record)
+	c.mutex.Unlock()
+	return n, err
+}
+
+// TapDialer wraps the connections of d and passes every request and response frame to
+// record, e.g. the Record method of a FrameLog. record must not block.
+func TapDialer(d Dialer, record func(Frame)) Dialer {
+	return DialerFunc(func() (net.Conn, error) {
+		conn, err := d.Dial()
+		if err != nil {
+			return nil, err
+		}
+		return &tapConn{
+			Conn:     conn,
+			record:   record,
+			requests: frameSplitter{direction: FrameRequest},
+			replies:  frameSplitter{direction: FrameResponse},
+		}, nil
+	})
+}
+
+// FrameLog writes frames as JSON lines. It is safe for concurrent use.
+type FrameLog struct {
+	mutex sync.Mutex
+	enc   *json.Encoder
+	err   error
+}
+
+// NewFrameLog creates a log writing to w.
+func NewFrameLog(w io.Writer) *FrameLog {
+	return &FrameLog{enc: json.NewEncoder(w)}
+}
+
+// Record writes the frame. After a write error all frames are dropped, see Err.
+func (l *FrameLog) Record(f Frame) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	if l.err == nil {
+		l.err = l.enc.Encode(f)
+	}
+}
+
+// Err returns the first write error.
+func (l *FrameLog) Err() error {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	return l.err
+}
+
+// ReadFrames reads a JSON lines capture written by a FrameLog.
+func ReadFrames(r io.Reader) ([]Frame, error) {
+	var frames []Frame
+	scanner := bufio.NewScanner(r)
+	for line := 1; scanner.Scan(); line++ {
+		if len(strings.TrimSpace(scanner.Text())) == 0 {
+			continue
+		}
+		var f Frame
+		if err := json.Unmarshal(scanner.Bytes(), &f); err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+		frames = append(frames, f)
+	}
+	return frames, scanner.Err()
+}
+
+// DescribeFrame decodes a frame with the command registry, e.g. "write SourceInput HDMI1".
+// request is the preceding request of a response, it names the value of read responses.
+func DescribeFrame(frame, request []byte) string {
+	if len(frame) < 6 || int(binary.LittleEndian.Uint16(frame[3:5])) != len(frame)-6 {
+		return "invalid frame"
+	}
+	suffix := ""
+	if checkSum(frame[1:len(frame)-1]) != frame[len(frame)-1] {
+		suffix = " (invalid checksum)"
+	}
+	data := frame[5 : len(frame)-1]
+
+	command := func(hi, lo byte) (*Command, string) {
+		code := uint16(hi)<<8 | uint16(lo)
+		if c := LookupCommand(code); c != nil {
+			return c, c.Name
+		}
+		return nil, fmt.Sprintf("0x%04X", code)
+	}
+
+	switch frame[0] {
+	case cmdWrite, cmdWriteKey:
+		if len(data) != 4 {
+			break
+		}
+		c, name := command(data[1], data[2])
+		value := fmt.Sprintf("0x%02X", data[3])
+		if c != nil && len(c.Values) > 0 {
+			value = c.ValueName(int8(data[3]))
+		}
+		verb := "write"
+		if frame[0] == cmdWriteKey {
+			verb = "key"
+		}
+		return fmt.Sprintf("%v %v %v%v", verb, name, value, suffix)
+	case cmdRead:
+		if len(data) != 5 {
+			break
+		}
+		_, name := command(data[3], data[4])
+		return fmt.Sprintf("read %v%v", name, suffix)
+	case cmdWriteResponse:
+		return "ok" + suffix
+	case cmdError:
+		return "error or function disabled" + suffix
+	case cmdReadResponse:
+		value := fmt.Sprintf("% X", data)
+		if len(data) > 2 {
+			value = fmt.Sprintf("% X", data[2:])
+		}
+		if len(request) == 11 && request[0] == cmdRead && len(data) == 3 {
+			if c, _ := command(request[8], request[9]); c != nil && len(c.Values) > 0 {
+				value = c.ValueName(int8(data[2]))
+			}
+		}
+		return fmt.Sprintf("value %v%v", value, suffix)
+	}
+	return fmt.Sprintf("unknown frame 0x%02X%v", frame[0], suffix)
+}
+
+// Replayer answers requests with the responses of a capture, for reproducing a session
+// without the projector.
+type Replayer struct {
+	mutex     sync.Mutex
+	exchanges []exchange
+	next      int
+
+	// Logf receives every unmatched request, nothing is logged if nil.
+	Logf func(format string, args ...any)
+}
+
+// exchange is a recorded request with the responses that followed it.
+type exchange struct {
+	request   []byte
+	responses [][]byte
+	used      bool
+}
+
+// NewReplayer pairs the requests of the frames with the responses that follow them.
+func NewReplayer(frames []Frame) *Replayer {
+	r := &Replayer{}
+	for _, f := range frames {
+		switch {
+		case f.Direction == FrameRequest:
+			r.exchanges = append(r.exchanges, exchange{request: f.Data})
+		case len(r.exchanges) > 0:
+			last := &r.exchanges[len(r.exchanges)-1]
+			last.responses = append(last.responses, f.Data)
+		}
+	}
+	return r
+}
+
+// Respond returns the recorded responses to a request. Requests are matched in the recorded
+// order first, then against any unused and finally any recorded equal request. Unknown
+// requests are answered with the error frame.
+func (r *Replayer) Respond(request []byte) [][]byte {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	match := -1
+	if r.next < len(r.exchanges) && string(r.exchanges[r.next].request) == string(request) {
+		match = r.next
+	}
+	for _, unused := range []bool{true, false} {
+		for i := range r.exchanges {
+			if match < 0 && string(r.exchanges[i].request) == string(request) && (!unused || !r.exchanges[i].used) {
+				match = i
+			}
+		}
+	}
+	if match < 0 {
+		if r.Logf != nil {
+			r.Logf("no recorded response to % X", request)
+		}
+		return [][]byte{{cmdError, 0x14, 0x00, 0x00, 0x00, 0x14}}
+	}
+	r.exchanges[match].used = true
+	r.next = match + 1
+	return r.exchanges[match].responses
+}
+
+// Serve answers the requests read from conn until it is closed.
+func (r *Replayer) Serve(conn net.Conn) error {
+	defer conn.Close()
+	reader := bufio.NewReader(conn)
+	for {
+		head := make([]byte, 5)
+		if _, err := io.ReadFull(reader, head); err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return err
+		}
+		request := make([]byte, 5+int(binary.LittleEndian.Uint16(head[3:5]))+1)
+		copy(request, head)
+		if _, err := io.ReadFull(reader, request[5:]); err != nil {
+			return err
+		}
+		for _, response := range r.Respond(request) {
+			if _, err := conn.Write(response); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// Dialer returns a Dialer connecting the client to the replayer in memory.
+func (r *Replayer) Dialer() Dialer {
+	return DialerFunc(func() (net.Conn, error) {
+		client, server := net.Pipe()
+		go r.Serve(server)
+		return client, nil
+	})
+}
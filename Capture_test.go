@@ -0,0 +1,59 @@
+package viewsonic
+
+import (
+	"bytes"
+	"encoding/binary"
+	"testing"
+)
+
+// frame builds a frame with the head, length and checksum of the protocol.
+func frame(cmd1 uint8, data ...byte) []byte {
+	f := append([]byte{cmd1, 0x14, 0x00}, binary.LittleEndian.AppendUint16(nil, uint16(len(data)))...)
+	f = append(f, data...)
+	return append(f, checkSum(f[1:]))
+}
+
+func TestFrameSplitterResyncs(t *testing.T) {
+	read := frame(cmdRead, 0x34, 0x00, 0x00, 0x11, 0x00)
+	write := frame(cmdWrite, 0x34, 0x11, 0x00, 0x01)
+	corrupt := frame(cmdWrite, 0x34, 0x12, 0x00, 0x01)
+	corrupt[len(corrupt)-1]++
+
+	var stream []byte
+	stream = append(stream, 0xFF, 0x14) // noise
+	stream = append(stream, read...)
+	stream = append(stream, corrupt...)
+	stream = append(stream, frame(cmdReadResponse, 0x00, 0x00, 0x01)...) // not a request
+	stream = append(stream, write...)
+
+	var got [][]byte
+	s := frameSplitter{direction: FrameRequest}
+	// Split the stream in the middle of frames
+	for i := 0; i < len(stream); i += 4 {
+		s.write(stream[i:min(i+4, len(stream))], func(f Frame) {
+			if f.Direction != FrameRequest {
+				t.Errorf("direction %v", f.Direction)
+			}
+			got = append(got, f.Data)
+		})
+	}
+
+	if len(got) != 2 || !bytes.Equal(got[0], read) || !bytes.Equal(got[1], write) {
+		t.Errorf("frames % X, want % X and % X", got, read, write)
+	}
+}
+
+func TestFrameSplitterResponses(t *testing.T) {
+	ok := frame(cmdWriteResponse)
+	value := frame(cmdReadResponse, 0x00, 0x00, 0x03)
+
+	var got [][]byte
+	s := frameSplitter{direction: FrameResponse}
+	s.write(append(append(append([]byte{0x00}, ok...), 0x07, 0x14), value...), func(f Frame) {
+		got = append(got, f.Data)
+	})
+
+	if len(got) != 2 || !bytes.Equal(got[0], ok) || !bytes.Equal(got[1], value) {
+		t.Errorf("frames % X, want % X and % X", got, ok, value)
+	}
+}
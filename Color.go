@@ -11,11 +11,11 @@ const (
 )
 
 func (conn *ViewSonic) SetColorTemperature(temp ColorTemperature) error {
-	return conn.Write(0x1208, int8(temp)) // PDF #66-69
+	return conn.Write(cmdColorTemperature.Code, int8(temp))
 }
 
 func (conn *ViewSonic) GetColorTemperature() (ColorTemperature, error) {
-	val, err := conn.Read(0x1208) // PDF #70
+	val, err := conn.Read(cmdColorTemperature.Code)
 	return ColorTemperature(val), err
 }
 
@@ -40,16 +40,16 @@ const (
 )
 
 func (conn *ViewSonic) SetColorMode(mode ColorMode) error {
-	return conn.Write(0x120B, int8(mode)) // PDF #80-90
+	return conn.Write(cmdColorMode.Code, int8(mode))
 }
 
 func (conn *ViewSonic) GetColorMode() (ColorMode, error) {
-	val, err := conn.Read(0x120B) // PDF #92
+	val, err := conn.Read(cmdColorMode.Code)
 	return ColorMode(val), err
 }
 
 func (conn *ViewSonic) CycleColorMode() error {
-	return conn.Write(0x1333, 0x00) // PDF #91
+	return conn.Write(cmdCycleColorMode.Code, 0x00)
 }
 
 // Primary Color
@@ -65,59 +65,59 @@ const (
 )
 
 func (conn *ViewSonic) SelectPrimaryColor(color PrimaryColor) error {
-	return conn.Write(0x1210, int8(color)) // PDF #93-98
+	return conn.Write(cmdPrimaryColor.Code, int8(color))
 }
 
 // GetSelectedPrimaryColor returns inconsistent results use with caution.
 // The documentation states that the Generic Read Return is 1 byte longer then the documentet
 // values. However, testing indicates that its most of the time a 2 byte Read Returns some data.
 func (conn *ViewSonic) GetSelectedPrimaryColor() (PrimaryColor, error) {
-	val, err := conn.Read2Bytes(0x1210) // PDF #99
+	val, err := conn.Read2Bytes(cmdPrimaryColor.Code)
 	return PrimaryColor(val), err
 }
 
 // Hue/Tint
 func (conn *ViewSonic) IncreaseHue() error {
-	return conn.Write(0x1211, 0x01) // PDF #101
+	return conn.Write(cmdHue.Code, 0x01)
 }
 func (conn *ViewSonic) DecreaseHue() error {
-	return conn.Write(0x1211, 0x00) // PDF #100
+	return conn.Write(cmdHue.Code, 0x00)
 }
 func (conn *ViewSonic) GetHue() (int16, error) {
-	return conn.Read2Bytes(0x1211) // PDF #102
+	return conn.Read2Bytes(cmdHue.Code)
 }
 
 // Saturation
 func (conn *ViewSonic) IncreaseSaturation() error {
-	return conn.Write(0x1212, 0x01) // PDF #104
+	return conn.Write(cmdSaturation.Code, 0x01)
 }
 func (conn *ViewSonic) DecreaseSaturation() error {
-	return conn.Write(0x1212, 0x00) // PDF #103
+	return conn.Write(cmdSaturation.Code, 0x00)
 }
 func (conn *ViewSonic) GetSaturation() (int16, error) {
-	return conn.Read2Bytes(0x1212) // PDF #105
+	return conn.Read2Bytes(cmdSaturation.Code)
 }
 
 // Sharpness
 func (conn *ViewSonic) IncreaseSharpness() error {
-	return conn.Write(0x120E, 0x01) // PDF #110
+	return conn.Write(cmdSharpness.Code, 0x01)
 }
 func (conn *ViewSonic) DecreaseSharpness() error {
-	return conn.Write(0x120E, 0x00) // PDF #109
+	return conn.Write(cmdSharpness.Code, 0x00)
 }
 func (conn *ViewSonic) GetSharpness() (int16, error) {
-	return conn.Read2Bytes(0x120E) // PDF #111
+	return conn.Read2Bytes(cmdSharpness.Code)
 }
 
 // Gain
 func (conn *ViewSonic) IncreaseGain() error {
-	return conn.Write(0x1213, 0x01) // PDF #107
+	return conn.Write(cmdGain.Code, 0x01)
 }
 func (conn *ViewSonic) DecreaseGain() error {
-	return conn.Write(0x1213, 0x00) // PDF #106
+	return conn.Write(cmdGain.Code, 0x00)
 }
 func (conn *ViewSonic) GetGain() (int16, error) {
-	return conn.Read2Bytes(0x1213) // PDF #108
+	return conn.Read2Bytes(cmdGain.Code)
 }
 
 // Brilliant Color
@@ -126,11 +126,11 @@ func (conn *ViewSonic) SetBrilliantColor(level int8) error {
 	if level > 10 {
 		level = 10
 	}
-	// PDF #178-188: Brilliant Color OFF is value 0, Color 1 is value 1, etc.
-	return conn.Write(0x120F, level)
+	// Brilliant Color OFF is value 0, Color 1 is value 1, etc.
+	return conn.Write(cmdBrilliantColor.Code, level)
 }
 func (conn *ViewSonic) GetBrilliantColor() (int8, error) {
-	return conn.Read(0x120F) // PDF #189
+	return conn.Read(cmdBrilliantColor.Code)
 }
 
 // Screen Color
@@ -145,9 +145,9 @@ const (
 )
 
 func (conn *ViewSonic) SetScreenColor(color ScreenColor) error {
-	return conn.Write(0x1132, int8(color)) // PDF #199-203
+	return conn.Write(cmdScreenColor.Code, int8(color))
 }
 func (conn *ViewSonic) GetScreenColor() (ScreenColor, error) {
-	val, err := conn.Read(0x1132) // PDF #204
+	val, err := conn.Read(cmdScreenColor.Code)
 	return ScreenColor(val), err
 }
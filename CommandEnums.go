@@ -0,0 +1,271 @@
+// Code generated by mkcommands from Commands.csv. DO NOT EDIT.
+
+package viewsonic
+
+var projectorStatusValueEnum = enum[ProjectorStatusValue]{"ProjectorStatusValue", cmdProjectorStatus.Values}
+
+func (v ProjectorStatusValue) String() string { return projectorStatusValueEnum.name(v) }
+
+func (v ProjectorStatusValue) MarshalText() ([]byte, error) {
+	return projectorStatusValueEnum.marshal(v)
+}
+
+func (v *ProjectorStatusValue) UnmarshalText(text []byte) error {
+	return projectorStatusValueEnum.unmarshal(v, text)
+}
+
+// ParseProjectorStatusValue returns the ProjectorStatusValue with the case insensitive name or number.
+func ParseProjectorStatusValue(s string) (ProjectorStatusValue, error) {
+	return projectorStatusValueEnum.parse(s)
+}
+
+// ProjectorStatusValueValues returns all named ProjectorStatusValue values.
+func ProjectorStatusValueValues() []ProjectorStatusValue { return projectorStatusValueEnum.all() }
+
+var splashScreenEnum = enum[SplashScreen]{"SplashScreen", cmdSplashScreen.Values}
+
+func (v SplashScreen) String() string { return splashScreenEnum.name(v) }
+
+func (v SplashScreen) MarshalText() ([]byte, error) { return splashScreenEnum.marshal(v) }
+
+func (v *SplashScreen) UnmarshalText(text []byte) error { return splashScreenEnum.unmarshal(v, text) }
+
+// ParseSplashScreen returns the SplashScreen with the case insensitive name or number.
+func ParseSplashScreen(s string) (SplashScreen, error) { return splashScreenEnum.parse(s) }
+
+// SplashScreenValues returns all named SplashScreen values.
+func SplashScreenValues() []SplashScreen { return splashScreenEnum.all() }
+
+var projectorPositionEnum = enum[ProjectorPosition]{"ProjectorPosition", cmdProjectorPosition.Values}
+
+func (v ProjectorPosition) String() string { return projectorPositionEnum.name(v) }
+
+func (v ProjectorPosition) MarshalText() ([]byte, error) { return projectorPositionEnum.marshal(v) }
+
+func (v *ProjectorPosition) UnmarshalText(text []byte) error {
+	return projectorPositionEnum.unmarshal(v, text)
+}
+
+// ParseProjectorPosition returns the ProjectorPosition with the case insensitive name or number.
+func ParseProjectorPosition(s string) (ProjectorPosition, error) {
+	return projectorPositionEnum.parse(s)
+}
+
+// ProjectorPositionValues returns all named ProjectorPosition values.
+func ProjectorPositionValues() []ProjectorPosition { return projectorPositionEnum.all() }
+
+var aspectRatioEnum = enum[AspectRatio]{"AspectRatio", cmdAspectRatio.Values}
+
+func (v AspectRatio) String() string { return aspectRatioEnum.name(v) }
+
+func (v AspectRatio) MarshalText() ([]byte, error) { return aspectRatioEnum.marshal(v) }
+
+func (v *AspectRatio) UnmarshalText(text []byte) error { return aspectRatioEnum.unmarshal(v, text) }
+
+// ParseAspectRatio returns the AspectRatio with the case insensitive name or number.
+func ParseAspectRatio(s string) (AspectRatio, error) { return aspectRatioEnum.parse(s) }
+
+// AspectRatioValues returns all named AspectRatio values.
+func AspectRatioValues() []AspectRatio { return aspectRatioEnum.all() }
+
+var threeDSyncModeEnum = enum[ThreeDSyncMode]{"ThreeDSyncMode", cmdThreeDSyncMode.Values}
+
+func (v ThreeDSyncMode) String() string { return threeDSyncModeEnum.name(v) }
+
+func (v ThreeDSyncMode) MarshalText() ([]byte, error) { return threeDSyncModeEnum.marshal(v) }
+
+func (v *ThreeDSyncMode) UnmarshalText(text []byte) error {
+	return threeDSyncModeEnum.unmarshal(v, text)
+}
+
+// ParseThreeDSyncMode returns the ThreeDSyncMode with the case insensitive name or number.
+func ParseThreeDSyncMode(s string) (ThreeDSyncMode, error) { return threeDSyncModeEnum.parse(s) }
+
+// ThreeDSyncModeValues returns all named ThreeDSyncMode values.
+func ThreeDSyncModeValues() []ThreeDSyncMode { return threeDSyncModeEnum.all() }
+
+var hdrModeEnum = enum[HdrMode]{"HdrMode", cmdHdrMode.Values}
+
+func (v HdrMode) String() string { return hdrModeEnum.name(v) }
+
+func (v HdrMode) MarshalText() ([]byte, error) { return hdrModeEnum.marshal(v) }
+
+func (v *HdrMode) UnmarshalText(text []byte) error { return hdrModeEnum.unmarshal(v, text) }
+
+// ParseHdrMode returns the HdrMode with the case insensitive name or number.
+func ParseHdrMode(s string) (HdrMode, error) { return hdrModeEnum.parse(s) }
+
+// HdrModeValues returns all named HdrMode values.
+func HdrModeValues() []HdrMode { return hdrModeEnum.all() }
+
+var eotfEnum = enum[Eotf]{"Eotf", cmdEotf.Values}
+
+func (v Eotf) String() string { return eotfEnum.name(v) }
+
+func (v Eotf) MarshalText() ([]byte, error) { return eotfEnum.marshal(v) }
+
+func (v *Eotf) UnmarshalText(text []byte) error { return eotfEnum.unmarshal(v, text) }
+
+// ParseEotf returns the Eotf with the case insensitive name or number.
+func ParseEotf(s string) (Eotf, error) { return eotfEnum.parse(s) }
+
+// EotfValues returns all named Eotf values.
+func EotfValues() []Eotf { return eotfEnum.all() }
+
+var sourceInputEnum = enum[SourceInput]{"SourceInput", cmdSourceInput.Values}
+
+func (v SourceInput) String() string { return sourceInputEnum.name(v) }
+
+func (v SourceInput) MarshalText() ([]byte, error) { return sourceInputEnum.marshal(v) }
+
+func (v *SourceInput) UnmarshalText(text []byte) error { return sourceInputEnum.unmarshal(v, text) }
+
+// ParseSourceInput returns the SourceInput with the case insensitive name or number.
+func ParseSourceInput(s string) (SourceInput, error) { return sourceInputEnum.parse(s) }
+
+// SourceInputValues returns all named SourceInput values.
+func SourceInputValues() []SourceInput { return sourceInputEnum.all() }
+
+var hdmiFormatEnum = enum[HdmiFormat]{"HdmiFormat", cmdHdmiFormat.Values}
+
+func (v HdmiFormat) String() string { return hdmiFormatEnum.name(v) }
+
+func (v HdmiFormat) MarshalText() ([]byte, error) { return hdmiFormatEnum.marshal(v) }
+
+func (v *HdmiFormat) UnmarshalText(text []byte) error { return hdmiFormatEnum.unmarshal(v, text) }
+
+// ParseHdmiFormat returns the HdmiFormat with the case insensitive name or number.
+func ParseHdmiFormat(s string) (HdmiFormat, error) { return hdmiFormatEnum.parse(s) }
+
+// HdmiFormatValues returns all named HdmiFormat values.
+func HdmiFormatValues() []HdmiFormat { return hdmiFormatEnum.all() }
+
+var hdmiRangeEnum = enum[HdmiRange]{"HdmiRange", cmdHdmiRange.Values}
+
+func (v HdmiRange) String() string { return hdmiRangeEnum.name(v) }
+
+func (v HdmiRange) MarshalText() ([]byte, error) { return hdmiRangeEnum.marshal(v) }
+
+func (v *HdmiRange) UnmarshalText(text []byte) error { return hdmiRangeEnum.unmarshal(v, text) }
+
+// ParseHdmiRange returns the HdmiRange with the case insensitive name or number.
+func ParseHdmiRange(s string) (HdmiRange, error) { return hdmiRangeEnum.parse(s) }
+
+// HdmiRangeValues returns all named HdmiRange values.
+func HdmiRangeValues() []HdmiRange { return hdmiRangeEnum.all() }
+
+var colorTemperatureEnum = enum[ColorTemperature]{"ColorTemperature", cmdColorTemperature.Values}
+
+func (v ColorTemperature) String() string { return colorTemperatureEnum.name(v) }
+
+func (v ColorTemperature) MarshalText() ([]byte, error) { return colorTemperatureEnum.marshal(v) }
+
+func (v *ColorTemperature) UnmarshalText(text []byte) error {
+	return colorTemperatureEnum.unmarshal(v, text)
+}
+
+// ParseColorTemperature returns the ColorTemperature with the case insensitive name or number.
+func ParseColorTemperature(s string) (ColorTemperature, error) { return colorTemperatureEnum.parse(s) }
+
+// ColorTemperatureValues returns all named ColorTemperature values.
+func ColorTemperatureValues() []ColorTemperature { return colorTemperatureEnum.all() }
+
+var colorModeEnum = enum[ColorMode]{"ColorMode", cmdColorMode.Values}
+
+func (v ColorMode) String() string { return colorModeEnum.name(v) }
+
+func (v ColorMode) MarshalText() ([]byte, error) { return colorModeEnum.marshal(v) }
+
+func (v *ColorMode) UnmarshalText(text []byte) error { return colorModeEnum.unmarshal(v, text) }
+
+// ParseColorMode returns the ColorMode with the case insensitive name or number.
+func ParseColorMode(s string) (ColorMode, error) { return colorModeEnum.parse(s) }
+
+// ColorModeValues returns all named ColorMode values.
+func ColorModeValues() []ColorMode { return colorModeEnum.all() }
+
+var primaryColorEnum = enum[PrimaryColor]{"PrimaryColor", cmdPrimaryColor.Values}
+
+func (v PrimaryColor) String() string { return primaryColorEnum.name(v) }
+
+func (v PrimaryColor) MarshalText() ([]byte, error) { return primaryColorEnum.marshal(v) }
+
+func (v *PrimaryColor) UnmarshalText(text []byte) error { return primaryColorEnum.unmarshal(v, text) }
+
+// ParsePrimaryColor returns the PrimaryColor with the case insensitive name or number.
+func ParsePrimaryColor(s string) (PrimaryColor, error) { return primaryColorEnum.parse(s) }
+
+// PrimaryColorValues returns all named PrimaryColor values.
+func PrimaryColorValues() []PrimaryColor { return primaryColorEnum.all() }
+
+var screenColorEnum = enum[ScreenColor]{"ScreenColor", cmdScreenColor.Values}
+
+func (v ScreenColor) String() string { return screenColorEnum.name(v) }
+
+func (v ScreenColor) MarshalText() ([]byte, error) { return screenColorEnum.marshal(v) }
+
+func (v *ScreenColor) UnmarshalText(text []byte) error { return screenColorEnum.unmarshal(v, text) }
+
+// ParseScreenColor returns the ScreenColor with the case insensitive name or number.
+func ParseScreenColor(s string) (ScreenColor, error) { return screenColorEnum.parse(s) }
+
+// ScreenColorValues returns all named ScreenColor values.
+func ScreenColorValues() []ScreenColor { return screenColorEnum.all() }
+
+var gammaEnum = enum[Gamma]{"Gamma", cmdGamma.Values}
+
+func (v Gamma) String() string { return gammaEnum.name(v) }
+
+func (v Gamma) MarshalText() ([]byte, error) { return gammaEnum.marshal(v) }
+
+func (v *Gamma) UnmarshalText(text []byte) error { return gammaEnum.unmarshal(v, text) }
+
+// ParseGamma returns the Gamma with the case insensitive name or number.
+func ParseGamma(s string) (Gamma, error) { return gammaEnum.parse(s) }
+
+// GammaValues returns all named Gamma values.
+func GammaValues() []Gamma { return gammaEnum.all() }
+
+var languageEnum = enum[Language]{"Language", cmdLanguage.Values}
+
+func (v Language) String() string { return languageEnum.name(v) }
+
+func (v Language) MarshalText() ([]byte, error) { return languageEnum.marshal(v) }
+
+func (v *Language) UnmarshalText(text []byte) error { return languageEnum.unmarshal(v, text) }
+
+// ParseLanguage returns the Language with the case insensitive name or number.
+func ParseLanguage(s string) (Language, error) { return languageEnum.parse(s) }
+
+// LanguageValues returns all named Language values.
+func LanguageValues() []Language { return languageEnum.all() }
+
+var remoteKeyEnum = enum[RemoteKey]{"RemoteKey", cmdRemoteKey.Values}
+
+func (v RemoteKey) String() string { return remoteKeyEnum.name(v) }
+
+func (v RemoteKey) MarshalText() ([]byte, error) { return remoteKeyEnum.marshal(v) }
+
+func (v *RemoteKey) UnmarshalText(text []byte) error { return remoteKeyEnum.unmarshal(v, text) }
+
+// ParseRemoteKey returns the RemoteKey with the case insensitive name or number.
+func ParseRemoteKey(s string) (RemoteKey, error) { return remoteKeyEnum.parse(s) }
+
+// RemoteKeyValues returns all named RemoteKey values.
+func RemoteKeyValues() []RemoteKey { return remoteKeyEnum.all() }
+
+var lightSourceModeEnum = enum[LightSourceMode]{"LightSourceMode", cmdLightSourceMode.Values}
+
+func (v LightSourceMode) String() string { return lightSourceModeEnum.name(v) }
+
+func (v LightSourceMode) MarshalText() ([]byte, error) { return lightSourceModeEnum.marshal(v) }
+
+func (v *LightSourceMode) UnmarshalText(text []byte) error {
+	return lightSourceModeEnum.unmarshal(v, text)
+}
+
+// ParseLightSourceMode returns the LightSourceMode with the case insensitive name or number.
+func ParseLightSourceMode(s string) (LightSourceMode, error) { return lightSourceModeEnum.parse(s) }
+
+// LightSourceModeValues returns all named LightSourceMode values.
+func LightSourceModeValues() []LightSourceMode { return lightSourceModeEnum.all() }
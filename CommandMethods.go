@@ -0,0 +1,114 @@
+// Code generated by mkcommands from Commands.csv. DO NOT EDIT.
+
+package viewsonic
+
+// TogglePower, LS920WU table R1.00 row 3.
+func (conn *ViewSonic) TogglePower() error {
+	return conn.Write(cmdTogglePower.Code, 0x00)
+}
+
+// HdrMode, LS920WU table R1.00 rows 103-105.
+type HdrMode int8
+
+const (
+	HdrModeAuto HdrMode = 0x00
+	HdrModeSDR  HdrMode = 0x01
+)
+
+func (conn *ViewSonic) SetHdrMode(v HdrMode) error {
+	return conn.Write(cmdHdrMode.Code, int8(v))
+}
+
+func (conn *ViewSonic) GetHdrMode() (HdrMode, error) {
+	value, err := conn.Read(cmdHdrMode.Code)
+	if err != nil {
+		return 0, err
+	}
+	return HdrMode(value), nil
+}
+
+// Eotf, LS920WU table R1.00 rows 213-216.
+type Eotf int8
+
+const (
+	EotfLow  Eotf = 0x00
+	EotfMid  Eotf = 0x01
+	EotfHigh Eotf = 0x02
+)
+
+func (conn *ViewSonic) SetEotf(v Eotf) error {
+	return conn.Write(cmdEotf.Code, int8(v))
+}
+
+func (conn *ViewSonic) GetEotf() (Eotf, error) {
+	value, err := conn.Read(cmdEotf.Code)
+	if err != nil {
+		return 0, err
+	}
+	return Eotf(value), nil
+}
+
+// DigitalLensShiftVertical, LS920WU table R1.00 rows 225-227.
+func (conn *ViewSonic) DecreaseDigitalLensShiftVertical() error {
+	return conn.Write(cmdDigitalLensShiftVertical.Code, 0x00)
+}
+
+func (conn *ViewSonic) IncreaseDigitalLensShiftVertical() error {
+	return conn.Write(cmdDigitalLensShiftVertical.Code, 0x01)
+}
+
+func (conn *ViewSonic) GetDigitalLensShiftVertical() (int8, error) {
+	return conn.Read(cmdDigitalLensShiftVertical.Code)
+}
+
+// DigitalLensShiftHorizontal, LS920WU table R1.00 rows 228-230.
+func (conn *ViewSonic) DecreaseDigitalLensShiftHorizontal() error {
+	return conn.Write(cmdDigitalLensShiftHorizontal.Code, 0x00)
+}
+
+func (conn *ViewSonic) IncreaseDigitalLensShiftHorizontal() error {
+	return conn.Write(cmdDigitalLensShiftHorizontal.Code, 0x01)
+}
+
+func (conn *ViewSonic) GetDigitalLensShiftHorizontal() (int8, error) {
+	return conn.Read(cmdDigitalLensShiftHorizontal.Code)
+}
+
+// IsfMode, LS920WU table R1.00 rows 100-102.
+func (conn *ViewSonic) SetIsfMode(enable bool) error {
+	value := int8(0x00)
+	if enable {
+		value = 0x01
+	}
+	return conn.Write(cmdIsfMode.Code, value)
+}
+
+func (conn *ViewSonic) GetIsfMode() (bool, error) {
+	value, err := conn.Read(cmdIsfMode.Code)
+	return value == 0x01, err
+}
+
+// Gamma, LS920WU table R1.00 rows 217-224.
+type Gamma int8
+
+const (
+	Gamma18    Gamma = 0x00
+	Gamma20    Gamma = 0x01
+	Gamma22    Gamma = 0x02
+	Gamma235   Gamma = 0x03
+	Gamma25    Gamma = 0x04
+	GammaSRGB  Gamma = 0x05
+	GammaCubic Gamma = 0x06
+)
+
+func (conn *ViewSonic) SetGamma(v Gamma) error {
+	return conn.Write(cmdGamma.Code, int8(v))
+}
+
+func (conn *ViewSonic) GetGamma() (Gamma, error) {
+	value, err := conn.Read(cmdGamma.Code)
+	if err != nil {
+		return 0, err
+	}
+	return Gamma(value), nil
+}
@@ -0,0 +1,143 @@
+// Code generated by mkcommands from Commands.csv. DO NOT EDIT.
+
+package viewsonic_test
+
+import (
+	"testing"
+
+	"github.com/m-baertschi/viewsonic"
+	"github.com/m-baertschi/viewsonic/emulator"
+)
+
+func emulated(t *testing.T) *viewsonic.ViewSonic {
+	t.Helper()
+	e := emulator.New()
+	if err := e.Listen("127.0.0.1:0"); err != nil {
+		t.Fatal(err)
+	}
+	conn := viewsonic.New(e.Addr())
+	t.Cleanup(func() {
+		conn.Close()
+		e.Close()
+	})
+	return conn
+}
+
+func TestCommandWidths(t *testing.T) {
+	conn := emulated(t)
+	for _, c := range viewsonic.Commands {
+		if c.Access&viewsonic.AccessRead == 0 {
+			continue
+		}
+		data, err := conn.ReadNBytes(c.Code)
+		if err != nil {
+			t.Errorf("%v: %v", c, err)
+		} else if len(data) != 2+c.Width {
+			t.Errorf("%v: got %d bytes, expected %d", c, len(data), 2+c.Width)
+		}
+	}
+}
+
+func TestTogglePower(t *testing.T) {
+	conn := emulated(t)
+	if err := conn.TogglePower(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestHdrMode(t *testing.T) {
+	conn := emulated(t)
+	for _, v := range []viewsonic.HdrMode{viewsonic.HdrModeAuto, viewsonic.HdrModeSDR} {
+		if err := conn.SetHdrMode(v); err != nil {
+			t.Fatal(err)
+		}
+		if got, err := conn.GetHdrMode(); err != nil || got != v {
+			t.Errorf("got %v, %v, expected %v", got, err, v)
+		}
+		if p, err := viewsonic.ParseHdrMode(v.String()); err != nil || p != v {
+			t.Errorf("parse %v: got %v, %v", v, p, err)
+		}
+	}
+}
+
+func TestEotf(t *testing.T) {
+	conn := emulated(t)
+	for _, v := range []viewsonic.Eotf{viewsonic.EotfLow, viewsonic.EotfMid, viewsonic.EotfHigh} {
+		if err := conn.SetEotf(v); err != nil {
+			t.Fatal(err)
+		}
+		if got, err := conn.GetEotf(); err != nil || got != v {
+			t.Errorf("got %v, %v, expected %v", got, err, v)
+		}
+		if p, err := viewsonic.ParseEotf(v.String()); err != nil || p != v {
+			t.Errorf("parse %v: got %v, %v", v, p, err)
+		}
+	}
+}
+
+func TestDigitalLensShiftVertical(t *testing.T) {
+	conn := emulated(t)
+	before, err := conn.GetDigitalLensShiftVertical()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := conn.IncreaseDigitalLensShiftVertical(); err != nil {
+		t.Fatal(err)
+	}
+	if got, err := conn.GetDigitalLensShiftVertical(); err != nil || got != before+1 {
+		t.Errorf("got %v, %v, expected %v", got, err, before+1)
+	}
+	if err := conn.DecreaseDigitalLensShiftVertical(); err != nil {
+		t.Fatal(err)
+	}
+	if got, err := conn.GetDigitalLensShiftVertical(); err != nil || got != before {
+		t.Errorf("got %v, %v, expected %v", got, err, before)
+	}
+}
+
+func TestDigitalLensShiftHorizontal(t *testing.T) {
+	conn := emulated(t)
+	before, err := conn.GetDigitalLensShiftHorizontal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := conn.IncreaseDigitalLensShiftHorizontal(); err != nil {
+		t.Fatal(err)
+	}
+	if got, err := conn.GetDigitalLensShiftHorizontal(); err != nil || got != before+1 {
+		t.Errorf("got %v, %v, expected %v", got, err, before+1)
+	}
+	if err := conn.DecreaseDigitalLensShiftHorizontal(); err != nil {
+		t.Fatal(err)
+	}
+	if got, err := conn.GetDigitalLensShiftHorizontal(); err != nil || got != before {
+		t.Errorf("got %v, %v, expected %v", got, err, before)
+	}
+}
+
+func TestIsfMode(t *testing.T) {
+	conn := emulated(t)
+	for _, v := range []bool{true, false} {
+		if err := conn.SetIsfMode(v); err != nil {
+			t.Fatal(err)
+		}
+		if got, err := conn.GetIsfMode(); err != nil || got != v {
+			t.Errorf("got %v, %v, expected %v", got, err, v)
+		}
+	}
+}
+
+func TestGamma(t *testing.T) {
+	conn := emulated(t)
+	for _, v := range []viewsonic.Gamma{viewsonic.Gamma18, viewsonic.Gamma20, viewsonic.Gamma22, viewsonic.Gamma235, viewsonic.Gamma25, viewsonic.GammaSRGB, viewsonic.GammaCubic} {
+		if err := conn.SetGamma(v); err != nil {
+			t.Fatal(err)
+		}
+		if got, err := conn.GetGamma(); err != nil || got != v {
+			t.Errorf("got %v, %v, expected %v", got, err, v)
+		}
+		if p, err := viewsonic.ParseGamma(v.String()); err != nil || p != v {
+			t.Errorf("parse %v: got %v, %v", v, p, err)
+		}
+	}
+}
@@ -0,0 +1,886 @@
+// Code generated by mkcommands from Commands.csv. DO NOT EDIT.
+
+package viewsonic
+
+// System
+var (
+	cmdPower = &Command{
+		Code:     0x1100,
+		Name:     "Power",
+		Category: CategorySystem,
+		Access:   AccessRead | AccessWrite,
+		Width:    1,
+		Values: []CommandValue{
+			{"Execute", 0x00},
+		},
+		Relative: true,
+		Rows:     []int{1, 3},
+		Notes:    "Writing turns the projector on, reading returns the PowerState.",
+	}
+	cmdPowerOff = &Command{
+		Code:     0x1101,
+		Name:     "PowerOff",
+		Category: CategorySystem,
+		Access:   AccessWrite,
+		Values: []CommandValue{
+			{"Execute", 0x00},
+		},
+		Relative: true,
+		Rows:     []int{2},
+	}
+	cmdTogglePower = &Command{
+		Code:     0x1134,
+		Name:     "TogglePower",
+		Category: CategorySystem,
+		Access:   AccessWrite,
+		Values: []CommandValue{
+			{"Execute", 0x00},
+		},
+		Relative: true,
+		Notes:    "LS920WU table R1.00 row 3.",
+	}
+	cmdProjectorStatus = &Command{
+		Code:     0x1126,
+		Name:     "ProjectorStatus",
+		Category: CategorySystem,
+		Access:   AccessRead,
+		Width:    1,
+		Values: []CommandValue{
+			{"PowerOff", 0x00},
+			{"WarmUp", 0x01},
+			{"PowerOn", 0x02},
+			{"CoolDown", 0x03},
+		},
+		Rows:  []int{4},
+		Notes: "Note 7.",
+	}
+	cmdResetAllSettings = &Command{
+		Code:     0x1102,
+		Name:     "ResetAllSettings",
+		Category: CategorySystem,
+		Access:   AccessWrite,
+		Values: []CommandValue{
+			{"Execute", 0x00},
+		},
+		Relative: true,
+		Rows:     []int{5},
+	}
+	cmdResetCurrentColorSettings = &Command{
+		Code:     0x112A,
+		Name:     "ResetCurrentColorSettings",
+		Category: CategorySystem,
+		Access:   AccessWrite,
+		Values: []CommandValue{
+			{"Execute", 0x00},
+		},
+		Relative: true,
+		Rows:     []int{6},
+	}
+	cmdQuickPowerOff = &Command{
+		Code:     0x110B,
+		Name:     "QuickPowerOff",
+		Category: CategorySystem,
+		Access:   AccessRead | AccessWrite,
+		Width:    1,
+		Values: []CommandValue{
+			{"Off", 0x00},
+			{"On", 0x01},
+		},
+		Rows: []int{13, 14, 15},
+	}
+	cmdErrorStatus = &Command{
+		Code:     0x0C0D,
+		Name:     "ErrorStatus",
+		Category: CategorySystem,
+		Access:   AccessRead,
+		Width:    24,
+		Rows:     []int{177},
+		Notes:    "Error counters, first burn-in error minute, lamp mode status and lamp mode error status (note 3). 24 value bytes as listed in the note, the 0x16 length of its example does not match.",
+	}
+	cmdOperatingTemperature = &Command{
+		Code:     0x1503,
+		Name:     "OperatingTemperature",
+		Category: CategorySystem,
+		Access:   AccessRead,
+		Width:    8,
+		Rows:     []int{223},
+		Notes:    "Two little endian 32 bit values in 0.1 °C (note 1).",
+	}
+)
+
+// Image
+var (
+	cmdSplashScreen = &Command{
+		Code:     0x110A,
+		Name:     "SplashScreen",
+		Category: CategoryImage,
+		Access:   AccessRead | AccessWrite,
+		Width:    1,
+		Values: []CommandValue{
+			{"Black", 0x00},
+			{"Blue", 0x01},
+			{"ViewSonic", 0x02},
+			{"Capture", 0x03},
+			{"Off", 0x04},
+		},
+		Rows: []int{7, 8, 9, 10, 11, 12},
+	}
+	cmdProjectorPosition = &Command{
+		Code:     0x1200,
+		Name:     "ProjectorPosition",
+		Category: CategoryImage,
+		Access:   AccessRead | AccessWrite,
+		Width:    1,
+		Values: []CommandValue{
+			{"FrontTable", 0x00},
+			{"RearTable", 0x01},
+			{"RearCeiling", 0x02},
+			{"FrontCeiling", 0x03},
+		},
+		Rows: []int{27, 28, 29, 30, 31},
+	}
+	cmdContrast = &Command{
+		Code:     0x1202,
+		Name:     "Contrast",
+		Category: CategoryImage,
+		Access:   AccessRead | AccessWrite,
+		Width:    2,
+		Values: []CommandValue{
+			{"Decrease", 0x00},
+			{"Increase", 0x01},
+		},
+		Relative: true,
+		Rows:     []int{42, 43, 44},
+	}
+	cmdBrightness = &Command{
+		Code:     0x1203,
+		Name:     "Brightness",
+		Category: CategoryImage,
+		Access:   AccessRead | AccessWrite,
+		Width:    2,
+		Values: []CommandValue{
+			{"Decrease", 0x00},
+			{"Increase", 0x01},
+		},
+		Relative: true,
+		Rows:     []int{45, 46, 47},
+	}
+	cmdAspectRatio = &Command{
+		Code:     0x1204,
+		Name:     "AspectRatio",
+		Category: CategoryImage,
+		Access:   AccessRead | AccessWrite,
+		Width:    1,
+		Values: []CommandValue{
+			{"Auto", 0x00},
+			{"4:3", 0x02},
+			{"16:9", 0x03},
+			{"16:10", 0x04},
+			{"Anamorphic", 0x05},
+			{"Wide", 0x06},
+			{"2.35:1", 0x07},
+			{"Panorama", 0x08},
+			{"Native", 0x09},
+		},
+		Rows: []int{48, 49, 50, 51, 52, 53, 54, 55, 56, 58},
+	}
+	cmdCycleAspectRatio = &Command{
+		Code:     0x1331,
+		Name:     "CycleAspectRatio",
+		Category: CategoryImage,
+		Access:   AccessWrite,
+		Values: []CommandValue{
+			{"Execute", 0x00},
+		},
+		Relative: true,
+		Rows:     []int{57},
+	}
+	cmdAutoAdjust = &Command{
+		Code:     0x1205,
+		Name:     "AutoAdjust",
+		Category: CategoryImage,
+		Access:   AccessWrite,
+		Values: []CommandValue{
+			{"Execute", 0x00},
+		},
+		Relative: true,
+		Rows:     []int{59},
+	}
+	cmdBlank = &Command{
+		Code:     0x1209,
+		Name:     "Blank",
+		Category: CategoryImage,
+		Access:   AccessRead | AccessWrite,
+		Width:    1,
+		Values: []CommandValue{
+			{"Off", 0x00},
+			{"On", 0x01},
+		},
+		Rows: []int{71, 72, 73},
+	}
+	cmdFreeze = &Command{
+		Code:     0x1300,
+		Name:     "Freeze",
+		Category: CategoryImage,
+		Access:   AccessRead | AccessWrite,
+		Width:    1,
+		Values: []CommandValue{
+			{"Off", 0x00},
+			{"On", 0x01},
+		},
+		Rows: []int{112, 113, 114},
+	}
+	cmdOverScan = &Command{
+		Code:     0x1133,
+		Name:     "OverScan",
+		Category: CategoryImage,
+		Access:   AccessRead | AccessWrite,
+		Width:    1,
+		Min:      0, Max: 5,
+		Rows: []int{205, 206, 207, 208, 209, 210, 211},
+	}
+	cmdThreeDSyncMode = &Command{
+		Code:     0x1220,
+		Name:     "ThreeDSyncMode",
+		Category: CategoryImage,
+		Access:   AccessRead | AccessWrite,
+		Width:    1,
+		Values: []CommandValue{
+			{"Off", 0x00},
+			{"Auto", 0x01},
+			{"FrameSequential", 0x02},
+			{"FramePacking", 0x03},
+			{"TopBottom", 0x04},
+			{"SideBySide", 0x05},
+		},
+		Rows: []int{32, 33, 34, 35, 36, 37, 38},
+	}
+	cmdThreeDSyncInvert = &Command{
+		Code:     0x1221,
+		Name:     "ThreeDSyncInvert",
+		Category: CategoryImage,
+		Access:   AccessRead | AccessWrite,
+		Width:    1,
+		Values: []CommandValue{
+			{"Off", 0x00},
+			{"On", 0x01},
+		},
+		Rows: []int{39, 40, 41},
+	}
+	cmdHdrMode = &Command{
+		Code:     0x1239,
+		Name:     "HdrMode",
+		Category: CategoryImage,
+		Access:   AccessRead | AccessWrite,
+		Width:    1,
+		Values: []CommandValue{
+			{"Auto", 0x00},
+			{"SDR", 0x01},
+		},
+		Notes: "LS920WU table R1.00 rows 103-105.",
+	}
+	cmdEotf = &Command{
+		Code:     0x112C,
+		Name:     "Eotf",
+		Category: CategoryImage,
+		Access:   AccessRead | AccessWrite,
+		Width:    1,
+		Values: []CommandValue{
+			{"Low", 0x00},
+			{"Mid", 0x01},
+			{"High", 0x02},
+		},
+		Notes: "LS920WU table R1.00 rows 213-216.",
+	}
+	cmdDigitalLensShiftVertical = &Command{
+		Code:     0x1139,
+		Name:     "DigitalLensShiftVertical",
+		Category: CategoryImage,
+		Access:   AccessRead | AccessWrite,
+		Width:    1,
+		Values: []CommandValue{
+			{"Decrease", 0x00},
+			{"Increase", 0x01},
+		},
+		Relative: true,
+		Notes:    "LS920WU table R1.00 rows 225-227.",
+	}
+	cmdDigitalLensShiftHorizontal = &Command{
+		Code:     0x113A,
+		Name:     "DigitalLensShiftHorizontal",
+		Category: CategoryImage,
+		Access:   AccessRead | AccessWrite,
+		Width:    1,
+		Values: []CommandValue{
+			{"Decrease", 0x00},
+			{"Increase", 0x01},
+		},
+		Relative: true,
+		Notes:    "LS920WU table R1.00 rows 228-230.",
+	}
+)
+
+// Input
+var (
+	cmdSourceInput = &Command{
+		Code:     0x1301,
+		Name:     "SourceInput",
+		Category: CategoryInput,
+		Access:   AccessRead | AccessWrite,
+		Width:    1,
+		Values: []CommandValue{
+			{"DSub1", 0x00},
+			{"DSub2", 0x08},
+			{"HDMI1", 0x03},
+			{"HDMI2", 0x07},
+			{"HDMI3", 0x09},
+			{"HDMIMHL4", 0x0E},
+			{"Composite", 0x05},
+			{"SVideo", 0x06},
+			{"DVI", 0x0A},
+			{"Component", 0x0B},
+			{"HDBaseT", 0x0C},
+			{"USBC", 0x0F},
+			{"USBReader", 0x1A},
+			{"LANWiFi", 0x1B},
+			{"USBDisplay", 0x1C},
+		},
+		Rows: []int{115, 116, 117, 118, 119, 120, 121, 122, 123, 124, 125, 126, 127, 128, 129, 130},
+	}
+	cmdQuickAutoSearch = &Command{
+		Code:     0x1302,
+		Name:     "QuickAutoSearch",
+		Category: CategoryInput,
+		Access:   AccessRead | AccessWrite,
+		Width:    1,
+		Values: []CommandValue{
+			{"Off", 0x00},
+			{"On", 0x01},
+		},
+		Rows: []int{131, 132, 133},
+	}
+	cmdHdmiFormat = &Command{
+		Code:     0x1128,
+		Name:     "HdmiFormat",
+		Category: CategoryInput,
+		Access:   AccessRead | AccessWrite,
+		Width:    1,
+		Values: []CommandValue{
+			{"RGB", 0x00},
+			{"YUV", 0x01},
+			{"Auto", 0x02},
+		},
+		Rows: []int{166, 167, 168, 169},
+	}
+	cmdHdmiRange = &Command{
+		Code:     0x1129,
+		Name:     "HdmiRange",
+		Category: CategoryInput,
+		Access:   AccessRead | AccessWrite,
+		Width:    1,
+		Values: []CommandValue{
+			{"Enhanced", 0x00},
+			{"Normal", 0x01},
+			{"Auto", 0x02},
+		},
+		Rows:  []int{170, 171, 172, 173},
+		Notes: "Note 6.",
+	}
+	cmdCEC = &Command{
+		Code:     0x112B,
+		Name:     "CEC",
+		Category: CategoryInput,
+		Access:   AccessRead | AccessWrite,
+		Width:    1,
+		Values: []CommandValue{
+			{"Off", 0x00},
+			{"On", 0x01},
+		},
+		Rows: []int{174, 175, 176},
+	}
+	cmdHorizontalPosition = &Command{
+		Code:     0x1206,
+		Name:     "HorizontalPosition",
+		Category: CategoryInput,
+		Access:   AccessRead | AccessWrite,
+		Width:    1,
+		Values: []CommandValue{
+			{"Left", 0x00},
+			{"Right", 0x01},
+		},
+		Relative: true,
+		Rows:     []int{60, 61, 62},
+	}
+	cmdVerticalPosition = &Command{
+		Code:     0x1207,
+		Name:     "VerticalPosition",
+		Category: CategoryInput,
+		Access:   AccessRead | AccessWrite,
+		Width:    1,
+		Values: []CommandValue{
+			{"Up", 0x00},
+			{"Down", 0x01},
+		},
+		Relative: true,
+		Rows:     []int{63, 64, 65},
+	}
+	cmdKeystoneVertical = &Command{
+		Code:     0x120A,
+		Name:     "KeystoneVertical",
+		Category: CategoryInput,
+		Access:   AccessRead | AccessWrite,
+		Width:    1,
+		Values: []CommandValue{
+			{"Decrease", 0x00},
+			{"Increase", 0x01},
+		},
+		Relative: true,
+		Rows:     []int{74, 75, 76},
+	}
+	cmdKeystoneHorizontal = &Command{
+		Code:     0x1131,
+		Name:     "KeystoneHorizontal",
+		Category: CategoryInput,
+		Access:   AccessRead | AccessWrite,
+		Width:    1,
+		Values: []CommandValue{
+			{"Decrease", 0x00},
+			{"Increase", 0x01},
+		},
+		Relative: true,
+		Rows:     []int{77, 78, 79},
+	}
+)
+
+// Color
+var (
+	cmdColorTemperature = &Command{
+		Code:     0x1208,
+		Name:     "ColorTemperature",
+		Category: CategoryColor,
+		Access:   AccessRead | AccessWrite,
+		Width:    1,
+		Values: []CommandValue{
+			{"Warm", 0x00},
+			{"Normal", 0x01},
+			{"Neutral", 0x02},
+			{"Cool", 0x03},
+		},
+		Rows: []int{66, 67, 68, 69, 70},
+	}
+	cmdColorMode = &Command{
+		Code:     0x120B,
+		Name:     "ColorMode",
+		Category: CategoryColor,
+		Access:   AccessRead | AccessWrite,
+		Width:    1,
+		Values: []CommandValue{
+			{"Brightest", 0x00},
+			{"Movie", 0x01},
+			{"Standard", 0x04},
+			{"SRGBViewMatch", 0x05},
+			{"Dynamic", 0x08},
+			{"Rec709", 0x09},
+			{"DICOMSIM", 0x0A},
+			{"Sports", 0x11},
+			{"Gaming", 0x12},
+			{"Photo", 0x13},
+			{"Presentation", 0x14},
+			{"Vivid", 0x15},
+			{"ISFDay", 0x16},
+			{"ISFNight", 0x17},
+		},
+		Rows: []int{80, 81, 82, 83, 84, 85, 86, 87, 88, 89, 90, 92},
+	}
+	cmdCycleColorMode = &Command{
+		Code:     0x1333,
+		Name:     "CycleColorMode",
+		Category: CategoryColor,
+		Access:   AccessWrite,
+		Values: []CommandValue{
+			{"Execute", 0x00},
+		},
+		Relative: true,
+		Rows:     []int{91},
+	}
+	cmdPrimaryColor = &Command{
+		Code:     0x1210,
+		Name:     "PrimaryColor",
+		Category: CategoryColor,
+		Access:   AccessRead | AccessWrite,
+		Width:    2,
+		Values: []CommandValue{
+			{"R", 0x00},
+			{"G", 0x01},
+			{"B", 0x02},
+			{"C", 0x03},
+			{"M", 0x04},
+			{"Y", 0x05},
+		},
+		Rows:  []int{93, 94, 95, 96, 97, 98, 99},
+		Notes: "The read response is documented as 1 byte but mostly returns 2 bytes.",
+	}
+	cmdHue = &Command{
+		Code:     0x1211,
+		Name:     "Hue",
+		Category: CategoryColor,
+		Access:   AccessRead | AccessWrite,
+		Width:    2,
+		Values: []CommandValue{
+			{"Decrease", 0x00},
+			{"Increase", 0x01},
+		},
+		Relative: true,
+		Rows:     []int{100, 101, 102},
+	}
+	cmdSaturation = &Command{
+		Code:     0x1212,
+		Name:     "Saturation",
+		Category: CategoryColor,
+		Access:   AccessRead | AccessWrite,
+		Width:    2,
+		Values: []CommandValue{
+			{"Decrease", 0x00},
+			{"Increase", 0x01},
+		},
+		Relative: true,
+		Rows:     []int{103, 104, 105},
+	}
+	cmdSharpness = &Command{
+		Code:     0x120E,
+		Name:     "Sharpness",
+		Category: CategoryColor,
+		Access:   AccessRead | AccessWrite,
+		Width:    2,
+		Values: []CommandValue{
+			{"Decrease", 0x00},
+			{"Increase", 0x01},
+		},
+		Relative: true,
+		Rows:     []int{109, 110, 111},
+	}
+	cmdGain = &Command{
+		Code:     0x1213,
+		Name:     "Gain",
+		Category: CategoryColor,
+		Access:   AccessRead | AccessWrite,
+		Width:    2,
+		Values: []CommandValue{
+			{"Decrease", 0x00},
+			{"Increase", 0x01},
+		},
+		Relative: true,
+		Rows:     []int{106, 107, 108},
+	}
+	cmdBrilliantColor = &Command{
+		Code:     0x120F,
+		Name:     "BrilliantColor",
+		Category: CategoryColor,
+		Access:   AccessRead | AccessWrite,
+		Width:    1,
+		Min:      0, Max: 10,
+		Rows:  []int{178, 179, 180, 181, 182, 183, 184, 185, 186, 187, 188, 189},
+		Notes: "0 is off, 1-10 the level.",
+	}
+	cmdScreenColor = &Command{
+		Code:     0x1132,
+		Name:     "ScreenColor",
+		Category: CategoryColor,
+		Access:   AccessRead | AccessWrite,
+		Width:    1,
+		Values: []CommandValue{
+			{"Off", 0x00},
+			{"Blackboard", 0x01},
+			{"Greenboard", 0x02},
+			{"Whiteboard", 0x03},
+			{"Blueboard", 0x04},
+		},
+		Rows: []int{199, 200, 201, 202, 203, 204},
+	}
+	cmdIsfMode = &Command{
+		Code:     0x1238,
+		Name:     "IsfMode",
+		Category: CategoryColor,
+		Access:   AccessRead | AccessWrite,
+		Width:    1,
+		Values: []CommandValue{
+			{"Off", 0x00},
+			{"On", 0x01},
+		},
+		Notes: "LS920WU table R1.00 rows 100-102.",
+	}
+	cmdGamma = &Command{
+		Code:     0x05CA,
+		Name:     "Gamma",
+		Category: CategoryColor,
+		Access:   AccessRead | AccessWrite,
+		Width:    1,
+		Values: []CommandValue{
+			{"1.8", 0x00},
+			{"2.0", 0x01},
+			{"2.2", 0x02},
+			{"2.35", 0x03},
+			{"2.5", 0x04},
+			{"sRGB", 0x05},
+			{"Cubic", 0x06},
+		},
+		Notes: "LS920WU table R1.00 rows 217-224.",
+	}
+)
+
+// Audio
+var (
+	cmdMute = &Command{
+		Code:     0x1400,
+		Name:     "Mute",
+		Category: CategoryAudio,
+		Access:   AccessRead | AccessWrite,
+		Width:    1,
+		Values: []CommandValue{
+			{"Off", 0x00},
+			{"On", 0x01},
+		},
+		Rows: []int{134, 135, 136},
+	}
+	cmdVolumeUp = &Command{
+		Code:     0x1401,
+		Name:     "VolumeUp",
+		Category: CategoryAudio,
+		Access:   AccessWrite,
+		Values: []CommandValue{
+			{"Execute", 0x00},
+		},
+		Relative: true,
+		Rows:     []int{137},
+	}
+	cmdVolumeDown = &Command{
+		Code:     0x1402,
+		Name:     "VolumeDown",
+		Category: CategoryAudio,
+		Access:   AccessWrite,
+		Values: []CommandValue{
+			{"Execute", 0x00},
+		},
+		Relative: true,
+		Rows:     []int{138},
+	}
+	cmdSetVolume = &Command{
+		Code:     0x132A,
+		Name:     "SetVolume",
+		Category: CategoryAudio,
+		Access:   AccessWrite,
+		Min:      0, Max: 20,
+		Rows:  []int{139},
+		Notes: "Read through GetVolume (0x1403).",
+	}
+	cmdGetVolume = &Command{
+		Code:     0x1403,
+		Name:     "GetVolume",
+		Category: CategoryAudio,
+		Access:   AccessRead,
+		Width:    1,
+		Rows:     []int{140},
+		Notes:    "Written through SetVolume (0x132A).",
+	}
+	cmdCycleAudioMode = &Command{
+		Code:     0x1335,
+		Name:     "CycleAudioMode",
+		Category: CategoryAudio,
+		Access:   AccessWrite,
+		Values: []CommandValue{
+			{"Execute", 0x00},
+		},
+		Relative: true,
+		Rows:     []int{225},
+	}
+)
+
+// Miscellaneous
+var (
+	cmdHighAltitudeMode = &Command{
+		Code:     0x110C,
+		Name:     "HighAltitudeMode",
+		Category: CategoryMiscellaneous,
+		Access:   AccessRead | AccessWrite,
+		Width:    1,
+		Values: []CommandValue{
+			{"Off", 0x00},
+			{"On", 0x01},
+		},
+		Rows: []int{16, 17, 18},
+	}
+	cmdMessageDisplay = &Command{
+		Code:     0x1127,
+		Name:     "MessageDisplay",
+		Category: CategoryMiscellaneous,
+		Access:   AccessRead | AccessWrite,
+		Width:    1,
+		Values: []CommandValue{
+			{"Off", 0x00},
+			{"On", 0x01},
+		},
+		Rows: []int{24, 25, 26},
+	}
+	cmdLanguage = &Command{
+		Code:     0x1500,
+		Name:     "Language",
+		Category: CategoryMiscellaneous,
+		Access:   AccessRead | AccessWrite,
+		Width:    1,
+		Values: []CommandValue{
+			{"English", 0x00},
+			{"French", 0x01},
+			{"German", 0x02},
+			{"Italian", 0x03},
+			{"Spanish", 0x04},
+			{"Russian", 0x05},
+			{"TradChinese", 0x06},
+			{"SimpChinese", 0x07},
+			{"Japanese", 0x08},
+			{"Korean", 0x09},
+			{"Swedish", 0x0A},
+			{"Dutch", 0x0B},
+			{"Turkish", 0x0C},
+			{"Czech", 0x0D},
+			{"Portuguese", 0x0E},
+			{"Thai", 0x0F},
+			{"Polish", 0x10},
+			{"Finnish", 0x11},
+			{"Arabic", 0x12},
+			{"Indonesian", 0x13},
+			{"Hindi", 0x14},
+			{"Vietnamese", 0x15},
+		},
+		Rows: []int{141, 142, 143, 144, 145, 146, 147, 148, 149, 150, 151, 152, 153, 154, 155, 156, 157, 158, 159, 160, 161, 162, 163},
+	}
+	cmdRemoteControlCode = &Command{
+		Code:     0x0C48,
+		Name:     "RemoteControlCode",
+		Category: CategoryMiscellaneous,
+		Access:   AccessRead | AccessWrite,
+		Width:    1,
+		Min:      0, Max: 7,
+		Rows:  []int{190, 191, 192, 193, 194, 195, 196, 197, 198},
+		Notes: "Value 0 is remote control code 1.",
+	}
+	cmdRemoteKey = &Command{
+		Code:     0x0204,
+		Name:     "RemoteKey",
+		Category: CategoryMiscellaneous,
+		Access:   AccessKey,
+		Values: []CommandValue{
+			{"Menu", 0x0F},
+			{"Exit", 0x13},
+			{"Top", 0x0B},
+			{"Bottom", 0x0C},
+			{"Left", 0x0D},
+			{"Right", 0x0E},
+			{"Source", 0x04},
+			{"Enter", 0x15},
+			{"Auto", 0x08},
+			{"MyButton", 0x11},
+		},
+		Rows: []int{212, 213, 214, 215, 216, 217, 218, 219, 220, 221},
+	}
+	cmdLightSourceUsageTime = &Command{
+		Code:     0x1501,
+		Name:     "LightSourceUsageTime",
+		Category: CategoryMiscellaneous,
+		Access:   AccessRead | AccessWrite,
+		Width:    4,
+		Values: []CommandValue{
+			{"Execute", 0x00},
+		},
+		Rows:  []int{164, 165},
+		Notes: "Writing resets the counter, reading returns the hours as little endian 32 bit value (note 4).",
+	}
+	cmdLightSourceMode = &Command{
+		Code:     0x1110,
+		Name:     "LightSourceMode",
+		Category: CategoryMiscellaneous,
+		Access:   AccessRead | AccessWrite,
+		Width:    1,
+		Values: []CommandValue{
+			{"Normal", 0x00},
+			{"Eco", 0x01},
+			{"DynamicEco", 0x02},
+			{"SuperEco", 0x03},
+		},
+		Rows: []int{19, 20, 21, 22, 23},
+	}
+	cmdCycleLampMode = &Command{
+		Code:     0x1336,
+		Name:     "CycleLampMode",
+		Category: CategoryMiscellaneous,
+		Access:   AccessWrite,
+		Values: []CommandValue{
+			{"Execute", 0x00},
+		},
+		Relative: true,
+		Rows:     []int{224},
+	}
+)
+
+// Commands is the registry of all command codes, in the order of the spec.
+var Commands = []*Command{
+	cmdPower,
+	cmdPowerOff,
+	cmdTogglePower,
+	cmdProjectorStatus,
+	cmdResetAllSettings,
+	cmdResetCurrentColorSettings,
+	cmdQuickPowerOff,
+	cmdErrorStatus,
+	cmdOperatingTemperature,
+	cmdSplashScreen,
+	cmdProjectorPosition,
+	cmdContrast,
+	cmdBrightness,
+	cmdAspectRatio,
+	cmdCycleAspectRatio,
+	cmdAutoAdjust,
+	cmdBlank,
+	cmdFreeze,
+	cmdOverScan,
+	cmdThreeDSyncMode,
+	cmdThreeDSyncInvert,
+	cmdHdrMode,
+	cmdEotf,
+	cmdDigitalLensShiftVertical,
+	cmdDigitalLensShiftHorizontal,
+	cmdSourceInput,
+	cmdQuickAutoSearch,
+	cmdHdmiFormat,
+	cmdHdmiRange,
+	cmdCEC,
+	cmdHorizontalPosition,
+	cmdVerticalPosition,
+	cmdKeystoneVertical,
+	cmdKeystoneHorizontal,
+	cmdColorTemperature,
+	cmdColorMode,
+	cmdCycleColorMode,
+	cmdPrimaryColor,
+	cmdHue,
+	cmdSaturation,
+	cmdSharpness,
+	cmdGain,
+	cmdBrilliantColor,
+	cmdScreenColor,
+	cmdIsfMode,
+	cmdGamma,
+	cmdMute,
+	cmdVolumeUp,
+	cmdVolumeDown,
+	cmdSetVolume,
+	cmdGetVolume,
+	cmdCycleAudioMode,
+	cmdHighAltitudeMode,
+	cmdMessageDisplay,
+	cmdLanguage,
+	cmdRemoteControlCode,
+	cmdRemoteKey,
+	cmdLightSourceUsageTime,
+	cmdLightSourceMode,
+	cmdCycleLampMode,
+}
@@ -0,0 +1,120 @@
+package viewsonic
+
+import (
+	"fmt"
+	"strings"
+)
+
+//go:generate go run ./internal/mkcommands -spec Commands.csv -tests CommandMethods_test.go -modbus modbus/RegisterTable.go
+
+// CommandAccess is a set of ways a command code is used.
+type CommandAccess uint8
+
+const (
+	AccessRead  CommandAccess = 1 << iota // Read, Read2Bytes or ReadNBytes
+	AccessWrite                           // Write
+	AccessKey                             // WriteKey
+)
+
+func (a CommandAccess) String() string {
+	var parts []string
+	if a&AccessRead != 0 {
+		parts = append(parts, "read")
+	}
+	if a&AccessWrite != 0 {
+		parts = append(parts, "write")
+	}
+	if a&AccessKey != 0 {
+		parts = append(parts, "key")
+	}
+	return strings.Join(parts, "/")
+}
+
+// CommandCategory groups the commands like the source files of this package.
+type CommandCategory string
+
+const (
+	CategorySystem        CommandCategory = "System"
+	CategoryImage         CommandCategory = "Image"
+	CategoryInput         CommandCategory = "Input"
+	CategoryColor         CommandCategory = "Color"
+	CategoryAudio         CommandCategory = "Audio"
+	CategoryMiscellaneous CommandCategory = "Miscellaneous"
+)
+
+// CommandValue is a named value of a command.
+type CommandValue struct {
+	Name  string
+	Value int8
+}
+
+// Command describes a command code of the RS-232 table.
+type Command struct {
+	Code     uint16
+	Name     string
+	Category CommandCategory
+	Access   CommandAccess
+	// Width is the number of value bytes of a read response: 1 for Read, 2 for Read2Bytes
+	// and more for ReadNBytes. It is 0 for commands that can not be read.
+	Width int
+	// Values lists the valid write values, if the command takes named values.
+	Values []CommandValue
+	// Min and Max limit the write value of commands taking a number, if Max is not 0.
+	Min, Max int8
+	// Relative is set if a write triggers an action or steps the value, e.g. VolumeUp or
+	// Brightness, instead of setting the value.
+	Relative bool
+	// Rows are the row numbers of the command in the v1.19 PDF.
+	Rows  []int
+	Notes string
+}
+
+func (c *Command) String() string {
+	return fmt.Sprintf("0x%04X %v", c.Code, c.Name)
+}
+
+// Validate checks a write value against the values or the range of the command.
+func (c *Command) Validate(value int8) error {
+	if len(c.Values) > 0 {
+		for _, v := range c.Values {
+			if v.Value == value {
+				return nil
+			}
+		}
+		return fmt.Errorf("%v: invalid value 0x%02X", c.Name, uint8(value))
+	}
+	if c.Max != 0 && (value < c.Min || value > c.Max) {
+		return fmt.Errorf("%v: value %d out of range %d-%d", c.Name, value, c.Min, c.Max)
+	}
+	return nil
+}
+
+// ValueName returns the name of a value, or the number if the value is not named.
+func (c *Command) ValueName(value int8) string {
+	for _, v := range c.Values {
+		if v.Value == value {
+			return v.Name
+		}
+	}
+	return fmt.Sprint(value)
+}
+
+// LookupCommand returns the command with the code, or nil.
+func LookupCommand(code uint16) *Command {
+	for _, c := range Commands {
+		if c.Code == code {
+			return c
+		}
+	}
+	return nil
+}
+
+// CommandByName returns the command with the case insensitive name, or nil.
+func CommandByName(name string) *Command {
+	for _, c := range Commands {
+		if strings.EqualFold(c.Name, name) {
+			return c
+		}
+	}
+	return nil
+}
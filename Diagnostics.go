@@ -0,0 +1,299 @@
+package viewsonic
+
+import (
+	"fmt"
+	"strings"
+)
+
+var lampModeStatusEnum = enum[LampModeStatus]{"LampModeStatus", []CommandValue{
+	{"Standby", 0x00},
+	{"Ignition", 0x01},
+	{"LampRunUp", 0x04},
+	{"CoolDown", 0x05},
+	{"NormalLampOperation", 0x06},
+	{"ShutdownUnrecoverableError", 0x08},
+	{"PreHeatingPhase", 0x09},
+}}
+
+func (s LampModeStatus) String() string { return lampModeStatusEnum.name(s) }
+
+func (s LampModeStatus) MarshalText() ([]byte, error) { return lampModeStatusEnum.marshal(s) }
+
+func (s *LampModeStatus) UnmarshalText(text []byte) error {
+	return lampModeStatusEnum.unmarshal(s, text)
+}
+
+// ParseLampModeStatus returns the LampModeStatus with the case insensitive name or number.
+func ParseLampModeStatus(s string) (LampModeStatus, error) { return lampModeStatusEnum.parse(s) }
+
+// LampModeStatusValues returns all named LampModeStatus values.
+func LampModeStatusValues() []LampModeStatus { return lampModeStatusEnum.all() }
+
+// Description returns a human readable explanation of the lamp mode.
+func (s LampModeStatus) Description() string {
+	switch s {
+	case LampModeStatusStandby:
+		return "lamp is off, projector in standby"
+	case LampModeStatusIgnition:
+		return "lamp is igniting"
+	case LampModeStatusLampRunUp:
+		return "lamp is running up to full power"
+	case LampModeStatusCoolDown:
+		return "lamp is cooling down"
+	case LampModeStatusNormalLampOperation:
+		return "lamp is operating normally"
+	case LampModeStatusShutdownUnrecoverableError:
+		return "lamp was shut down because of an unrecoverable error"
+	case LampModeStatusPreHeatingPhase:
+		return "lamp is pre-heating"
+	}
+	return "unknown lamp mode"
+}
+
+var lampModeErrorStatusEnum = enum[LampModeErrorStatus]{"LampModeErrorStatus", []CommandValue{
+	{"NoError", 0x00},
+	{"TemperatureShutdown", 0x01},
+	{"ShortCircuit", 0x02},
+	{"EndOfLampLife", 0x03},
+	{"LampDidNotIgnite", 0x04},
+	{"LampExtinguishedDuringOperation", 0x05},
+	{"LampExtinguishedDuringRunUp", 0x06},
+	{"EEPROMWriteError", 0x07},
+	{"EEPROMWriteBufferOverflow", 0x08},
+	{"UARTBufferOverflow", 0x09},
+	{"LampCurrentCalculationError", 0x0A},
+	{"CorruptedSoftwareConfiguration", 0x0B},
+	{"LampVoltageTooLow", 0x0C},
+	{"EEPROMConfigMismatch", 0x0F},
+	{"MaxPreHeatingTimeElapsed", 0x10},
+}}
+
+func (s LampModeErrorStatus) String() string { return lampModeErrorStatusEnum.name(s) }
+
+func (s LampModeErrorStatus) MarshalText() ([]byte, error) {
+	return lampModeErrorStatusEnum.marshal(s)
+}
+
+func (s *LampModeErrorStatus) UnmarshalText(text []byte) error {
+	return lampModeErrorStatusEnum.unmarshal(s, text)
+}
+
+// ParseLampModeErrorStatus returns the LampModeErrorStatus with the case insensitive name or
+// number.
+func ParseLampModeErrorStatus(s string) (LampModeErrorStatus, error) {
+	return lampModeErrorStatusEnum.parse(s)
+}
+
+// LampModeErrorStatusValues returns all named LampModeErrorStatus values.
+func LampModeErrorStatusValues() []LampModeErrorStatus { return lampModeErrorStatusEnum.all() }
+
+// Description returns a human readable explanation of the lamp error.
+func (s LampModeErrorStatus) Description() string {
+	switch s {
+	case LampModeErrorStatusNoError:
+		return "no lamp error"
+	case LampModeErrorStatusTemperatureShutdown:
+		return "lamp was shut down because of overtemperature"
+	case LampModeErrorStatusShortCircuit:
+		return "short circuit detected in the lamp circuit"
+	case LampModeErrorStatusEndOfLampLife:
+		return "lamp has reached the end of its life"
+	case LampModeErrorStatusLampDidNotIgnite:
+		return "lamp did not ignite"
+	case LampModeErrorStatusLampExtinguishedDuringOperation:
+		return "lamp went out during normal operation"
+	case LampModeErrorStatusLampExtinguishedDuringRunUp:
+		return "lamp went out while running up"
+	case LampModeErrorStatusEEPROMWriteError:
+		return "writing to the lamp driver EEPROM failed"
+	case LampModeErrorStatusEEPROMWriteBufferOverflow:
+		return "lamp driver EEPROM write buffer overflow"
+	case LampModeErrorStatusUARTBufferOverflow:
+		return "lamp driver UART buffer overflow"
+	case LampModeErrorStatusLampCurrentCalculationError:
+		return "lamp driver could not calculate the lamp current"
+	case LampModeErrorStatusCorruptedSoftwareConfiguration:
+		return "lamp driver software configuration is corrupted"
+	case LampModeErrorStatusLampVoltageTooLow:
+		return "lamp voltage is too low"
+	case LampModeErrorStatusEEPROMConfigMismatch:
+		return "lamp driver EEPROM configuration does not match"
+	case LampModeErrorStatusMaxPreHeatingTimeElapsed:
+		return "lamp did not finish pre-heating in time"
+	}
+	return "unknown lamp error"
+}
+
+// critical reports whether the lamp error stops the projector from working.
+func (s LampModeErrorStatus) critical() bool {
+	switch s {
+	case LampModeErrorStatusTemperatureShutdown,
+		LampModeErrorStatusShortCircuit,
+		LampModeErrorStatusEndOfLampLife,
+		LampModeErrorStatusLampDidNotIgnite,
+		LampModeErrorStatusLampExtinguishedDuringOperation,
+		LampModeErrorStatusLampExtinguishedDuringRunUp,
+		LampModeErrorStatusCorruptedSoftwareConfiguration,
+		LampModeErrorStatusLampVoltageTooLow,
+		LampModeErrorStatusMaxPreHeatingTimeElapsed:
+		return true
+	}
+	return false
+}
+
+// CounterCategory groups the error counters of ErrorStatus.
+type CounterCategory string
+
+const (
+	CounterCategoryLamp        CounterCategory = "lamp"
+	CounterCategoryFan         CounterCategory = "fan"
+	CounterCategoryDiode       CounterCategory = "diode"
+	CounterCategoryTemperature CounterCategory = "temperature"
+	CounterCategoryColorWheel  CounterCategory = "colorwheel"
+	CounterCategoryOther       CounterCategory = "other"
+)
+
+// ErrorCounter is a single named counter of ErrorStatus.
+type ErrorCounter struct {
+	Name     string
+	Category CounterCategory
+	Value    uint8
+}
+
+// Counters returns all error counters of the status in a fixed order.
+func (s *ErrorStatus) Counters() []ErrorCounter {
+	return []ErrorCounter{
+		{"LampFailCount", CounterCategoryLamp, s.LampFailCount},
+		{"LampLitErrorCount", CounterCategoryLamp, s.LampLitErrorCount},
+		{"Fan1ErrorCount", CounterCategoryFan, s.Fan1ErrorCount},
+		{"Fan2ErrorCount", CounterCategoryFan, s.Fan2ErrorCount},
+		{"Fan3ErrorCount", CounterCategoryFan, s.Fan3ErrorCount},
+		{"Fan4ErrorCount", CounterCategoryFan, s.Fan4ErrorCount},
+		{"Diode1OpenErrorCount", CounterCategoryDiode, s.Diode1OpenErrorCount},
+		{"Diode2OpenErrorCount", CounterCategoryDiode, s.Diode2OpenErrorCount},
+		{"Diode1ShortErrorCount", CounterCategoryDiode, s.Diode1ShortErrorCount},
+		{"Diode2ShortErrorCount", CounterCategoryDiode, s.Diode2ShortErrorCount},
+		{"TemperatureErrorCount", CounterCategoryTemperature, s.TemperatureErrorCount},
+		{"Temperature2ErrorCount", CounterCategoryTemperature, s.Temperature2ErrorCount},
+		{"FanIC1ErrorCount", CounterCategoryFan, s.FanIC1ErrorCount},
+		{"ColorWheelErrorCount", CounterCategoryColorWheel, s.ColorWheelErrorCount},
+		{"ColorWheelStartupErrorCount", CounterCategoryColorWheel, s.ColorWheelStartupErrorCount},
+		{"UART1ErrorCount", CounterCategoryOther, s.UART1ErrorCount},
+		{"AbnormalPowerdown", CounterCategoryOther, s.AbnormalPowerdown},
+	}
+}
+
+// CounterIncrement is a counter that increased between two ErrorStatus readings.
+type CounterIncrement struct {
+	Name     string
+	Category CounterCategory
+	Previous uint8
+	Current  uint8
+}
+
+func (c CounterIncrement) String() string {
+	return fmt.Sprintf("%v increased from %d to %d", c.Name, c.Previous, c.Current)
+}
+
+// verdict is the severity of the increment, temperature and diode errors are critical.
+func (c CounterIncrement) verdict() HealthVerdict {
+	switch c.Category {
+	case CounterCategoryTemperature, CounterCategoryDiode:
+		return HealthCritical
+	}
+	return HealthWarning
+}
+
+// DiffErrorStatus returns the counters that were incremented from prev to cur.
+// Counters that went down (e.g. after a service reset) are ignored.
+func DiffErrorStatus(prev, cur *ErrorStatus) []CounterIncrement {
+	if prev == nil || cur == nil {
+		return nil
+	}
+
+	before := prev.Counters()
+	var increments []CounterIncrement
+	for i, c := range cur.Counters() {
+		if c.Value > before[i].Value {
+			increments = append(increments, CounterIncrement{
+				Name:     c.Name,
+				Category: c.Category,
+				Previous: before[i].Value,
+				Current:  c.Value,
+			})
+		}
+	}
+	return increments
+}
+
+// HealthVerdict is the overall health of a projector.
+type HealthVerdict int
+
+const (
+	HealthOK HealthVerdict = iota
+	HealthWarning
+	HealthCritical
+)
+
+func (v HealthVerdict) String() string {
+	switch v {
+	case HealthOK:
+		return "OK"
+	case HealthWarning:
+		return "Warning"
+	case HealthCritical:
+		return "Critical"
+	}
+	return fmt.Sprintf("HealthVerdict(%d)", int(v))
+}
+
+// HealthReport is the result of AssessHealth.
+type HealthReport struct {
+	Verdict    HealthVerdict
+	Reasons    []string
+	Increments []CounterIncrement
+}
+
+func (r *HealthReport) raise(v HealthVerdict, format string, args ...any) {
+	if v > r.Verdict {
+		r.Verdict = v
+	}
+	r.Reasons = append(r.Reasons, fmt.Sprintf(format, args...))
+}
+
+func (r HealthReport) String() string {
+	if len(r.Reasons) == 0 {
+		return r.Verdict.String()
+	}
+	return r.Verdict.String() + ": " + strings.Join(r.Reasons, "; ")
+}
+
+// AssessHealth produces a health verdict from the current error status.
+// The counters of ErrorStatus are cumulative, so they only influence the
+// verdict if a previous reading is given to compare against. prev may be nil.
+func AssessHealth(prev, cur *ErrorStatus) HealthReport {
+	report := HealthReport{}
+	if cur == nil {
+		report.raise(HealthWarning, "no error status available")
+		return report
+	}
+
+	if cur.LampStatus == LampModeStatusShutdownUnrecoverableError {
+		report.raise(HealthCritical, "lamp mode: %v", cur.LampStatus.Description())
+	}
+
+	if cur.LampErrorStatus != LampModeErrorStatusNoError {
+		v := HealthWarning
+		if cur.LampErrorStatus.critical() {
+			v = HealthCritical
+		}
+		report.raise(v, "lamp error: %v", cur.LampErrorStatus.Description())
+	}
+
+	report.Increments = DiffErrorStatus(prev, cur)
+	for _, inc := range report.Increments {
+		report.raise(inc.verdict(), "%v", inc)
+	}
+
+	return report
+}
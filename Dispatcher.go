@@ -0,0 +1,376 @@
+package viewsonic
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"log"
+	"net"
+	"slices"
+	"time"
+
+	"github.com/jpillora/backoff"
+)
+
+// Priority orders the commands waiting for the connection. Commands of a higher priority are
+// sent first, callers of the same priority take turns.
+type Priority int
+
+const (
+	// PriorityUser is for commands a person waits for, the default.
+	PriorityUser Priority = iota
+	// PriorityAutomation is for scripts, schedules and other unattended control.
+	PriorityAutomation
+	// PriorityPolling is for state polling and monitoring. The health check of the connection
+	// runs below it, only when nothing else is queued.
+	PriorityPolling
+
+	numPriorities
+)
+
+func (p Priority) String() string {
+	switch p {
+	case PriorityUser:
+		return "user"
+	case PriorityAutomation:
+		return "automation"
+	case PriorityPolling:
+		return "polling"
+	}
+	return fmt.Sprintf("Priority(%d)", int(p))
+}
+
+// ErrQueueFull is returned when the queue limit of the priority is reached.
+var ErrQueueFull = errors.New("command queue full")
+
+// ErrClosed is returned for commands after Close.
+var ErrClosed = errors.New("connection closed")
+
+// ErrNotConnected is returned for commands while the connection to the projector is down.
+var ErrNotConnected = errors.New("connection not established")
+
+// DefaultQueueLimit is the number of commands per priority that may wait for the connection.
+const DefaultQueueLimit = 32
+
+// request is a queued command. Callers sending an equal read or a write of the same command
+// code while it waits share the request.
+type request struct {
+	cmd1     uint8
+	data     []byte
+	priority Priority
+	caller   string
+
+	done    chan struct{} // closed when the result is set
+	respCmd uint8
+	resp    []byte
+	err     error
+}
+
+// coalesces reports whether the request answers another command too: an equal read, or a
+// write setting the same command code, which the new value supersedes. Key presses and
+// relative writes like VolumeUp are never coalesced.
+func (r *request) coalesces(cmd1 uint8, data []byte) bool {
+	switch cmd1 {
+	case cmdRead:
+		return r.cmd1 == cmdRead && bytes.Equal(r.data, data)
+	case cmdWrite:
+		c := LookupCommand(uint16(data[1])<<8 | uint16(data[2]))
+		return c != nil && !c.Relative && r.cmd1 == cmdWrite && bytes.Equal(r.data[:3], data[:3])
+	}
+	return false
+}
+
+// queue holds the requests of one priority. It takes the callers in turns, the requests of
+// one caller in order.
+type queue struct {
+	callers []string // callers with pending requests, the next one first
+	pending map[string][]*request
+	count   int
+}
+
+func (q *queue) push(r *request) {
+	if q.pending == nil {
+		q.pending = map[string][]*request{}
+	}
+	if len(q.pending[r.caller]) == 0 {
+		q.callers = append(q.callers, r.caller)
+	}
+	q.pending[r.caller] = append(q.pending[r.caller], r)
+	q.count++
+}
+
+func (q *queue) pop() *request {
+	if len(q.callers) == 0 {
+		return nil
+	}
+	caller := q.callers[0]
+	q.callers = q.callers[1:]
+	r := q.pending[caller][0]
+	q.pending[caller] = q.pending[caller][1:]
+	if len(q.pending[caller]) > 0 {
+		q.callers = append(q.callers, caller)
+	}
+	q.count--
+	return r
+}
+
+func (q *queue) remove(r *request) {
+	pending := q.pending[r.caller]
+	i := slices.Index(pending, r)
+	if i < 0 {
+		return
+	}
+	q.pending[r.caller] = slices.Delete(pending, i, i+1)
+	if len(q.pending[r.caller]) == 0 {
+		q.callers = slices.DeleteFunc(q.callers, func(c string) bool { return c == r.caller })
+	}
+	q.count--
+}
+
+func (q *queue) find(cmd1 uint8, data []byte) *request {
+	for _, pending := range q.pending {
+		for _, r := range pending {
+			if r.coalesces(cmd1, data) {
+				return r
+			}
+		}
+	}
+	return nil
+}
+
+// WithPriority returns a handle on the same connection that queues its commands with the
+// priority, e.g. conn.WithPriority(PriorityPolling) for a poller.
+func (conn *ViewSonic) WithPriority(p Priority) *ViewSonic {
+	return &ViewSonic{client: conn.client, priority: p, caller: conn.caller}
+}
+
+// WithCaller returns a handle on the same connection whose commands take turns with the
+// commands of other callers of the same priority, e.g. one per web client.
+func (conn *ViewSonic) WithCaller(name string) *ViewSonic {
+	return &ViewSonic{client: conn.client, priority: conn.priority, caller: name}
+}
+
+// Priority returns the priority of the commands of the handle.
+func (conn *ViewSonic) Priority() Priority {
+	return conn.priority
+}
+
+// SetQueueLimit sets the number of commands per priority that may wait for the connection,
+// further commands fail with ErrQueueFull. Commands coalesced with a waiting one do not count.
+func (conn *ViewSonic) SetQueueLimit(n int) {
+	conn.mutex.Lock()
+	defer conn.mutex.Unlock()
+	conn.queueLimit = n
+}
+
+// enqueue queues a command or returns the waiting request it coalesces with.
+func (c *client) enqueue(cmd1 uint8, data []byte, priority Priority, caller string) (*request, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if c.closed {
+		return nil, ErrClosed
+	}
+	for p := range c.queues {
+		r := c.queues[p].find(cmd1, data)
+		if r == nil {
+			continue
+		}
+		if cmd1 == cmdWrite {
+			r.data = data
+		}
+		if priority < r.priority {
+			c.queues[p].remove(r)
+			r.priority = priority
+			c.queues[priority].push(r)
+		}
+		return r, nil
+	}
+
+	if c.queues[priority].count >= c.queueLimit {
+		return nil, fmt.Errorf("%w: %v", ErrQueueFull, priority)
+	}
+	r := &request{cmd1: cmd1, data: data, priority: priority, caller: caller, done: make(chan struct{})}
+	c.queues[priority].push(r)
+	select {
+	case c.wake <- struct{}{}:
+	default:
+	}
+	return r, nil
+}
+
+// waiting reports whether requests are queued.
+func (c *client) waiting() bool {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	for p := range c.queues {
+		if c.queues[p].count > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// next returns the next request to send, or nil.
+func (c *client) next() *request {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	for p := range c.queues {
+		if r := c.queues[p].pop(); r != nil {
+			return r
+		}
+	}
+	return nil
+}
+
+// dialResult is the outcome of a dial in the background.
+type dialResult struct {
+	conn net.Conn
+	err  error
+}
+
+// run is the dispatcher: it owns the connection, sends the queued commands with the pacing,
+// reconnects and checks the connection when idle.
+func (c *client) run(dialer Dialer, name string) {
+	b := &backoff.Backoff{
+		Min:    2 * time.Second,
+		Max:    30 * time.Second,
+		Factor: 1.19,
+		Jitter: false,
+	}
+	ticker := time.NewTicker(30 * time.Second)
+	defer ticker.Stop()
+
+	// Dials run in the background, so that queued commands fail fast while the projector is
+	// unreachable. A dial is pending whenever there is no connection: one is started when
+	// the connection is lost and after a failed dial with backoff.
+	dialed := make(chan dialResult)
+	dialing := false
+	dial := func(delay time.Duration) {
+		if dialing {
+			return
+		}
+		dialing = true
+		go func() {
+			timer := time.NewTimer(delay)
+			defer timer.Stop()
+			select {
+			case <-c.ctx.Done():
+				return
+			case <-timer.C:
+			}
+			conn, err := dialer.Dial()
+			select {
+			case dialed <- dialResult{conn, err}:
+			case <-c.ctx.Done():
+				if conn != nil {
+					conn.Close()
+				}
+			}
+		}()
+	}
+	lost := func() {
+		if c.conn != nil {
+			c.conn.Close()
+			c.conn = nil
+			c.connectionChanged(false)
+		}
+		dial(0)
+	}
+	connected := func(r dialResult) {
+		dialing = false
+		if r.err != nil {
+			log.Println("reconnect error: ", r.err)
+			dial(b.Duration())
+			return
+		}
+		log.Println("reconnect success:", name)
+		b.Reset()
+		c.conn = newFrameConn(r.conn)
+		c.connectionChanged(true)
+	}
+
+	if c.conn == nil {
+		dial(b.Duration())
+	}
+
+	for {
+		// Close and reconnects go before the queued commands
+		select {
+		case <-c.ctx.Done():
+			c.shutdown()
+			return
+		case <-c.triggerReconnect:
+			lost()
+			continue
+		case r := <-dialed:
+			connected(r)
+			continue
+		default:
+		}
+
+		// Keep the pacing, a command of a higher priority may arrive in the meantime
+		var paced <-chan time.Time
+		waiting := c.waiting()
+		if waiting {
+			d := c.pause()
+			if d == 0 {
+				if r := c.next(); r != nil {
+					c.serve(r)
+				}
+				continue
+			}
+			paced = time.After(d)
+		}
+
+		select {
+		case <-c.ctx.Done():
+			c.shutdown()
+			return
+		case <-c.triggerReconnect:
+			lost()
+		case r := <-dialed:
+			connected(r)
+		case <-paced:
+		case <-ticker.C:
+			// Health check: read power status, only when idle and connected
+			if waiting || c.conn == nil || c.pause() > 0 {
+				continue
+			}
+			power := []byte{0x34, 0x00, 0x00, byte(cmdPower.Code >> 8), byte(cmdPower.Code)}
+			if _, _, err := c.paced(cmdRead, power); err != nil {
+				log.Printf("Health check failed for %v: %v", name, err)
+			}
+		case <-c.wake:
+		}
+	}
+}
+
+// serve sends a request and passes the result to its callers.
+func (c *client) serve(r *request) {
+	if c.conn == nil {
+		// A dial is pending, fail fast instead of waiting for it
+		r.err = ErrNotConnected
+	} else {
+		r.respCmd, r.resp, r.err = c.paced(r.cmd1, r.data)
+	}
+	close(r.done)
+}
+
+// shutdown closes the connection and fails the queued commands.
+func (c *client) shutdown() {
+	log.Println("Stopping Reconnect loop")
+	if c.conn != nil {
+		c.conn.Close()
+	}
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.closed = true
+	for p := range c.queues {
+		for r := c.queues[p].pop(); r != nil; r = c.queues[p].pop() {
+			r.err = ErrClosed
+			close(r.done)
+		}
+	}
+}
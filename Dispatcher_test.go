@@ -0,0 +1,175 @@
+package viewsonic
+
+import (
+	"errors"
+	"net"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/m-baertschi/viewsonic/emulator"
+)
+
+// newQueues returns a client without dispatcher for testing the queues.
+func newQueues(limit int) *client {
+	return &client{queueLimit: limit, wake: make(chan struct{}, 1)}
+}
+
+func readRequest(code uint16) []byte {
+	return []byte{0x34, 0x00, 0x00, byte(code >> 8), byte(code)}
+}
+
+func writeRequest(code uint16, value byte) []byte {
+	return []byte{0x34, byte(code >> 8), byte(code), value}
+}
+
+func TestQueuePriorities(t *testing.T) {
+	c := newQueues(DefaultQueueLimit)
+	polling, _ := c.enqueue(cmdRead, readRequest(cmdPower.Code), PriorityPolling, "")
+	automation, _ := c.enqueue(cmdRead, readRequest(cmdBlank.Code), PriorityAutomation, "")
+	user, _ := c.enqueue(cmdRead, readRequest(cmdFreeze.Code), PriorityUser, "")
+
+	for i, want := range []*request{user, automation, polling, nil} {
+		if got := c.next(); got != want {
+			t.Errorf("request %d: got %v, want %v", i, got, want)
+		}
+	}
+}
+
+func TestQueueCallersTakeTurns(t *testing.T) {
+	c := newQueues(DefaultQueueLimit)
+	a1, _ := c.enqueue(cmdWriteKey, writeRequest(0x1000, 1), PriorityUser, "a")
+	a2, _ := c.enqueue(cmdWriteKey, writeRequest(0x1000, 2), PriorityUser, "a")
+	a3, _ := c.enqueue(cmdWriteKey, writeRequest(0x1000, 3), PriorityUser, "a")
+	b1, _ := c.enqueue(cmdWriteKey, writeRequest(0x1000, 4), PriorityUser, "b")
+
+	for i, want := range []*request{a1, b1, a2, a3} {
+		if got := c.next(); got != want {
+			t.Errorf("request %d: got % X, want % X", i, got.data, want.data)
+		}
+	}
+}
+
+func TestQueueCoalescing(t *testing.T) {
+	c := newQueues(DefaultQueueLimit)
+
+	read, _ := c.enqueue(cmdRead, readRequest(cmdPower.Code), PriorityPolling, "poller")
+	if r, _ := c.enqueue(cmdRead, readRequest(cmdPower.Code), PriorityUser, "web"); r != read {
+		t.Error("equal reads not coalesced")
+	}
+	if read.priority != PriorityUser {
+		t.Errorf("coalesced read has priority %v, want the higher user priority", read.priority)
+	}
+
+	write, _ := c.enqueue(cmdWrite, writeRequest(cmdBlank.Code, 1), PriorityUser, "")
+	if r, _ := c.enqueue(cmdWrite, writeRequest(cmdBlank.Code, 0), PriorityUser, ""); r != write {
+		t.Error("writes of the same command not coalesced")
+	}
+	if write.data[3] != 0 {
+		t.Errorf("coalesced write sends %d, want the last value 0", write.data[3])
+	}
+
+	up, _ := c.enqueue(cmdWrite, writeRequest(cmdVolumeUp.Code, 0), PriorityUser, "")
+	if r, _ := c.enqueue(cmdWrite, writeRequest(cmdVolumeUp.Code, 0), PriorityUser, ""); r == up {
+		t.Error("relative writes coalesced")
+	}
+	key, _ := c.enqueue(cmdWriteKey, writeRequest(0x1000, 1), PriorityUser, "")
+	if r, _ := c.enqueue(cmdWriteKey, writeRequest(0x1000, 1), PriorityUser, ""); r == key {
+		t.Error("key presses coalesced")
+	}
+}
+
+func TestQueueLimit(t *testing.T) {
+	c := newQueues(2)
+	c.enqueue(cmdRead, readRequest(cmdPower.Code), PriorityPolling, "")
+	c.enqueue(cmdRead, readRequest(cmdBlank.Code), PriorityPolling, "")
+
+	if _, err := c.enqueue(cmdRead, readRequest(cmdFreeze.Code), PriorityPolling, ""); !errors.Is(err, ErrQueueFull) {
+		t.Errorf("enqueue beyond the limit = %v, want ErrQueueFull", err)
+	}
+	if _, err := c.enqueue(cmdRead, readRequest(cmdPower.Code), PriorityPolling, ""); err != nil {
+		t.Errorf("coalesced read = %v, want no limit", err)
+	}
+	if _, err := c.enqueue(cmdRead, readRequest(cmdFreeze.Code), PriorityUser, ""); err != nil {
+		t.Errorf("other priority = %v, want its own limit", err)
+	}
+}
+
+func TestCommandsFailFastWhileDisconnected(t *testing.T) {
+	var dials atomic.Int32
+	dialer := DialerFunc(func() (net.Conn, error) {
+		dials.Add(1)
+		time.Sleep(200 * time.Millisecond) // like an unreachable host
+		return nil, errors.New("unreachable")
+	})
+	conn := NewWithDialer("test", dialer)
+	defer conn.Close()
+
+	start := time.Now()
+	for range 20 {
+		if _, err := conn.GetBlank(); !errors.Is(err, ErrNotConnected) {
+			t.Fatalf("GetBlank = %v, want ErrNotConnected", err)
+		}
+	}
+	if d := time.Since(start); d > 100*time.Millisecond {
+		t.Errorf("20 commands took %v while disconnected", d)
+	}
+	// Only the first dial, the next one waits for the backoff
+	if n := dials.Load(); n != 1 {
+		t.Errorf("%d dials, want 1", n)
+	}
+}
+
+func TestReconnectAfterLostConnection(t *testing.T) {
+	e := emulator.New()
+	servers := make(chan net.Conn, 2)
+	dialer := DialerFunc(func() (net.Conn, error) {
+		client, server := net.Pipe()
+		servers <- server
+		go func() {
+			defer server.Close()
+			var buf []byte
+			chunk := make([]byte, 256)
+			for {
+				var request []byte
+				if request, buf, _ = splitFrame(buf, requestHeads); request != nil {
+					server.Write(e.Handle(request))
+					continue
+				}
+				n, err := server.Read(chunk)
+				if err != nil {
+					return
+				}
+				buf = append(buf, chunk[:n]...)
+			}
+		}()
+		return client, nil
+	})
+
+	conn := NewWithDialer("test", dialer)
+	defer conn.Close()
+	changes := make(chan bool, 4)
+	conn.OnConnectionChange(func(connected bool) { changes <- connected })
+
+	if _, err := conn.GetBlank(); err != nil {
+		t.Fatal(err)
+	}
+	(<-servers).Close()
+	if _, err := conn.GetBlank(); err == nil {
+		t.Fatal("GetBlank succeeded on a closed connection")
+	}
+
+	for _, want := range []bool{false, true} {
+		select {
+		case got := <-changes:
+			if got != want {
+				t.Fatalf("connection change %v, want %v", got, want)
+			}
+		case <-time.After(time.Second):
+			t.Fatalf("no connection change to %v", want)
+		}
+	}
+	if _, err := conn.GetBlank(); err != nil {
+		t.Errorf("GetBlank after the reconnect = %v", err)
+	}
+}
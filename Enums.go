@@ -0,0 +1,72 @@
+package viewsonic
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unsafe"
+)
+
+// enum names the values of an enum type. The names of command enums are the values of the
+// registry, the String, MarshalText, UnmarshalText, Parse and Values functions of every enum
+// type delegate to it.
+type enum[T ~int8 | ~uint16] struct {
+	typeName string
+	values   []CommandValue
+}
+
+// name returns the name of v, or the type and the hex value if v has no name.
+func (e enum[T]) name(v T) string {
+	for _, cv := range e.values {
+		if T(cv.Value) == v {
+			return cv.Name
+		}
+	}
+	size := int(unsafe.Sizeof(v))
+	return fmt.Sprintf("%v(0x%0*X)", e.typeName, 2*size, uint64(v)&(1<<(8*size)-1))
+}
+
+// parse returns the value with the case insensitive name, or the decimal or 0x prefixed hex
+// number in the range of the type.
+func (e enum[T]) parse(s string) (T, error) {
+	s = strings.TrimSpace(s)
+	for _, cv := range e.values {
+		if strings.EqualFold(cv.Name, s) {
+			return T(cv.Value), nil
+		}
+	}
+	bits := 8 * int(unsafe.Sizeof(T(0)))
+	if n, err := strconv.ParseInt(s, 0, 64); err == nil && n >= -1<<(bits-1) && n < 1<<bits {
+		return T(n), nil
+	}
+	return 0, fmt.Errorf("unknown %v %q", e.typeName, s)
+}
+
+// all returns the named values in the order of the registry.
+func (e enum[T]) all() []T {
+	values := make([]T, len(e.values))
+	for i, cv := range e.values {
+		values[i] = T(cv.Value)
+	}
+	return values
+}
+
+// marshal returns the name of v, or the decimal number if v has no name so that it can be
+// parsed again.
+func (e enum[T]) marshal(v T) ([]byte, error) {
+	for _, cv := range e.values {
+		if T(cv.Value) == v {
+			return []byte(cv.Name), nil
+		}
+	}
+	return strconv.AppendInt(nil, int64(v), 10), nil
+}
+
+func (e enum[T]) unmarshal(v *T, text []byte) error {
+	parsed, err := e.parse(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
@@ -0,0 +1,180 @@
+package viewsonic
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"log"
+	"net"
+	"slices"
+	"time"
+)
+
+// ErrNoResponse is returned when the projector did not answer a command in time.
+var ErrNoResponse = errors.New("no response from the projector")
+
+// ResponseError is returned when the projector only answered with frames that do not fit the
+// request, e.g. a late reply to an earlier command or a read response of another width.
+type ResponseError struct {
+	Request  []byte // the request frame
+	Response []byte // the last mismatched response frame
+	Expected string // e.g. "read response with 1 value byte"
+}
+
+func (e *ResponseError) Error() string {
+	return fmt.Sprintf("unexpected response % X to % X, expected %v", e.Response, e.Request, e.Expected)
+}
+
+func (e *ResponseError) Unwrap() error {
+	return ErrUnexpectedResponse
+}
+
+const (
+	// maxFrameData limits the data length of a valid head, longer lengths are noise.
+	maxFrameData = 64
+	// maxStale timeouts in a row trigger a reconnect.
+	maxStale = 3
+)
+
+// Variables for the tests.
+var (
+	// responseTimeout is the wait for the response to a request.
+	responseTimeout = 2 * time.Second
+	// staleWait is the wait for late replies after a request timed out.
+	staleWait = 500 * time.Millisecond
+)
+
+// frameConn reads whole response frames from a connection and keeps track of requests whose
+// response may still arrive.
+type frameConn struct {
+	net.Conn
+
+	buf []byte
+	// stale counts the requests that timed out in a row
+	stale int
+}
+
+func newFrameConn(conn net.Conn) *frameConn {
+	return &frameConn{Conn: conn}
+}
+
+// readFrame returns the next response frame with a valid head and checksum. Bytes that do not
+// start such a frame are skipped until the reader is in sync again.
+func (c *frameConn) readFrame(deadline time.Time) ([]byte, error) {
+	c.SetReadDeadline(deadline)
+	skipped := 0
+	defer func() {
+		if skipped > 0 {
+			log.Printf("Skipped %d bytes to resync on a frame head", skipped)
+		}
+	}()
+
+	chunk := make([]byte, 256)
+	for {
+		frame, rest, n := splitFrame(c.buf, responseHeads)
+		c.buf = rest
+		skipped += n
+		if frame != nil {
+			return frame, nil
+		}
+
+		n, err := c.Read(chunk)
+		c.buf = append(c.buf, chunk[:n]...)
+		if err != nil {
+			return nil, err
+		}
+	}
+}
+
+// The first bytes of request and response frames.
+var (
+	requestHeads  = []uint8{cmdWriteKey, cmdWrite, cmdRead}
+	responseHeads = []uint8{cmdError, cmdWriteResponse, cmdReadResponse}
+)
+
+// splitFrame returns the first frame of buf with one of the heads and a valid checksum, and
+// the bytes after it. The bytes before it are skipped, their number is returned. frame is nil
+// if buf ends before a whole frame, rest then holds the start of the next frame.
+func splitFrame(buf []byte, heads []uint8) (frame, rest []byte, skipped int) {
+	for len(buf) >= 5 {
+		if !validHead(buf, heads) {
+			buf = buf[1:]
+			skipped++
+			continue
+		}
+		size := 5 + int(binary.LittleEndian.Uint16(buf[3:5])) + 1
+		if len(buf) < size {
+			break
+		}
+		if checkSum(buf[1:size-1]) != buf[size-1] {
+			buf = buf[1:]
+			skipped++
+			continue
+		}
+		return append([]byte(nil), buf[:size]...), buf[size:], skipped
+	}
+	return nil, buf, skipped
+}
+
+// validHead reports whether b starts with the head of a frame with one of the heads.
+func validHead(b []byte, heads []uint8) bool {
+	if !slices.Contains(heads, b[0]) {
+		return false
+	}
+	return b[1] == 0x14 && b[2] == 0x00 && binary.LittleEndian.Uint16(b[3:5]) <= maxFrameData
+}
+
+// discard drops the frames that arrive until the deadline, e.g. late replies to commands
+// that timed out, and returns their number. Only errors other than the timeout are returned.
+func (c *frameConn) discard(deadline time.Time) (int, error) {
+	for n := 0; ; n++ {
+		frame, err := c.readFrame(deadline)
+		if isTimeout(err) {
+			return n, nil
+		}
+		if err != nil {
+			return n, err
+		}
+		log.Printf("Discarded stale frame: % X", frame)
+	}
+}
+
+func isTimeout(err error) bool {
+	var netErr net.Error
+	return errors.As(err, &netErr) && netErr.Timeout()
+}
+
+// expectation describes the response that fits a request.
+type expectation struct {
+	cmd1   uint8
+	length int // data length, -1 for any
+}
+
+// expect returns the response of a request: an empty write response for writes and a read
+// response with the width of the registry for reads. The error frame, which may answer any
+// request, is left to the caller.
+func expect(cmd1 uint8, data []byte) expectation {
+	if cmd1 != cmdRead {
+		return expectation{cmd1: cmdWriteResponse}
+	}
+	if c := LookupCommand(uint16(data[3])<<8 | uint16(data[4])); c != nil && c.Width > 0 {
+		return expectation{cmd1: cmdReadResponse, length: 2 + c.Width}
+	}
+	return expectation{cmd1: cmdReadResponse, length: -1}
+}
+
+func (e expectation) matches(frame []byte) bool {
+	return frame[0] == e.cmd1 && (e.length < 0 || len(frame)-6 == e.length)
+}
+
+func (e expectation) String() string {
+	switch {
+	case e.cmd1 == cmdWriteResponse:
+		return "write response"
+	case e.length < 0:
+		return "read response"
+	case e.length == 3:
+		return "read response with 1 value byte"
+	}
+	return fmt.Sprintf("read response with %d value bytes", e.length-2)
+}
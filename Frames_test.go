@@ -0,0 +1,200 @@
+package viewsonic
+
+import (
+	"bytes"
+	"errors"
+	"net"
+	"testing"
+	"time"
+)
+
+// fastTimeouts shortens the response timeout and the stale wait for a test.
+func fastTimeouts(t *testing.T) {
+	timeout, wait := responseTimeout, staleWait
+	responseTimeout, staleWait = 200*time.Millisecond, 100*time.Millisecond
+	t.Cleanup(func() { responseTimeout, staleWait = timeout, wait })
+}
+
+// projector serves a TCP connection and passes every request frame with its number to
+// respond, which writes the replies. It returns the client side.
+func projector(t *testing.T, respond func(n int, request []byte, conn net.Conn)) *frameConn {
+	t.Helper()
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	go func() {
+		defer l.Close()
+		conn, err := l.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		var buf []byte
+		chunk := make([]byte, 256)
+		for n := 0; ; {
+			var request []byte
+			request, buf, _ = splitFrame(buf, requestHeads)
+			if request != nil {
+				respond(n, request, conn)
+				n++
+				continue
+			}
+			m, err := conn.Read(chunk)
+			if err != nil {
+				return
+			}
+			buf = append(buf, chunk[:m]...)
+		}
+	}()
+
+	conn, err := net.Dial("tcp", l.Addr().String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	return newFrameConn(conn)
+}
+
+var (
+	readPower   = []byte{0x34, 0x00, 0x00, byte(cmdPower.Code >> 8), byte(cmdPower.Code)}
+	powerOn     = frame(cmdReadResponse, 0x00, 0x00, 0x01)
+	errorFrame  = frame(cmdError)
+	writeAnswer = frame(cmdWriteResponse)
+)
+
+func TestReadFrameResyncs(t *testing.T) {
+	corrupt := frame(cmdReadResponse, 0x00, 0x00, 0x02)
+	corrupt[len(corrupt)-1]++
+	conn := projector(t, func(n int, request []byte, conn net.Conn) {
+		conn.Write([]byte{0x14, 0x00, 0x05, 0x14, 0xFF, 0xFF})
+		conn.Write(corrupt)
+		conn.Write(powerOn)
+	})
+
+	cmd1, data, err := tx(conn, make(chan struct{}, 1), cmdRead, readPower)
+	if err != nil || cmd1 != cmdReadResponse || !bytes.Equal(data, []byte{0x00, 0x00, 0x01}) {
+		t.Errorf("tx = 0x%02X % X, %v", cmd1, data, err)
+	}
+}
+
+func TestTxChecksWidth(t *testing.T) {
+	fastTimeouts(t)
+	conn := projector(t, func(n int, request []byte, conn net.Conn) {
+		// A 2 byte value is a late reply to another command
+		conn.Write(frame(cmdReadResponse, 0x00, 0x00, 0x05, 0x00))
+		if n == 0 {
+			conn.Write(powerOn)
+		}
+	})
+
+	_, data, err := tx(conn, make(chan struct{}, 1), cmdRead, readPower)
+	if err != nil || !bytes.Equal(data, []byte{0x00, 0x00, 0x01}) {
+		t.Errorf("tx = % X, %v", data, err)
+	}
+
+	_, _, err = tx(conn, make(chan struct{}, 1), cmdRead, readPower)
+	var mismatch *ResponseError
+	if !errors.As(err, &mismatch) || mismatch.Expected != "read response with 1 value byte" {
+		t.Errorf("tx without fitting response = %v", err)
+	}
+}
+
+func TestTxMismatchIsNotStale(t *testing.T) {
+	fastTimeouts(t)
+	conn := projector(t, func(n int, request []byte, conn net.Conn) {
+		conn.Write(frame(cmdReadResponse, 0x00, 0x00, 0x05, 0x00))
+	})
+	trigger := make(chan struct{}, 1)
+
+	for i := 1; i <= maxStale; i++ {
+		var mismatch *ResponseError
+		if _, _, err := tx(conn, trigger, cmdRead, readPower); !errors.As(err, &mismatch) {
+			t.Fatalf("tx %d = %v, want a ResponseError", i, err)
+		}
+	}
+	if conn.stale != 0 || len(trigger) > 0 {
+		t.Errorf("stale = %d, reconnect = %v after answers of the wrong width", conn.stale, len(trigger) > 0)
+	}
+}
+
+func TestTxReconnectsAfterMaxStale(t *testing.T) {
+	fastTimeouts(t)
+	conn := projector(t, func(n int, request []byte, conn net.Conn) {})
+	trigger := make(chan struct{}, 1)
+
+	for i := 1; i <= maxStale; i++ {
+		if _, _, err := tx(conn, trigger, cmdRead, readPower); !errors.Is(err, ErrNoResponse) {
+			t.Fatalf("tx %d = %v, want ErrNoResponse", i, err)
+		}
+		if conn.stale != i {
+			t.Errorf("stale = %d after %d timeouts", conn.stale, i)
+		}
+		if reconnect := len(trigger) > 0; reconnect != (i == maxStale) {
+			t.Errorf("reconnect = %v after %d timeouts", reconnect, i)
+		}
+	}
+}
+
+func TestTxDiscardsLateReplies(t *testing.T) {
+	fastTimeouts(t)
+	conn := projector(t, func(n int, request []byte, conn net.Conn) {
+		switch n {
+		case 0:
+			// Answered after the timeout and the discard window
+			go func() {
+				time.Sleep(responseTimeout + staleWait + 30*time.Millisecond)
+				conn.Write(errorFrame)
+				time.Sleep(30 * time.Millisecond)
+				conn.Write(writeAnswer)
+			}()
+		case 1:
+			// The late error frame arrives first
+		case 2:
+			conn.Write(errorFrame)
+		}
+	})
+	trigger := make(chan struct{}, 1)
+
+	if _, _, err := tx(conn, trigger, cmdRead, readPower); !errors.Is(err, ErrNoResponse) {
+		t.Fatalf("tx without response = %v", err)
+	}
+	// The late error frame does not answer the write
+	cmd1, _, err := tx(conn, trigger, cmdWrite, []byte{0x34, 0x12, 0x00, 0x01})
+	if err != nil || cmd1 != cmdWriteResponse {
+		t.Errorf("tx after timeout = 0x%02X, %v, want the write response", cmd1, err)
+	}
+	// Without pending late replies the error frame is the response
+	if cmd1, _, err := tx(conn, trigger, cmdRead, readPower); err != nil || cmd1 != cmdError {
+		t.Errorf("tx = 0x%02X, %v, want the error frame", cmd1, err)
+	}
+}
+
+func TestTxDiscardWindow(t *testing.T) {
+	fastTimeouts(t)
+	conn := projector(t, func(n int, request []byte, conn net.Conn) {
+		switch n {
+		case 0:
+			// Answered shortly after the timeout
+			go func() {
+				time.Sleep(responseTimeout + staleWait/2)
+				conn.Write(powerOn)
+			}()
+		case 1:
+			conn.Write(frame(cmdReadResponse, 0x00, 0x00, 0x00))
+		}
+	})
+	trigger := make(chan struct{}, 1)
+
+	if _, _, err := tx(conn, trigger, cmdRead, readPower); !errors.Is(err, ErrNoResponse) {
+		t.Fatalf("tx without response = %v", err)
+	}
+	// The late power on is discarded before the request is sent
+	_, data, err := tx(conn, trigger, cmdRead, readPower)
+	if err != nil || !bytes.Equal(data, []byte{0x00, 0x00, 0x00}) {
+		t.Errorf("tx after timeout = % X, %v, want power off", data, err)
+	}
+	if conn.stale != 0 {
+		t.Errorf("stale = %d after a response", conn.stale)
+	}
+}
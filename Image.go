@@ -12,11 +12,11 @@ const (
 )
 
 func (conn *ViewSonic) SetSplashScreen(screen SplashScreen) error {
-	return conn.Write(0x110A, int8(screen))
+	return conn.Write(cmdSplashScreen.Code, int8(screen))
 }
 
 func (conn *ViewSonic) GetSplashScreen() (SplashScreen, error) {
-	value, err := conn.Read(0x110A) // PDF #12
+	value, err := conn.Read(cmdSplashScreen.Code)
 	if err != nil {
 		return 0, err
 	}
@@ -34,11 +34,11 @@ const (
 )
 
 func (conn *ViewSonic) SetProjectorPosition(pos ProjectorPosition) error {
-	return conn.Write(0x1200, int8(pos)) // PDF #27-30
+	return conn.Write(cmdProjectorPosition.Code, int8(pos))
 }
 
 func (conn *ViewSonic) GetProjectorPosition() (ProjectorPosition, error) {
-	value, err := conn.Read(0x1200) // PDF #31
+	value, err := conn.Read(cmdProjectorPosition.Code)
 	if err != nil {
 		return 0, err
 	}
@@ -47,28 +47,28 @@ func (conn *ViewSonic) GetProjectorPosition() (ProjectorPosition, error) {
 
 // Contrast
 func (conn *ViewSonic) IncreaseContrast() error {
-	return conn.Write(0x1202, 0x01) // PDF #43
+	return conn.Write(cmdContrast.Code, 0x01)
 }
 
 func (conn *ViewSonic) DecreaseContrast() error {
-	return conn.Write(0x1202, 0x00) // PDF #42
+	return conn.Write(cmdContrast.Code, 0x00)
 }
 
 func (conn *ViewSonic) GetContrast() (int16, error) {
-	return conn.Read2Bytes(0x1202) // PDF #44
+	return conn.Read2Bytes(cmdContrast.Code)
 }
 
 // Brightness
 func (conn *ViewSonic) IncreaseBrightness() error {
-	return conn.Write(0x1203, 0x01) // PDF #46
+	return conn.Write(cmdBrightness.Code, 0x01)
 }
 
 func (conn *ViewSonic) DecreaseBrightness() error {
-	return conn.Write(0x1203, 0x00) // PDF #45
+	return conn.Write(cmdBrightness.Code, 0x00)
 }
 
 func (conn *ViewSonic) GetBrightness() (int16, error) {
-	return conn.Read2Bytes(0x1203) // PDF #47
+	return conn.Read2Bytes(cmdBrightness.Code)
 }
 
 // Aspect ratio
@@ -87,11 +87,11 @@ const (
 )
 
 func (conn *ViewSonic) SetAspectRatio(ratio AspectRatio) error {
-	return conn.Write(0x1204, int8(ratio)) // PDF #48-56
+	return conn.Write(cmdAspectRatio.Code, int8(ratio))
 }
 
 func (conn *ViewSonic) GetAspectRatio() (AspectRatio, error) {
-	value, err := conn.Read(0x1204) // PDF #58
+	value, err := conn.Read(cmdAspectRatio.Code)
 	if err != nil {
 		return 0, err
 	}
@@ -99,12 +99,12 @@ func (conn *ViewSonic) GetAspectRatio() (AspectRatio, error) {
 }
 
 func (conn *ViewSonic) CycleAspectRatio() error {
-	return conn.Write(0x1331, 0x00) // PDF #57
+	return conn.Write(cmdCycleAspectRatio.Code, 0x00)
 }
 
 // Auto Adjust
 func (conn *ViewSonic) AutoAdjust() error {
-	return conn.Write(0x1205, 0x00) // PDF #59
+	return conn.Write(cmdAutoAdjust.Code, 0x00)
 }
 
 // Blank
@@ -113,11 +113,11 @@ func (conn *ViewSonic) SetBlank(blank bool) error {
 	if blank {
 		value = 0x01 // On
 	}
-	return conn.Write(0x1209, value) // PDF #71, 72
+	return conn.Write(cmdBlank.Code, value)
 }
 
 func (conn *ViewSonic) GetBlank() (bool, error) {
-	val, err := conn.Read(0x1209) // PDF #73
+	val, err := conn.Read(cmdBlank.Code)
 	return val == 0x01, err
 }
 
@@ -127,11 +127,11 @@ func (conn *ViewSonic) SetFreeze(freeze bool) error {
 	if freeze {
 		value = 0x01
 	}
-	return conn.Write(0x1300, value) // PDF #112, 113
+	return conn.Write(cmdFreeze.Code, value)
 }
 
 func (conn *ViewSonic) GetFreeze() (bool, error) {
-	val, err := conn.Read(0x1300) // PDF #114
+	val, err := conn.Read(cmdFreeze.Code)
 	return val == 0x01, err
 }
 
@@ -140,10 +140,10 @@ func (conn *ViewSonic) SetOverScan(value int8) error {
 	if value > 5 {
 		value = 5
 	}
-	return conn.Write(0x1133, value) // PDF #205-210
+	return conn.Write(cmdOverScan.Code, value)
 }
 func (conn *ViewSonic) GetOverScan() (int8, error) {
-	return conn.Read(0x1133) // PDF #211
+	return conn.Read(cmdOverScan.Code)
 }
 
 // 3D Sync Mode
@@ -159,11 +159,11 @@ const (
 )
 
 func (conn *ViewSonic) SetThreeDSyncMode(mode ThreeDSyncMode) error {
-	return conn.Write(0x1220, int8(mode)) // PDF #32-37
+	return conn.Write(cmdThreeDSyncMode.Code, int8(mode))
 }
 
 func (conn *ViewSonic) GetThreeDSyncMode() (ThreeDSyncMode, error) {
-	value, err := conn.Read(0x1220) // PDF #38
+	value, err := conn.Read(cmdThreeDSyncMode.Code)
 	if err != nil {
 		return 0, err
 	}
@@ -175,10 +175,10 @@ func (conn *ViewSonic) SetThreeDSyncInvert(enable bool) error {
 	if enable {
 		value = 0x01
 	}
-	return conn.Write(0x1221, value) // PDF #39, 40
+	return conn.Write(cmdThreeDSyncInvert.Code, value)
 }
 
 func (conn *ViewSonic) GetThreeDSyncInvert() (bool, error) {
-	value, err := conn.Read(0x1221) // PDF #41
+	value, err := conn.Read(cmdThreeDSyncInvert.Code)
 	return value == 0x01, err
 }
@@ -22,11 +22,11 @@ const (
 )
 
 func (conn *ViewSonic) SetSourceInput(input SourceInput) error {
-	return conn.Write(0x1301, int8(input)) // PDF #115-129
+	return conn.Write(cmdSourceInput.Code, int8(input))
 }
 
 func (conn *ViewSonic) GetSourceInput() (SourceInput, error) {
-	value, err := conn.Read(0x1301) // PDF #130
+	value, err := conn.Read(cmdSourceInput.Code)
 	if err != nil {
 		return 0, err
 	}
@@ -39,11 +39,11 @@ func (conn *ViewSonic) SetQuickAutoSearch(enable bool) error {
 	if enable {
 		value = 0x01
 	}
-	return conn.Write(0x1302, value) // PDF #131, 132
+	return conn.Write(cmdQuickAutoSearch.Code, value)
 }
 
 func (conn *ViewSonic) GetQuickAutoSearch() (bool, error) {
-	val, err := conn.Read(0x1302) // PDF #133
+	val, err := conn.Read(cmdQuickAutoSearch.Code)
 	return val == 0x01, err
 }
 
@@ -57,10 +57,10 @@ const (
 )
 
 func (conn *ViewSonic) SetHdmiFormat(format HdmiFormat) error {
-	return conn.Write(0x1128, int8(format)) // PDF #166-168
+	return conn.Write(cmdHdmiFormat.Code, int8(format))
 }
 func (conn *ViewSonic) GetHdmiFormat() (HdmiFormat, error) {
-	val, err := conn.Read(0x1128) // PDF #169
+	val, err := conn.Read(cmdHdmiFormat.Code)
 	return HdmiFormat(val), err
 }
 
@@ -74,10 +74,10 @@ const (
 )
 
 func (conn *ViewSonic) SetHdmiRange(r HdmiRange) error {
-	return conn.Write(0x1129, int8(r)) // PDF #170-172
+	return conn.Write(cmdHdmiRange.Code, int8(r))
 }
 func (conn *ViewSonic) GetHdmiRange() (HdmiRange, error) {
-	val, err := conn.Read(0x1129) // PDF #173
+	val, err := conn.Read(cmdHdmiRange.Code)
 	return HdmiRange(val), err
 }
 
@@ -87,10 +87,10 @@ func (conn *ViewSonic) SetCEC(enable bool) error {
 	if enable {
 		value = 0x01
 	}
-	return conn.Write(0x112B, value) // PDF #174, 175
+	return conn.Write(cmdCEC.Code, value)
 }
 func (conn *ViewSonic) GetCEC() (bool, error) {
-	val, err := conn.Read(0x112B) // PDF #176
+	val, err := conn.Read(cmdCEC.Code)
 	return val == 0x01, err
 }
 
@@ -100,11 +100,11 @@ func (conn *ViewSonic) ShiftHorizontalPosition(right bool) error {
 	if right {
 		value = 0x01
 	}
-	return conn.Write(0x1206, value) // PDF #60, 61
+	return conn.Write(cmdHorizontalPosition.Code, value)
 }
 
 func (conn *ViewSonic) GetHorizontalPosition() (int8, error) {
-	return conn.Read(0x1206) // PDF #62
+	return conn.Read(cmdHorizontalPosition.Code)
 }
 
 func (conn *ViewSonic) ShiftVerticalPosition(up bool) error {
@@ -112,34 +112,34 @@ func (conn *ViewSonic) ShiftVerticalPosition(up bool) error {
 	if !up {            // down
 		value = 0x01
 	}
-	return conn.Write(0x1207, value) // PDF #63, 64
+	return conn.Write(cmdVerticalPosition.Code, value)
 }
 
 func (conn *ViewSonic) GetVerticalPosition() (int8, error) {
-	return conn.Read(0x1207) // PDF #65
+	return conn.Read(cmdVerticalPosition.Code)
 }
 
 // Keystone
 func (conn *ViewSonic) IncreaseKeystoneVertical() error {
-	return conn.Write(0x120A, 0x01) // PDF #75
+	return conn.Write(cmdKeystoneVertical.Code, 0x01)
 }
 
 func (conn *ViewSonic) DecreaseKeystoneVertical() error {
-	return conn.Write(0x120A, 0x00) // PDF #74
+	return conn.Write(cmdKeystoneVertical.Code, 0x00)
 }
 
 func (conn *ViewSonic) GetKeystoneVertical() (int8, error) {
-	return conn.Read(0x120A) // PDF #76
+	return conn.Read(cmdKeystoneVertical.Code)
 }
 
 func (conn *ViewSonic) IncreaseKeystoneHorizontal() error {
-	return conn.Write(0x1131, 0x01) // PDF #78
+	return conn.Write(cmdKeystoneHorizontal.Code, 0x01)
 }
 
 func (conn *ViewSonic) DecreaseKeystoneHorizontal() error {
-	return conn.Write(0x1131, 0x00) // PDF #77
+	return conn.Write(cmdKeystoneHorizontal.Code, 0x00)
 }
 
 func (conn *ViewSonic) GetKeystoneHorizontal() (int8, error) {
-	return conn.Read(0x1131) // PDF #79
+	return conn.Read(cmdKeystoneHorizontal.Code)
 }
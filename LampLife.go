@@ -0,0 +1,457 @@
+package viewsonic
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"log"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+)
+
+// LampSample is a single reading of the light source usage counter.
+type LampSample struct {
+	Time  time.Time       `json:"time"`
+	Hours uint32          `json:"hours"`
+	Mode  LightSourceMode `json:"mode"`
+}
+
+// LampReset records a reset of the light source usage counter.
+// External is set if the reset was not done through the tracker but detected
+// because the counter went backwards.
+type LampReset struct {
+	Time        time.Time `json:"time"`
+	HoursBefore uint32    `json:"hoursBefore"`
+	External    bool      `json:"external,omitempty"`
+}
+
+// LampHistory is everything the tracker knows about one projector.
+// It is what gets persisted in the LampStore.
+type LampHistory struct {
+	Projector string       `json:"projector"`
+	Samples   []LampSample `json:"samples"`
+	Resets    []LampReset  `json:"resets"`
+	// Raised holds the alert thresholds already raised since the last reset,
+	// so a restart of the application does not repeat them.
+	Raised []float64 `json:"raised,omitempty"`
+}
+
+// LampStore persists the lamp history of a projector.
+type LampStore interface {
+	Load(projector string) (*LampHistory, error)
+	Save(history *LampHistory) error
+}
+
+// FileLampStore stores one JSON file per projector in Dir. The file name is the escaped
+// projector name, so names containing slashes get their own file.
+type FileLampStore struct {
+	Dir string
+}
+
+func (s FileLampStore) path(projector string) string {
+	return filepath.Join(s.Dir, url.PathEscape(projector)+".lamp.json")
+}
+
+// Load returns the stored history or an empty one if nothing was stored yet.
+func (s FileLampStore) Load(projector string) (*LampHistory, error) {
+	data, err := os.ReadFile(s.path(projector))
+	if errors.Is(err, fs.ErrNotExist) {
+		return &LampHistory{Projector: projector}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	history := &LampHistory{}
+	if err := json.Unmarshal(data, history); err != nil {
+		return nil, fmt.Errorf("invalid lamp history %v: %w", s.path(projector), err)
+	}
+	history.Projector = projector
+	return history, nil
+}
+
+// Save writes the history atomically by writing to a temporary file first.
+func (s FileLampStore) Save(history *LampHistory) error {
+	if err := os.MkdirAll(s.Dir, 0o755); err != nil {
+		return err
+	}
+
+	data, err := json.MarshalIndent(history, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	tmp := s.path(history.Projector) + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, s.path(history.Projector))
+}
+
+// LampAlertLevel is the severity of a maintenance alert.
+type LampAlertLevel int
+
+const (
+	LampAlertNotice LampAlertLevel = iota
+	LampAlertWarning
+	LampAlertCritical
+)
+
+func (l LampAlertLevel) String() string {
+	switch l {
+	case LampAlertNotice:
+		return "notice"
+	case LampAlertWarning:
+		return "warning"
+	case LampAlertCritical:
+		return "critical"
+	}
+	return fmt.Sprintf("LampAlertLevel(%d)", int(l))
+}
+
+// LampAlert is raised when the usage crosses a threshold of the rated life
+// or the forecasted end of life comes closer than the configured lead time.
+type LampAlert struct {
+	Projector string
+	Level     LampAlertLevel
+	Hours     uint32
+	RatedLife uint32
+	Threshold float64 // fraction of the rated life, 0 for lead time alerts
+	Forecast  time.Time
+	Message   string
+}
+
+// LampForecast is the prediction of when the rated life will be reached.
+// EndOfLife is the zero time if there is not enough data for a prediction.
+type LampForecast struct {
+	Hours          uint32
+	RatedLife      uint32
+	RemainingHours int64
+	BurnRate       float64 // usage hours per wall clock hour
+	EndOfLife      time.Time
+}
+
+// LampTracker records the light source usage of a projector over time,
+// computes the burn rate per LightSourceMode and forecasts the end of life.
+type LampTracker struct {
+	// RatedLife is the rated light source life in hours.
+	RatedLife uint32
+	// Thresholds are fractions of RatedLife that raise an alert once per lamp.
+	// The last threshold is critical, the one before a warning, all others notices.
+	Thresholds []float64
+	// LeadTime raises a warning once the forecasted end of life is closer than LeadTime.
+	// Zero disables the lead time alert.
+	LeadTime time.Duration
+	// MaxSamples limits the stored history, older samples are dropped first.
+	MaxSamples int
+	// OnAlert is called for each raised alert. It must not block.
+	OnAlert func(LampAlert)
+
+	conn    *ViewSonic
+	name    string
+	store   LampStore
+	mutex   sync.Mutex
+	history *LampHistory
+	// resets counts the resets through the tracker, samples read across one are dropped
+	resets int
+}
+
+// leadTimeThreshold marks the lead time alert in LampHistory.Raised.
+const leadTimeThreshold = -1
+
+// NewLampTracker loads the history of the named projector from the store. It reads with
+// PriorityPolling.
+func NewLampTracker(conn *ViewSonic, name string, store LampStore, ratedLife uint32) (*LampTracker, error) {
+	history, err := store.Load(name)
+	if err != nil {
+		return nil, err
+	}
+
+	return &LampTracker{
+		RatedLife:  ratedLife,
+		Thresholds: []float64{0.8, 0.9, 1.0},
+		MaxSamples: 10000,
+		conn:       conn.WithPriority(PriorityPolling),
+		name:       name,
+		store:      store,
+		history:    history,
+	}, nil
+}
+
+// Sample reads the usage time and light source mode from the projector and records them.
+// A sample read while Reset runs is returned but not recorded.
+func (t *LampTracker) Sample() (LampSample, error) {
+	t.mutex.Lock()
+	resets := t.resets
+	t.mutex.Unlock()
+
+	hours, err := t.conn.GetLightSourceUsageTime()
+	if err != nil {
+		return LampSample{}, err
+	}
+
+	mode, err := t.conn.GetLightSourceMode()
+	if err != nil {
+		return LampSample{}, err
+	}
+
+	sample := LampSample{Time: time.Now(), Hours: hours, Mode: mode}
+	return sample, t.record(sample, resets)
+}
+
+// Record adds a sample to the history, detects external resets,
+// raises alerts and persists the history.
+func (t *LampTracker) Record(sample LampSample) error {
+	t.mutex.Lock()
+	resets := t.resets
+	t.mutex.Unlock()
+	return t.record(sample, resets)
+}
+
+// record adds the sample unless the tracker reset the counter since the given number of
+// resets, the sample may then hold the hours before the reset.
+func (t *LampTracker) record(sample LampSample, resets int) error {
+	t.mutex.Lock()
+	if resets != t.resets {
+		t.mutex.Unlock()
+		return nil
+	}
+	h := t.history
+
+	if n := len(h.Samples); n > 0 && sample.Hours < h.Samples[n-1].Hours {
+		h.Resets = append(h.Resets, LampReset{
+			Time:        sample.Time,
+			HoursBefore: h.Samples[n-1].Hours,
+			External:    true,
+		})
+		h.Raised = nil
+	}
+
+	h.Samples = append(h.Samples, sample)
+	t.trim()
+
+	alerts := t.checkAlerts()
+	err := t.store.Save(h)
+	t.mutex.Unlock()
+
+	for _, alert := range alerts {
+		log.Printf("lamp %v: %v", alert.Level, alert.Message)
+		if t.OnAlert != nil {
+			t.OnAlert(alert)
+		}
+	}
+	return err
+}
+
+// Reset resets the usage time on the projector and logs the reset. Samples wait for it.
+func (t *LampTracker) Reset() error {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	hours, err := t.conn.GetLightSourceUsageTime()
+	if err != nil {
+		return err
+	}
+
+	if err := t.conn.ResetLightSourceUsageTime(); err != nil {
+		return err
+	}
+	t.resets++
+
+	now := time.Now()
+	t.history.Resets = append(t.history.Resets, LampReset{Time: now, HoursBefore: hours})
+	t.history.Raised = nil
+	// Add a zero sample, so the next sample is not detected as an external reset
+	t.history.Samples = append(t.history.Samples, LampSample{Time: now, Hours: 0})
+	if n := len(t.history.Samples); n > 1 {
+		t.history.Samples[n-1].Mode = t.history.Samples[n-2].Mode
+	}
+	t.trim()
+	return t.store.Save(t.history)
+}
+
+// trim drops the oldest samples beyond MaxSamples. The caller must hold the mutex.
+func (t *LampTracker) trim() {
+	if h := t.history; t.MaxSamples > 0 && len(h.Samples) > t.MaxSamples {
+		h.Samples = h.Samples[len(h.Samples)-t.MaxSamples:]
+	}
+}
+
+// History returns a copy of the recorded history.
+func (t *LampTracker) History() LampHistory {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	h := *t.history
+	h.Samples = append([]LampSample(nil), h.Samples...)
+	h.Resets = append([]LampReset(nil), h.Resets...)
+	h.Raised = append([]float64(nil), h.Raised...)
+	return h
+}
+
+// BurnRates returns the usage hours per wall clock hour for each LightSourceMode.
+// The time between two samples is attributed to the mode of the earlier sample.
+// Intervals containing a reset are ignored.
+func (t *LampTracker) BurnRates() map[LightSourceMode]float64 {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	usage, elapsed := t.burn()
+	rates := make(map[LightSourceMode]float64, len(usage))
+	for mode, u := range usage {
+		if elapsed[mode] > 0 {
+			rates[mode] = u / elapsed[mode]
+		}
+	}
+	return rates
+}
+
+func (t *LampTracker) burn() (usage, elapsed map[LightSourceMode]float64) {
+	usage = map[LightSourceMode]float64{}
+	elapsed = map[LightSourceMode]float64{}
+
+	samples := t.history.Samples
+	for i := 1; i < len(samples); i++ {
+		prev, cur := samples[i-1], samples[i]
+		if cur.Hours < prev.Hours || !cur.Time.After(prev.Time) {
+			continue
+		}
+		usage[prev.Mode] += float64(cur.Hours - prev.Hours)
+		elapsed[prev.Mode] += cur.Time.Sub(prev.Time).Hours()
+	}
+	return usage, elapsed
+}
+
+// Forecast predicts when the rated life is reached. It uses the burn rate of the
+// current mode if known, otherwise the average burn rate over all modes.
+func (t *LampTracker) Forecast() (LampForecast, error) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	return t.forecast()
+}
+
+func (t *LampTracker) forecast() (LampForecast, error) {
+	samples := t.history.Samples
+	if len(samples) == 0 {
+		return LampForecast{}, fmt.Errorf("no lamp samples recorded")
+	}
+	last := samples[len(samples)-1]
+
+	f := LampForecast{
+		Hours:          last.Hours,
+		RatedLife:      t.RatedLife,
+		RemainingHours: int64(t.RatedLife) - int64(last.Hours),
+	}
+
+	usage, elapsed := t.burn()
+	if elapsed[last.Mode] > 0 {
+		f.BurnRate = usage[last.Mode] / elapsed[last.Mode]
+	} else {
+		var u, e float64
+		for mode := range usage {
+			u += usage[mode]
+			e += elapsed[mode]
+		}
+		if e > 0 {
+			f.BurnRate = u / e
+		}
+	}
+
+	switch {
+	case f.RemainingHours <= 0:
+		f.EndOfLife = last.Time
+	case f.BurnRate > 0:
+		wall := float64(f.RemainingHours) / f.BurnRate
+		f.EndOfLife = last.Time.Add(time.Duration(wall * float64(time.Hour)))
+	}
+	return f, nil
+}
+
+// checkAlerts returns the alerts not raised yet and marks them as raised.
+func (t *LampTracker) checkAlerts() []LampAlert {
+	if t.RatedLife == 0 {
+		return nil
+	}
+
+	f, err := t.forecast()
+	if err != nil {
+		return nil
+	}
+
+	thresholds := append([]float64(nil), t.Thresholds...)
+	sort.Float64s(thresholds)
+
+	var alerts []LampAlert
+	usage := float64(f.Hours) / float64(t.RatedLife)
+	for i, threshold := range thresholds {
+		if usage < threshold || t.raised(threshold) {
+			continue
+		}
+		level := LampAlertNotice
+		switch i {
+		case len(thresholds) - 1:
+			level = LampAlertCritical
+		case len(thresholds) - 2:
+			level = LampAlertWarning
+		}
+		t.history.Raised = append(t.history.Raised, threshold)
+		alerts = append(alerts, LampAlert{
+			Projector: t.name,
+			Level:     level,
+			Hours:     f.Hours,
+			RatedLife: t.RatedLife,
+			Threshold: threshold,
+			Forecast:  f.EndOfLife,
+			Message: fmt.Sprintf("%v: light source at %d of %d hours (%.0f%%)",
+				t.name, f.Hours, t.RatedLife, usage*100),
+		})
+	}
+
+	if t.LeadTime > 0 && !f.EndOfLife.IsZero() && !t.raised(leadTimeThreshold) &&
+		time.Until(f.EndOfLife) < t.LeadTime {
+		t.history.Raised = append(t.history.Raised, leadTimeThreshold)
+		alerts = append(alerts, LampAlert{
+			Projector: t.name,
+			Level:     LampAlertWarning,
+			Hours:     f.Hours,
+			RatedLife: t.RatedLife,
+			Forecast:  f.EndOfLife,
+			Message: fmt.Sprintf("%v: light source end of life expected on %v",
+				t.name, f.EndOfLife.Format(time.DateOnly)),
+		})
+	}
+	return alerts
+}
+
+func (t *LampTracker) raised(threshold float64) bool {
+	for _, r := range t.history.Raised {
+		if r == threshold {
+			return true
+		}
+	}
+	return false
+}
+
+// Run samples the projector every interval until the context is cancelled.
+func (t *LampTracker) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := t.Sample(); err != nil {
+			log.Printf("lamp sample failed for %v: %v", t.name, err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
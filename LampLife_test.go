@@ -0,0 +1,64 @@
+package viewsonic
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/m-baertschi/viewsonic/emulator"
+)
+
+func TestLampResetDropsSamplesReadBefore(t *testing.T) {
+	e := emulator.New()
+	if err := e.Listen("127.0.0.1:0"); err != nil {
+		t.Fatal(err)
+	}
+	defer e.Close()
+	conn := New(e.Addr())
+	defer conn.Close()
+
+	tracker, err := NewLampTracker(conn, "hall", FileLampStore{Dir: t.TempDir()}, 20000)
+	if err != nil {
+		t.Fatal(err)
+	}
+	before, err := tracker.Sample()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// A sample read before the reset is recorded after it
+	resets := tracker.resets
+	if err := tracker.Reset(); err != nil {
+		t.Fatal(err)
+	}
+	if err := tracker.record(before, resets); err != nil {
+		t.Fatal(err)
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 5; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			if _, err := tracker.Sample(); err != nil {
+				t.Error(err)
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			if err := tracker.Reset(); err != nil {
+				t.Error(err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	h := tracker.History()
+	if len(h.Resets) != 6 {
+		t.Errorf("%d resets, want 6", len(h.Resets))
+	}
+	for _, r := range h.Resets {
+		if r.External {
+			t.Errorf("external reset %+v", r)
+		}
+	}
+}
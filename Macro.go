@@ -0,0 +1,241 @@
+package viewsonic
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DefaultMacroKeyDelay is the pause after each key press if a macro does not set one.
+// The OSD drops key presses that arrive faster than it can redraw.
+const DefaultMacroKeyDelay = 400 * time.Millisecond
+
+// macroKeyAliases are key names of macro sequences in addition to the RemoteKey names.
+var macroKeyAliases = map[string]RemoteKey{
+	"up":   RemoteKeyTop,
+	"down": RemoteKeyBottom,
+}
+
+// MacroStep presses Key Count times, or only waits for Wait if Count is 0.
+type MacroStep struct {
+	Key   RemoteKey
+	Count int
+	Wait  time.Duration
+}
+
+func (s MacroStep) String() string {
+	if s.Count == 0 {
+		return "Wait " + s.Wait.String()
+	}
+	name := s.Key.String()
+	if s.Count > 1 {
+		return fmt.Sprintf("%v×%d", name, s.Count)
+	}
+	return name
+}
+
+var macroStepPattern = regexp.MustCompile(`^([A-Za-z]+)\s*(?:[×xX*]\s*(\d+))?$`)
+
+// ParseMacroSequence parses a comma separated key sequence like
+// "Menu, Down×3, Right, Enter, Wait 2s, Exit". Repeats may also be written as
+// "Down x3" or "Down*3". Keys are the case insensitive RemoteKey names, Up and Down are
+// accepted for Top and Bottom.
+func ParseMacroSequence(sequence string) ([]MacroStep, error) {
+	var steps []MacroStep
+	for _, token := range strings.Split(sequence, ",") {
+		token = strings.TrimSpace(token)
+		if token == "" {
+			continue
+		}
+
+		if name, arg, ok := strings.Cut(token, " "); ok && strings.EqualFold(name, "wait") {
+			wait, err := time.ParseDuration(strings.TrimSpace(arg))
+			if err != nil || wait <= 0 {
+				return nil, fmt.Errorf("invalid wait %q", token)
+			}
+			steps = append(steps, MacroStep{Wait: wait})
+			continue
+		}
+
+		m := macroStepPattern.FindStringSubmatch(token)
+		if m == nil {
+			return nil, fmt.Errorf("invalid macro step %q", token)
+		}
+		key, ok := macroKeyAliases[strings.ToLower(m[1])]
+		if !ok {
+			var err error
+			if key, err = ParseRemoteKey(m[1]); err != nil {
+				return nil, err
+			}
+		}
+		count := 1
+		if m[2] != "" {
+			n, err := strconv.Atoi(m[2])
+			if err != nil || n < 1 || n > 99 {
+				return nil, fmt.Errorf("invalid repeat count in %q", token)
+			}
+			count = n
+		}
+		steps = append(steps, MacroStep{Key: key, Count: count})
+	}
+
+	if len(steps) == 0 {
+		return nil, fmt.Errorf("empty macro sequence")
+	}
+	return steps, nil
+}
+
+// FormatMacroSequence is the inverse of ParseMacroSequence.
+func FormatMacroSequence(steps []MacroStep) string {
+	parts := make([]string, len(steps))
+	for i, s := range steps {
+		parts[i] = s.String()
+	}
+	return strings.Join(parts, ", ")
+}
+
+// Macro is a named key sequence played on the virtual remote control. Macros reach
+// OSD settings that have no RS-232 command, at the cost of depending on the menu
+// layout of the projector model and firmware.
+type Macro struct {
+	Name  string
+	Steps []MacroStep
+	// KeyDelay is the pause after each key press, DefaultMacroKeyDelay if zero.
+	KeyDelay time.Duration
+}
+
+// NewMacro creates a macro from a key sequence, see ParseMacroSequence.
+func NewMacro(name, sequence string) (*Macro, error) {
+	steps, err := ParseMacroSequence(sequence)
+	if err != nil {
+		return nil, fmt.Errorf("macro %v: %w", name, err)
+	}
+	return &Macro{Name: name, Steps: steps}, nil
+}
+
+// Duration is the expected playing time of the macro.
+func (m *Macro) Duration() time.Duration {
+	var d time.Duration
+	for _, s := range m.Steps {
+		d += time.Duration(s.Count)*m.keyDelay() + s.Wait
+	}
+	return d
+}
+
+func (m *Macro) keyDelay() time.Duration {
+	if m.KeyDelay > 0 {
+		return m.KeyDelay
+	}
+	return DefaultMacroKeyDelay
+}
+
+// Play sends the key sequence to the projector. Macros on the same connection are played
+// one after another, as interleaved key presses would end up anywhere in the OSD.
+// If the context is cancelled or a key fails, Play stops and the OSD stays where it is.
+func (m *Macro) Play(ctx context.Context, conn *ViewSonic) error {
+	conn.macroMutex.Lock()
+	defer conn.macroMutex.Unlock()
+
+	delay := m.keyDelay()
+	for _, s := range m.Steps {
+		for range s.Count {
+			if err := conn.SendRemoteKey(s.Key); err != nil {
+				return fmt.Errorf("macro %v: %v: %w", m.Name, s, err)
+			}
+			if err := sleep(ctx, delay); err != nil {
+				return err
+			}
+		}
+		if err := sleep(ctx, s.Wait); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func sleep(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+type macroJSON struct {
+	Sequence string `json:"sequence"`
+	KeyDelay string `json:"keyDelay,omitempty"`
+}
+
+// MarshalJSON encodes the macro as {"sequence": "Menu, Bottom×3, Enter", "keyDelay": "500ms"}.
+// The name is the key of the macro in the config.
+func (m *Macro) MarshalJSON() ([]byte, error) {
+	v := macroJSON{Sequence: FormatMacroSequence(m.Steps)}
+	if m.KeyDelay > 0 {
+		v.KeyDelay = m.KeyDelay.String()
+	}
+	return json.Marshal(v)
+}
+
+func (m *Macro) UnmarshalJSON(data []byte) error {
+	var v macroJSON
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+
+	steps, err := ParseMacroSequence(v.Sequence)
+	if err != nil {
+		return err
+	}
+	m.Steps = steps
+
+	m.KeyDelay = 0
+	if v.KeyDelay != "" {
+		if m.KeyDelay, err = time.ParseDuration(v.KeyDelay); err != nil {
+			return fmt.Errorf("invalid key delay: %w", err)
+		}
+	}
+	return nil
+}
+
+// LoadMacros reads named macros from a JSON config file of the form
+//
+//	{"lens-menu": {"sequence": "Menu, Down×3, Right, Enter", "keyDelay": "500ms"}}
+func LoadMacros(path string) (map[string]*Macro, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var macros map[string]*Macro
+	if err := json.Unmarshal(data, &macros); err != nil {
+		return nil, fmt.Errorf("macros %v: %w", path, err)
+	}
+	for name, m := range macros {
+		if m == nil {
+			return nil, fmt.Errorf("macros %v: %v is empty", path, name)
+		}
+		m.Name = name
+	}
+	return macros, nil
+}
+
+// SaveMacros writes the macros to a JSON config file readable by LoadMacros.
+func SaveMacros(path string, macros map[string]*Macro) error {
+	data, err := json.MarshalIndent(macros, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, append(data, '\n'), 0o644)
+}
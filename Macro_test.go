@@ -0,0 +1,23 @@
+package viewsonic
+
+import "testing"
+
+func TestMacroSequenceUsesRemoteKeyNames(t *testing.T) {
+	steps, err := ParseMacroSequence("menu, Down×3, top x2, MyButton, Wait 2s, Exit")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := "Menu, Bottom×3, Top×2, MyButton, Wait 2s, Exit"
+	if got := FormatMacroSequence(steps); got != want {
+		t.Errorf("formatted %q, want %q", got, want)
+	}
+	for _, key := range RemoteKeyValues() {
+		steps, err := ParseMacroSequence(FormatMacroSequence([]MacroStep{{Key: key, Count: 1}}))
+		if err != nil || len(steps) != 1 || steps[0].Key != key {
+			t.Errorf("%v: parsed %v, %v", key, steps, err)
+		}
+	}
+	if _, err := ParseMacroSequence("Menu, Power"); err == nil {
+		t.Error("unknown key accepted")
+	}
+}
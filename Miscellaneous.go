@@ -11,11 +11,11 @@ func (conn *ViewSonic) SetHighAltitudeMode(enable bool) error {
 	if enable {
 		value = 0x01
 	}
-	return conn.Write(0x110C, value) // PDF #16, 17
+	return conn.Write(cmdHighAltitudeMode.Code, value)
 }
 
 func (conn *ViewSonic) GetHighAltitudeMode() (bool, error) {
-	value, err := conn.Read(0x110C) // PDF #18
+	value, err := conn.Read(cmdHighAltitudeMode.Code)
 	return value == 0x01, err
 }
 
@@ -25,11 +25,11 @@ func (conn *ViewSonic) SetMessageDisplay(enable bool) error {
 	if enable {
 		value = 0x01
 	}
-	return conn.Write(0x1127, value) // PDF #24, 25
+	return conn.Write(cmdMessageDisplay.Code, value)
 }
 
 func (conn *ViewSonic) GetMessageDisplay() (bool, error) {
-	value, err := conn.Read(0x1127) // PDF #26
+	value, err := conn.Read(cmdMessageDisplay.Code)
 	return value == 0x01, err
 }
 
@@ -62,11 +62,11 @@ const (
 )
 
 func (conn *ViewSonic) SetLanguage(lang Language) error {
-	return conn.Write(0x1500, int8(lang)) // PDF #141-162
+	return conn.Write(cmdLanguage.Code, int8(lang))
 }
 
 func (conn *ViewSonic) GetLanguage() (Language, error) {
-	val, err := conn.Read(0x1500) // PDF #163
+	val, err := conn.Read(cmdLanguage.Code)
 	return Language(val), err
 }
 
@@ -75,10 +75,10 @@ func (conn *ViewSonic) SetRemoteControlCode(code int8) error {
 	if code < 1 || code > 8 {
 		return fmt.Errorf("remote code must be between 1 and 8")
 	}
-	return conn.Write(0x0C48, int8(code-1)) // PDF #190-197: code 1 is value 0
+	return conn.Write(cmdRemoteControlCode.Code, int8(code-1)) // code 1 is value 0
 }
 func (conn *ViewSonic) GetRemoteControlCode() (int8, error) {
-	val, err := conn.Read(0x0C48) // PDF #198
+	val, err := conn.Read(cmdRemoteControlCode.Code)
 	return val + 1, err
 }
 
@@ -100,20 +100,20 @@ const (
 
 // 0x02 0x14 0x00 0x04 0x00 | 0x34 | 0x02 0x04 0x0F 0x61
 func (conn *ViewSonic) SendRemoteKey(key RemoteKey) error {
-	return conn.WriteKey(0x0204, uint8(key))
+	return conn.WriteKey(cmdRemoteKey.Code, uint8(key))
 }
 
 // Light Source
 func (conn *ViewSonic) ResetLightSourceUsageTime() error {
-	return conn.Write(0x1501, 0x00) // PDF #164
+	return conn.Write(cmdLightSourceUsageTime.Code, 0x00)
 }
 
 func (conn *ViewSonic) GetLightSourceUsageTime() (uint32, error) {
-	data, err := conn.ReadNBytes(0x1501) // PDF #165
+	data, err := conn.ReadNBytes(cmdLightSourceUsageTime.Code)
 	if err != nil {
 		return 0, err
 	}
-	if len(data) < 4 {
+	if len(data) < 2+cmdLightSourceUsageTime.Width {
 		return 0, fmt.Errorf("not enough data for usage time")
 	}
 	// Note 4: HEX2DEC(ddccbbaa)
@@ -130,11 +130,11 @@ const (
 )
 
 func (conn *ViewSonic) SetLightSourceMode(mode LightSourceMode) error {
-	return conn.Write(0x1110, int8(mode)) // PDF #19-22
+	return conn.Write(cmdLightSourceMode.Code, int8(mode))
 }
 
 func (conn *ViewSonic) GetLightSourceMode() (LightSourceMode, error) {
-	value, err := conn.Read(0x1110) // PDF #23
+	value, err := conn.Read(cmdLightSourceMode.Code)
 	if err != nil {
 		return 0, err
 	}
@@ -142,5 +142,5 @@ func (conn *ViewSonic) GetLightSourceMode() (LightSourceMode, error) {
 }
 
 func (conn *ViewSonic) CycleLampMode() error {
-	return conn.Write(0x1336, 0x00) // PDF #224
+	return conn.Write(cmdCycleLampMode.Code, 0x00)
 }
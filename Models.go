@@ -0,0 +1,190 @@
+package viewsonic
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// ErrUnsupported is returned without contacting the projector when a command or a value is
+// not supported by the model set with SetModel.
+var ErrUnsupported = errors.New("not supported by the projector model")
+
+// Model is the capability profile of a projector model: the commands it answers and, for
+// enum commands, the values it accepts.
+type Model struct {
+	Name string
+	// Commands lists the supported command codes.
+	Commands []uint16
+	// Values restricts the written values of a command code, e.g. the sources of the model.
+	// Commands without an entry accept every value.
+	Values map[uint16][]int8
+}
+
+// ModelV119 supports every command and value of the RS-232 table v1.19. Models without their
+// own profile mostly implement a subset of it.
+var ModelV119 = &Model{
+	Name:     "v1.19",
+	Commands: v119Commands(),
+}
+
+// ModelLS920WU follows the LS920WU/LS921WU RS-232 table R1.00. The projector has no 0x1126
+// status command and uses the color temperature values 0x00-0x04 for 5500K-9500K.
+var ModelLS920WU = lsModel("LS920WU")
+
+// ModelLS921WU shares the RS-232 table of the LS920WU.
+var ModelLS921WU = lsModel("LS921WU")
+
+// Models lists the known model profiles.
+var Models = []*Model{ModelV119, ModelLS920WU, ModelLS921WU}
+
+// LookupModel returns the model with the case insensitive name, or nil.
+func LookupModel(name string) *Model {
+	for _, m := range Models {
+		if strings.EqualFold(m.Name, name) {
+			return m
+		}
+	}
+	return nil
+}
+
+func v119Commands() []uint16 {
+	var codes []uint16
+	for _, c := range Commands {
+		if len(c.Rows) > 0 {
+			codes = append(codes, c.Code)
+		}
+	}
+	return codes
+}
+
+func lsModel(name string) *Model {
+	return &Model{
+		Name: name,
+		Commands: codes(
+			cmdPower, cmdPowerOff, cmdTogglePower, cmdResetAllSettings, cmdResetCurrentColorSettings,
+			cmdQuickPowerOff, cmdErrorStatus, cmdOperatingTemperature,
+			cmdSplashScreen, cmdProjectorPosition, cmdContrast, cmdBrightness, cmdAspectRatio,
+			cmdCycleAspectRatio, cmdBlank, cmdFreeze, cmdOverScan, cmdThreeDSyncMode,
+			cmdThreeDSyncInvert, cmdHdrMode, cmdEotf, cmdDigitalLensShiftVertical,
+			cmdDigitalLensShiftHorizontal,
+			cmdSourceInput, cmdQuickAutoSearch, cmdHdmiFormat, cmdHdmiRange, cmdKeystoneVertical,
+			cmdKeystoneHorizontal,
+			cmdColorTemperature, cmdColorMode, cmdCycleColorMode, cmdPrimaryColor, cmdSaturation,
+			cmdSharpness, cmdBrilliantColor, cmdIsfMode, cmdGamma,
+			cmdMute, cmdVolumeUp, cmdVolumeDown, cmdSetVolume, cmdGetVolume,
+			cmdHighAltitudeMode, cmdMessageDisplay, cmdLanguage, cmdRemoteControlCode, cmdRemoteKey,
+			cmdLightSourceUsageTime, cmdLightSourceMode, cmdCycleLampMode,
+		),
+		Values: map[uint16][]int8{
+			cmdSourceInput.Code: values(SourceInputHDMI1, SourceInputHDMI2, SourceInputUSBC),
+			cmdAspectRatio.Code: values(AspectRatioAuto, AspectRatio4To3, AspectRatio16To9,
+				AspectRatio16To10, AspectRatio235To1, AspectRatioNative),
+			cmdColorMode.Code: values(ColorModeBrightest, ColorModeMovie, ColorModeStandard,
+				ColorModeSports, ColorModeGaming, ColorModeISFDay, ColorModeISFNight),
+			cmdColorTemperature.Code: {0x00, 0x01, 0x02, 0x03, 0x04},
+		},
+	}
+}
+
+func codes(commands ...*Command) []uint16 {
+	codes := make([]uint16, len(commands))
+	for i, c := range commands {
+		codes[i] = c.Code
+	}
+	return codes
+}
+
+func values[T ~int8](vs ...T) []int8 {
+	values := make([]int8, len(vs))
+	for i, v := range vs {
+		values[i] = int8(v)
+	}
+	return values
+}
+
+// Supports reports whether the model answers the command code.
+func (m *Model) Supports(code uint16) bool {
+	return slices.Contains(m.Commands, code)
+}
+
+// SupportsValue reports whether the model accepts the value written to the command code.
+func (m *Model) SupportsValue(code uint16, value int8) bool {
+	if !m.Supports(code) {
+		return false
+	}
+	valid, ok := m.Values[code]
+	return !ok || slices.Contains(valid, value)
+}
+
+// Sources returns the source inputs of the model.
+func (m *Model) Sources() []SourceInput {
+	return modelValues(m, cmdSourceInput, SourceInputValues())
+}
+
+// ColorModes returns the color modes of the model.
+func (m *Model) ColorModes() []ColorMode {
+	return modelValues(m, cmdColorMode, ColorModeValues())
+}
+
+// AspectRatios returns the aspect ratios of the model.
+func (m *Model) AspectRatios() []AspectRatio {
+	return modelValues(m, cmdAspectRatio, AspectRatioValues())
+}
+
+// modelValues returns the named values the model accepts for the command.
+func modelValues[T ~int8](m *Model, cmd *Command, all []T) []T {
+	var supported []T
+	for _, v := range all {
+		if m.SupportsValue(cmd.Code, int8(v)) {
+			supported = append(supported, v)
+		}
+	}
+	return supported
+}
+
+func (m *Model) String() string {
+	return m.Name
+}
+
+// check returns an ErrUnsupported error if the model does not support the command, or the
+// value if write is set.
+func (m *Model) check(code uint16, value int8, write bool) error {
+	name := fmt.Sprintf("0x%04X", code)
+	cmd := LookupCommand(code)
+	if cmd != nil {
+		name = cmd.Name
+	}
+	if !m.Supports(code) {
+		return fmt.Errorf("%w %v: %v", ErrUnsupported, m.Name, name)
+	}
+	if write && !m.SupportsValue(code, value) {
+		valueName := fmt.Sprint(value)
+		if cmd != nil {
+			valueName = cmd.ValueName(value)
+		}
+		return fmt.Errorf("%w %v: %v %v", ErrUnsupported, m.Name, name, valueName)
+	}
+	return nil
+}
+
+// SetModel restricts the connection to the commands and values of the model. Unsupported
+// calls fail with ErrUnsupported instead of being sent to the projector. A nil model, the
+// default, sends every command.
+func (conn *ViewSonic) SetModel(m *Model) {
+	conn.model.Store(m)
+}
+
+// Model returns the model set with SetModel, or nil.
+func (conn *ViewSonic) Model() *Model {
+	return conn.model.Load()
+}
+
+// checkModel checks a command against the model of the connection, if any.
+func (conn *ViewSonic) checkModel(code uint16, value int8, write bool) error {
+	if m := conn.model.Load(); m != nil {
+		return m.check(code, value, write)
+	}
+	return nil
+}
@@ -0,0 +1,350 @@
+package viewsonic
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"sync"
+	"text/template"
+	"time"
+
+	"github.com/jpillora/backoff"
+)
+
+// EventType identifies the kind of an Event.
+type EventType string
+
+const (
+	EventConnectionLost       EventType = "connection.lost"
+	EventConnectionRestored   EventType = "connection.restored"
+	EventPowerTransition      EventType = "power.transition"
+	EventErrorCounter         EventType = "error.counter"
+	EventTemperatureThreshold EventType = "temperature.threshold"
+	EventLampHours            EventType = "lamp.hours"
+)
+
+// Event is something that happened on a projector and may be worth notifying about.
+type Event struct {
+	Type      EventType      `json:"type"`
+	Projector string         `json:"projector"`
+	Time      time.Time      `json:"time"`
+	Severity  string         `json:"severity"`
+	Message   string         `json:"message"`
+	Data      map[string]any `json:"data,omitempty"`
+}
+
+// Event converts the lamp alert into a notifier event.
+func (a LampAlert) Event() Event {
+	return Event{
+		Type:      EventLampHours,
+		Projector: a.Projector,
+		Time:      time.Now(),
+		Severity:  a.Level.String(),
+		Message:   a.Message,
+		Data: map[string]any{
+			"hours":     a.Hours,
+			"ratedLife": a.RatedLife,
+			"threshold": a.Threshold,
+			"forecast":  a.Forecast,
+		},
+	}
+}
+
+// Event converts the thermal event into a notifier event.
+func (e ThermalEvent) Event() Event {
+	return Event{
+		Type:      EventTemperatureThreshold,
+		Projector: e.Projector,
+		Time:      e.Time,
+		Severity:  e.Level.String(),
+		Message:   fmt.Sprintf("%v: %v", e.Projector, e.Reason),
+		Data: map[string]any{
+			"level":    e.Level.String(),
+			"previous": e.Previous.String(),
+			"temp1":    e.Sample.Temp1,
+			"temp2":    e.Sample.Temp2,
+			"rise":     e.Rise,
+		},
+	}
+}
+
+// Webhook is an HTTP endpoint events are delivered to.
+type Webhook struct {
+	URL string
+	// Method defaults to POST.
+	Method  string
+	Headers map[string]string
+	// Template renders the request body from the Event. If nil the event is sent as JSON.
+	// The template has a "json" function to embed values as JSON.
+	Template    *template.Template
+	ContentType string
+	// MaxRetries is the number of retries after the first attempt failed.
+	MaxRetries int
+}
+
+// ParseWebhookTemplate parses a payload template for a Webhook.
+func ParseWebhookTemplate(text string) (*template.Template, error) {
+	return template.New("webhook").Funcs(template.FuncMap{
+		"json": func(v any) (string, error) {
+			b, err := json.Marshal(v)
+			return string(b), err
+		},
+	}).Parse(text)
+}
+
+func (w *Webhook) body(e Event) ([]byte, error) {
+	if w.Template == nil {
+		return json.Marshal(e)
+	}
+	var buf bytes.Buffer
+	if err := w.Template.Execute(&buf, e); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// Route delivers matching events to a webhook.
+// Empty Types or Projectors match everything.
+type Route struct {
+	Types      []EventType
+	Projectors []string
+	Webhook    *Webhook
+}
+
+func (r Route) matches(e Event) bool {
+	return contains(r.Types, e.Type) && contains(r.Projectors, e.Projector)
+}
+
+func contains[T comparable](list []T, v T) bool {
+	if len(list) == 0 {
+		return true
+	}
+	for _, l := range list {
+		if l == v {
+			return true
+		}
+	}
+	return false
+}
+
+// Notifier delivers events to webhooks according to its routes.
+// Deliveries run in the background and are retried with backoff.
+type Notifier struct {
+	Client *http.Client
+
+	routes  []Route
+	ctx     context.Context
+	cancel  context.CancelFunc
+	mutex   sync.Mutex // orders Notify and Close
+	pending sync.WaitGroup
+}
+
+// NewNotifier creates a notifier with the given routes.
+func NewNotifier(routes ...Route) *Notifier {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &Notifier{
+		Client: &http.Client{Timeout: 10 * time.Second},
+		routes: routes,
+		ctx:    ctx,
+		cancel: cancel,
+	}
+}
+
+// Notify delivers the event to all matching routes. It does not block. Events after Close
+// are dropped.
+func (n *Notifier) Notify(e Event) {
+	if e.Time.IsZero() {
+		e.Time = time.Now()
+	}
+
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+	if n.ctx.Err() != nil {
+		return
+	}
+
+	for _, route := range n.routes {
+		if !route.matches(e) {
+			continue
+		}
+		n.pending.Add(1)
+		go func(w *Webhook) {
+			defer n.pending.Done()
+			if err := n.deliver(w, e); err != nil {
+				log.Printf("webhook %v failed for %v: %v", w.URL, e.Type, err)
+			}
+		}(route.Webhook)
+	}
+}
+
+func (n *Notifier) deliver(w *Webhook, e Event) error {
+	body, err := w.body(e)
+	if err != nil {
+		return err
+	}
+
+	b := &backoff.Backoff{
+		Min:    1 * time.Second,
+		Max:    30 * time.Second,
+		Factor: 2,
+		Jitter: true,
+	}
+
+	for attempt := 0; ; attempt++ {
+		retry, err := n.send(w, body)
+		if err == nil {
+			return nil
+		}
+		if !retry || attempt >= w.MaxRetries {
+			return err
+		}
+
+		select {
+		case <-n.ctx.Done():
+			return err
+		case <-time.After(b.Duration()):
+		}
+	}
+}
+
+// send does a single request and reports whether a failure is worth a retry.
+func (n *Notifier) send(w *Webhook, body []byte) (bool, error) {
+	method := w.Method
+	if method == "" {
+		method = http.MethodPost
+	}
+
+	req, err := http.NewRequestWithContext(n.ctx, method, w.URL, bytes.NewReader(body))
+	if err != nil {
+		return false, err
+	}
+
+	contentType := w.ContentType
+	if contentType == "" {
+		contentType = "application/json"
+	}
+	req.Header.Set("Content-Type", contentType)
+	for k, v := range w.Headers {
+		req.Header.Set(k, v)
+	}
+
+	resp, err := n.Client.Do(req)
+	if err != nil {
+		return true, err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return false, nil
+	}
+	retry := resp.StatusCode >= 500 || resp.StatusCode == http.StatusTooManyRequests
+	return retry, fmt.Errorf("unexpected status: %v", resp.Status)
+}
+
+// Close cancels outstanding retries and waits for running deliveries.
+func (n *Notifier) Close() {
+	n.mutex.Lock()
+	n.cancel()
+	n.mutex.Unlock()
+	n.pending.Wait()
+}
+
+// Watcher polls a projector and turns changes into events.
+// It reports connection changes, power transitions and new error counter increments.
+type Watcher struct {
+	name       string
+	conn       *ViewSonic
+	notify     func(Event)
+	unregister func()
+
+	status      ProjectorStatusValue
+	statusKnown bool
+	errorStatus *ErrorStatus
+}
+
+// NewWatcher creates a watcher that passes events to notify, e.g. Notifier.Notify.
+// It registers a connection handler on conn, see Close, and reads with PriorityPolling.
+func NewWatcher(conn *ViewSonic, name string, notify func(Event)) *Watcher {
+	w := &Watcher{name: name, conn: conn.WithPriority(PriorityPolling), notify: notify}
+	w.unregister = conn.OnConnectionChange(func(connected bool) {
+		if connected {
+			w.emit(EventConnectionRestored, "info", fmt.Sprintf("%v: connection restored", name), nil)
+		} else {
+			w.emit(EventConnectionLost, "critical", fmt.Sprintf("%v: connection lost", name), nil)
+		}
+	})
+	return w
+}
+
+func (w *Watcher) emit(t EventType, severity, message string, data map[string]any) {
+	w.notify(Event{
+		Type:      t,
+		Projector: w.name,
+		Time:      time.Now(),
+		Severity:  severity,
+		Message:   message,
+		Data:      data,
+	})
+}
+
+// Close removes the connection handler of the watcher.
+func (w *Watcher) Close() {
+	w.unregister()
+}
+
+// Poll reads the projector status and error status once and emits events for changes.
+// The reads are independent, e.g. error counters are still checked on models without the
+// status command.
+func (w *Watcher) Poll() error {
+	var errs []error
+
+	status, err := w.conn.GetProjectorStatus()
+	if err != nil {
+		errs = append(errs, fmt.Errorf("status: %w", err))
+	} else {
+		if w.statusKnown && status != w.status {
+			w.emit(EventPowerTransition, "info",
+				fmt.Sprintf("%v: %v -> %v", w.name, w.status, status),
+				map[string]any{"from": w.status.String(), "to": status.String()})
+		}
+		w.status, w.statusKnown = status, true
+	}
+
+	errorStatus, err := w.conn.GetErrorStatus()
+	if err != nil {
+		errs = append(errs, fmt.Errorf("error status: %w", err))
+	} else {
+		for _, inc := range DiffErrorStatus(w.errorStatus, errorStatus) {
+			w.emit(EventErrorCounter, inc.verdict().String(),
+				fmt.Sprintf("%v: %v", w.name, inc),
+				map[string]any{"counter": inc.Name, "category": inc.Category, "previous": inc.Previous, "current": inc.Current})
+		}
+		w.errorStatus = errorStatus
+	}
+
+	return errors.Join(errs...)
+}
+
+// Run polls the projector every interval until the context is cancelled.
+func (w *Watcher) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if err := w.Poll(); err != nil {
+			log.Printf("watch %v: %v", w.name, err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
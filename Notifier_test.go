@@ -0,0 +1,204 @@
+package viewsonic
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/m-baertschi/viewsonic/emulator"
+)
+
+func TestNotifierDeliversRoutedEvents(t *testing.T) {
+	events := make(chan Event, 10)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Token") != "secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		var e Event
+		if err := json.NewDecoder(r.Body).Decode(&e); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		events <- e
+	}))
+	defer server.Close()
+
+	n := NewNotifier(Route{
+		Types:   []EventType{EventPowerTransition},
+		Webhook: &Webhook{URL: server.URL, Headers: map[string]string{"X-Token": "secret"}},
+	})
+	defer n.Close()
+	n.Notify(Event{Type: EventConnectionLost, Projector: "hall"})
+	n.Notify(Event{Type: EventPowerTransition, Projector: "hall", Message: "on"})
+
+	select {
+	case e := <-events:
+		if e.Type != EventPowerTransition || e.Message != "on" || e.Time.IsZero() {
+			t.Errorf("delivered %+v", e)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("no event delivered")
+	}
+	select {
+	case e := <-events:
+		t.Errorf("delivered unrouted %+v", e)
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
+func TestNotifierRetriesServerErrors(t *testing.T) {
+	attempts := make(chan int, 10)
+	var count atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := count.Add(1)
+		if n == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+		attempts <- int(n)
+	}))
+	defer server.Close()
+
+	n := NewNotifier(Route{Webhook: &Webhook{URL: server.URL, MaxRetries: 1}})
+	defer n.Close()
+	n.Notify(Event{Type: EventLampHours})
+
+	for want := 1; want <= 2; want++ {
+		select {
+		case got := <-attempts:
+			if got != want {
+				t.Fatalf("attempt %d, want %d", got, want)
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatalf("no attempt %d", want)
+		}
+	}
+}
+
+func TestWatcherChecksErrorStatusWithoutProjectorStatus(t *testing.T) {
+	e := emulator.New()
+	if err := e.Listen("127.0.0.1:0"); err != nil {
+		t.Fatal(err)
+	}
+	defer e.Close()
+	// Like the LS920WU, which has no projector status
+	e.SetDisabled(cmdProjectorStatus.Code, true)
+
+	events := make(chan Event, 10)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var ev Event
+		json.NewDecoder(r.Body).Decode(&ev)
+		events <- ev
+	}))
+	defer server.Close()
+	n := NewNotifier(Route{Webhook: &Webhook{URL: server.URL}})
+	defer n.Close()
+
+	conn := New(e.Addr())
+	defer conn.Close()
+	w := NewWatcher(conn, "hall", n.Notify)
+	defer w.Close()
+
+	if err := w.Poll(); err == nil {
+		t.Error("Poll succeeded without projector status")
+	}
+	counters := make([]byte, cmdErrorStatus.Width)
+	counters[4] = 1
+	e.SetValue(cmdErrorStatus.Code, counters...)
+	if err := w.Poll(); err == nil {
+		t.Error("Poll succeeded without projector status")
+	}
+
+	select {
+	case ev := <-events:
+		if ev.Type != EventErrorCounter || ev.Projector != "hall" {
+			t.Errorf("event %+v, want error counter", ev)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("no error counter event")
+	}
+}
+
+func TestWatcherSeverityPerCounter(t *testing.T) {
+	e := emulator.New()
+	if err := e.Listen("127.0.0.1:0"); err != nil {
+		t.Fatal(err)
+	}
+	defer e.Close()
+	conn := New(e.Addr())
+	defer conn.Close()
+
+	var events []Event
+	w := NewWatcher(conn, "hall", func(e Event) { events = append(events, e) })
+	defer w.Close()
+
+	if err := w.Poll(); err != nil {
+		t.Fatal(err)
+	}
+	counters := make([]byte, cmdErrorStatus.Width)
+	counters[2] = 1  // Fan1ErrorCount
+	counters[10] = 1 // TemperatureErrorCount
+	e.SetValue(cmdErrorStatus.Code, counters...)
+	if err := w.Poll(); err != nil {
+		t.Fatal(err)
+	}
+
+	severities := map[any]string{}
+	for _, ev := range events {
+		if ev.Type == EventErrorCounter {
+			severities[ev.Data["counter"]] = ev.Severity
+		}
+	}
+	if severities["Fan1ErrorCount"] != "Warning" || severities["TemperatureErrorCount"] != "Critical" {
+		t.Errorf("severities %v, want Warning for the fan and Critical for the temperature", severities)
+	}
+}
+
+func TestNotifyAfterClose(t *testing.T) {
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+	}))
+	defer server.Close()
+
+	n := NewNotifier(Route{Webhook: &Webhook{URL: server.URL}})
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for i := 0; i < 100; i++ {
+			n.Notify(Event{Type: EventLampHours})
+		}
+	}()
+	n.Close()
+	<-done
+
+	sent := requests.Load()
+	n.Notify(Event{Type: EventLampHours})
+	time.Sleep(50 * time.Millisecond)
+	if got := requests.Load(); got != sent {
+		t.Errorf("%d requests after Close", got-sent)
+	}
+}
+
+func TestConnectionHandlers(t *testing.T) {
+	c := &client{}
+	conn := &ViewSonic{client: c}
+
+	var first, second []bool
+	unregister := conn.OnConnectionChange(func(connected bool) { first = append(first, connected) })
+	conn.OnConnectionChange(func(connected bool) { second = append(second, connected) })
+
+	c.connectionChanged(false)
+	unregister()
+	c.connectionChanged(true)
+
+	if len(first) != 1 || first[0] {
+		t.Errorf("first handler got %v, want [false]", first)
+	}
+	if len(second) != 2 || second[0] || !second[1] {
+		t.Errorf("second handler got %v, want [false true]", second)
+	}
+}
@@ -0,0 +1,118 @@
+package viewsonic
+
+import (
+	"maps"
+	"time"
+)
+
+// Pacing spaces the commands sent to the projector. Some firmware drops replies to commands
+// sent back to back, e.g. a burst of IncreaseBrightness calls.
+type Pacing struct {
+	// MinGap is the pause between the response to a command and the next command.
+	MinGap time.Duration
+	// After overrides MinGap after writes and key presses of a command code, e.g. a longer
+	// pause after switching the power or the source.
+	After map[uint16]time.Duration
+	// Rate limits the commands per second with a token bucket of Burst tokens. No limit if 0.
+	Rate  float64
+	Burst int
+}
+
+// DefaultPacing suits firmware that drops replies, enable it with
+// conn.SetPacing(viewsonic.DefaultPacing). New connections are not paced.
+var DefaultPacing = Pacing{
+	MinGap: 50 * time.Millisecond,
+	After: map[uint16]time.Duration{
+		cmdPower.Code:         3 * time.Second,
+		cmdPowerOff.Code:      3 * time.Second,
+		cmdTogglePower.Code:   3 * time.Second,
+		cmdQuickPowerOff.Code: 3 * time.Second,
+		cmdSourceInput.Code:   time.Second,
+	},
+	Rate:  10,
+	Burst: 5,
+}
+
+// SetPacing replaces the pacing of the connection, Pacing{} sends commands as fast as the
+// projector answers. The pause after the last command is kept.
+func (conn *ViewSonic) SetPacing(p Pacing) {
+	conn.mutex.Lock()
+	defer conn.mutex.Unlock()
+	ready := conn.pacer.ready
+	conn.pacer = newPacer(p)
+	conn.pacer.ready = ready
+}
+
+// pacer tracks the pause and the tokens left before the next command. It is guarded by the
+// mutex of the client.
+type pacer struct {
+	Pacing
+
+	ready  time.Time // earliest time of the next command
+	tokens float64
+	filled time.Time // last refill of the tokens
+}
+
+// newPacer starts with a full bucket. It copies After, so that changes to the map of the
+// caller, e.g. of DefaultPacing, do not race with the dispatcher.
+func newPacer(p Pacing) pacer {
+	p.After = maps.Clone(p.After)
+	return pacer{Pacing: p, tokens: float64(p.Burst), filled: time.Now()}
+}
+
+// delay returns the wait until the next command may be sent.
+func (p *pacer) delay(now time.Time) time.Duration {
+	wait := p.ready.Sub(now)
+	if p.Rate > 0 {
+		p.refill(now)
+		if p.tokens < 1 {
+			wait = max(wait, time.Duration((1-p.tokens)/p.Rate*float64(time.Second)))
+		}
+	}
+	return max(wait, 0)
+}
+
+func (p *pacer) refill(now time.Time) {
+	p.tokens = min(p.tokens+now.Sub(p.filled).Seconds()*p.Rate, float64(max(p.Burst, 1)))
+	p.filled = now
+}
+
+// sent takes a token for a command.
+func (p *pacer) sent(now time.Time) {
+	if p.Rate > 0 {
+		p.refill(now)
+		p.tokens--
+	}
+}
+
+// done starts the pause after the response to a command.
+func (p *pacer) done(now time.Time, cmd1 uint8, data []byte) {
+	gap := p.MinGap
+	if cmd1 == cmdWrite || cmd1 == cmdWriteKey {
+		if after, ok := p.After[uint16(data[1])<<8|uint16(data[2])]; ok {
+			gap = after
+		}
+	}
+	p.ready = now.Add(gap)
+}
+
+// paced sends a command with the package level tx and accounts for it in the pacing.
+func (c *client) paced(cmd1 uint8, data []byte) (uint8, []byte, error) {
+	c.mutex.Lock()
+	c.pacer.sent(time.Now())
+	c.mutex.Unlock()
+
+	respCmd, resp, err := tx(c.conn, c.triggerReconnect, cmd1, data)
+
+	c.mutex.Lock()
+	c.pacer.done(time.Now(), cmd1, data)
+	c.mutex.Unlock()
+	return respCmd, resp, err
+}
+
+// pause returns the wait until the next command may be sent.
+func (c *client) pause() time.Duration {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	return c.pacer.delay(time.Now())
+}
@@ -0,0 +1,71 @@
+package viewsonic
+
+import (
+	"testing"
+	"time"
+)
+
+func TestPacerGap(t *testing.T) {
+	p := newPacer(Pacing{MinGap: 50 * time.Millisecond})
+	now := time.Now()
+
+	if d := p.delay(now); d != 0 {
+		t.Errorf("first command waits %v", d)
+	}
+	p.sent(now)
+	p.done(now, cmdRead, readRequest(cmdPower.Code))
+	if d := p.delay(now.Add(20 * time.Millisecond)); d != 30*time.Millisecond {
+		t.Errorf("delay %v, want the rest of the gap 30ms", d)
+	}
+	if d := p.delay(now.Add(50 * time.Millisecond)); d != 0 {
+		t.Errorf("delay %v after the gap", d)
+	}
+}
+
+func TestPacerPauseAfterCommand(t *testing.T) {
+	p := newPacer(DefaultPacing)
+	now := time.Now()
+
+	p.done(now, cmdWrite, writeRequest(cmdPower.Code, 1))
+	if d := p.delay(now); d != 3*time.Second {
+		t.Errorf("delay after power %v, want 3s", d)
+	}
+	p.done(now, cmdWrite, writeRequest(cmdSourceInput.Code, 3))
+	if d := p.delay(now); d != time.Second {
+		t.Errorf("delay after source %v, want 1s", d)
+	}
+	// Reads of the command take the normal gap
+	p.done(now, cmdRead, readRequest(cmdPower.Code))
+	if d := p.delay(now); d != DefaultPacing.MinGap {
+		t.Errorf("delay after reading power %v, want %v", d, DefaultPacing.MinGap)
+	}
+}
+
+func TestPacerBurst(t *testing.T) {
+	p := newPacer(Pacing{Rate: 10, Burst: 5})
+	now := p.filled
+
+	for i := range 5 {
+		if d := p.delay(now); d != 0 {
+			t.Fatalf("command %d of the burst waits %v", i, d)
+		}
+		p.sent(now)
+		p.done(now, cmdRead, readRequest(cmdPower.Code))
+	}
+	if d := p.delay(now); d != 100*time.Millisecond {
+		t.Errorf("delay after the burst %v, want 100ms", d)
+	}
+	if d := p.delay(now.Add(100 * time.Millisecond)); d != 0 {
+		t.Errorf("delay %v after a token was refilled", d)
+	}
+}
+
+func TestPacerCopiesAfter(t *testing.T) {
+	pacing := Pacing{After: map[uint16]time.Duration{cmdPower.Code: time.Second}}
+	p := newPacer(pacing)
+	pacing.After[cmdPower.Code] = time.Minute
+
+	if after := p.After[cmdPower.Code]; after != time.Second {
+		t.Errorf("pacer changed with the map of the caller to %v", after)
+	}
+}
@@ -0,0 +1,261 @@
+package viewsonic
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+	"time"
+)
+
+// ProbeOutcome classifies the answer of a projector to a read command.
+type ProbeOutcome string
+
+const (
+	// ProbeData is a read response of the width in the registry.
+	ProbeData ProbeOutcome = "data"
+	// ProbeDisabled is the function disabled answer, see ErrFunctionDisabled.
+	ProbeDisabled ProbeOutcome = "disabled"
+	// ProbeUnexpected is a response of another type or width than the registry describes.
+	ProbeUnexpected ProbeOutcome = "unexpected"
+	// ProbeNoResponse is a timeout or a connection error.
+	ProbeNoResponse ProbeOutcome = "no response"
+)
+
+// ProbeResult is the answer to one read command in one power state.
+type ProbeResult struct {
+	Code    uint16       `json:"code"`
+	Name    string       `json:"name"`
+	Power   PowerState   `json:"power"`
+	Outcome ProbeOutcome `json:"outcome"`
+	Data    string       `json:"data,omitempty"` // hex bytes of the value
+	Error   string       `json:"error,omitempty"`
+}
+
+// CapabilityReport lists the answers of a projector to every read command of the registry.
+type CapabilityReport struct {
+	Time    time.Time     `json:"time"`
+	Results []ProbeResult `json:"results"`
+}
+
+// ProbeOptions controls ProbeCapabilities.
+type ProbeOptions struct {
+	// PowerStates are probed in order, the projector is switched to each of them.
+	// Only the current power state is probed if empty.
+	PowerStates []PowerState
+	// Settle is the pause after a power change, for the warm up or cool down.
+	// Defaults to 60s.
+	Settle time.Duration
+	// Timeout limits the wait for a power change. Defaults to 3 minutes.
+	Timeout time.Duration
+	// Logf receives the progress, nothing is logged if nil.
+	Logf func(format string, args ...any)
+}
+
+// ProbeCapabilities reads every readable command of the registry in each power state and
+// records the answers. Reads bypass the model set with SetModel. The projector is left in
+// the last probed power state.
+func ProbeCapabilities(ctx context.Context, conn *ViewSonic, opts ProbeOptions) (*CapabilityReport, error) {
+	if opts.Settle == 0 {
+		opts.Settle = 60 * time.Second
+	}
+	if opts.Timeout == 0 {
+		opts.Timeout = 3 * time.Minute
+	}
+	logf := opts.Logf
+	if logf == nil {
+		logf = func(string, ...any) {}
+	}
+
+	states := opts.PowerStates
+	if len(states) == 0 {
+		power, err := conn.GetPower()
+		if err != nil {
+			return nil, fmt.Errorf("power: %w", err)
+		}
+		states = []PowerState{power}
+	}
+
+	report := &CapabilityReport{Time: time.Now()}
+	for _, power := range states {
+		if len(opts.PowerStates) > 0 {
+			changed, err := switchPower(ctx, conn, power, opts.Timeout, logf)
+			if err != nil {
+				return report, err
+			}
+			if changed {
+				logf("waiting %v to settle", opts.Settle)
+				if err := sleep(ctx, opts.Settle); err != nil {
+					return report, err
+				}
+			}
+		}
+
+		for _, c := range Commands {
+			if c.Access&AccessRead == 0 {
+				continue
+			}
+			if err := ctx.Err(); err != nil {
+				return report, err
+			}
+			result := conn.probe(c)
+			result.Power = power
+			logf("%v %v: %v %v", power, c, result.Outcome, result.Data+result.Error)
+			report.Results = append(report.Results, result)
+		}
+	}
+	return report, nil
+}
+
+// switchPower switches the power and waits until the projector reports the state. It
+// reports whether the power state changed.
+func switchPower(ctx context.Context, conn *ViewSonic, power PowerState, timeout time.Duration,
+	logf func(string, ...any)) (bool, error) {
+	if current, err := conn.GetPower(); err == nil && current == power {
+		return false, nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	logf("switching power %v", power)
+	if err := conn.SetPower(power); err != nil {
+		return false, fmt.Errorf("power %v: %w", power, err)
+	}
+	for {
+		if current, err := conn.GetPower(); err == nil && current == power {
+			return true, nil
+		}
+		if err := sleep(ctx, 2*time.Second); err != nil {
+			return false, fmt.Errorf("power %v: %w", power, err)
+		}
+	}
+}
+
+// probe reads a command and classifies the raw answer.
+func (conn *ViewSonic) probe(c *Command) ProbeResult {
+	result := ProbeResult{Code: c.Code, Name: c.Name}
+
+	cmd1, data, err := conn.tx(cmdRead, []byte{0x34, 0x00, 0x00, byte(c.Code >> 8), byte(c.Code)})
+	var mismatch *ResponseError
+	switch {
+	case errors.As(err, &mismatch):
+		result.Outcome = ProbeUnexpected
+		result.Error = "expected " + mismatch.Expected
+		result.Data = fmt.Sprintf("% X", mismatch.Response)
+	case err != nil:
+		result.Outcome, result.Error = ProbeNoResponse, err.Error()
+	case cmd1 == cmdError:
+		result.Outcome = ProbeDisabled
+	case cmd1 != cmdReadResponse:
+		result.Outcome = ProbeUnexpected
+		result.Error = fmt.Sprintf("response command 0x%02X", cmd1)
+		result.Data = fmt.Sprintf("% X", data)
+	case len(data) != 2+c.Width:
+		result.Outcome = ProbeUnexpected
+		result.Error = fmt.Sprintf("%d value bytes, expected %d", max(len(data)-2, 0), c.Width)
+		result.Data = fmt.Sprintf("% X", data)
+	default:
+		result.Outcome = ProbeData
+		result.Data = fmt.Sprintf("% X", data[2:])
+	}
+	return result
+}
+
+// Model returns a profile seeded from the report: the commands that returned data in any
+// power state. Write only commands can not be probed and have to be added by hand. Disabled
+// commands are left out, since projectors answer unknown commands the same way; probing
+// with a source connected enables most of them.
+func (r *CapabilityReport) Model(name string) *Model {
+	m := &Model{Name: name}
+	for _, result := range r.Results {
+		if result.Outcome == ProbeData && !slices.Contains(m.Commands, result.Code) {
+			m.Commands = append(m.Commands, result.Code)
+		}
+	}
+	return m
+}
+
+// powerStates returns the probed power states in order.
+func (r *CapabilityReport) powerStates() []PowerState {
+	var states []PowerState
+	for _, result := range r.Results {
+		if !slices.Contains(states, result.Power) {
+			states = append(states, result.Power)
+		}
+	}
+	return states
+}
+
+// ExportCapabilityJSON writes the report as indented JSON.
+func ExportCapabilityJSON(w io.Writer, r *CapabilityReport) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(r)
+}
+
+// ExportCapabilityMarkdown writes the report as a Markdown table with a column per power
+// state, followed by the seeded model profile as Go code.
+func ExportCapabilityMarkdown(w io.Writer, r *CapabilityReport, model string) error {
+	states := r.powerStates()
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "# Capabilities of %v\n\nProbed %v.\n\n| Command | Code |", model, r.Time.Format(time.RFC3339))
+	for _, s := range states {
+		fmt.Fprintf(&b, " Power %v |", s)
+	}
+	b.WriteString("\n| --- | --- |")
+	for range states {
+		b.WriteString(" --- |")
+	}
+	b.WriteString("\n")
+
+	var codes []uint16
+	cells := map[uint16]map[PowerState]string{}
+	names := map[uint16]string{}
+	for _, result := range r.Results {
+		if cells[result.Code] == nil {
+			codes = append(codes, result.Code)
+			cells[result.Code] = map[PowerState]string{}
+			names[result.Code] = result.Name
+		}
+		cell := string(result.Outcome)
+		switch {
+		case result.Outcome == ProbeData:
+			cell = "`" + result.Data + "`"
+		case result.Error != "":
+			cell += " (" + result.Error + ")"
+		}
+		cells[result.Code][result.Power] = cell
+	}
+	for _, code := range codes {
+		fmt.Fprintf(&b, "| %v | 0x%04X |", names[code], code)
+		for _, s := range states {
+			fmt.Fprintf(&b, " %v |", cells[code][s])
+		}
+		b.WriteString("\n")
+	}
+
+	m := r.Model(model)
+	fmt.Fprintf(&b, "\n## Model profile\n\nReadable commands that returned data, add the write only commands by hand.\n\n```go\nvar Model%v = &viewsonic.Model{\n\tName: %q,\n\tCommands: []uint16{\n", ident(model), model)
+	for _, code := range m.Commands {
+		fmt.Fprintf(&b, "\t\t0x%04X, // %v\n", code, names[code])
+	}
+	b.WriteString("\t},\n}\n```\n")
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// ident strips the characters of a model name that are not allowed in a Go identifier.
+func ident(name string) string {
+	return strings.Map(func(r rune) rune {
+		if r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' {
+			return r
+		}
+		return -1
+	}, name)
+}
@@ -0,0 +1,23 @@
+package viewsonic
+
+// Projector is the high level API shared by all projector backends,
+// e.g. the RS-232-over-LAN ViewSonic type and the PJLink client.
+type Projector interface {
+	SetPower(state PowerState) error
+	GetPower() (PowerState, error)
+	GetProjectorStatus() (ProjectorStatusValue, error)
+
+	SetSourceInput(input SourceInput) error
+	GetSourceInput() (SourceInput, error)
+
+	SetBlank(blank bool) error
+	GetBlank() (bool, error)
+	SetMute(mute bool) error
+	GetMute() (bool, error)
+
+	GetLightSourceUsageTime() (uint32, error)
+
+	Close()
+}
+
+var _ Projector = (*ViewSonic)(nil)
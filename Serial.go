@@ -0,0 +1,343 @@
+package viewsonic
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Parity of a serial port.
+type Parity byte
+
+// Parity values as encoded by RFC 2217.
+const (
+	ParityNone Parity = 1
+	ParityOdd  Parity = 2
+	ParityEven Parity = 3
+)
+
+// SerialConfig is the setting of the RS-232 port of the projector. The projector supports
+// 2400 to 115200 baud. A field of 0 keeps the current setting of the device server.
+type SerialConfig struct {
+	BaudRate int
+	DataBits int // 5 to 8
+	Parity   Parity
+	StopBits int // 1, 2 or 3 for 1.5 stop bits as in RFC 2217
+}
+
+func (cfg SerialConfig) validate() error {
+	switch {
+	case cfg.BaudRate < 0:
+		return fmt.Errorf("invalid baud rate %d", cfg.BaudRate)
+	case cfg.DataBits != 0 && (cfg.DataBits < 5 || cfg.DataBits > 8):
+		return fmt.Errorf("invalid data bits %d", cfg.DataBits)
+	case cfg.Parity > ParityEven:
+		return fmt.Errorf("invalid parity %d", cfg.Parity)
+	case cfg.StopBits < 0 || cfg.StopBits > 3:
+		return fmt.Errorf("invalid stop bits %d", cfg.StopBits)
+	}
+	return nil
+}
+
+// DefaultSerialConfig is the factory setting of the projectors, 115200 8N1.
+var DefaultSerialConfig = SerialConfig{BaudRate: 115200, DataBits: 8, Parity: ParityNone, StopBits: 1}
+
+// Telnet commands and options of RFC 854, RFC 856, RFC 858 and RFC 2217.
+const (
+	telnetSE   = 240
+	telnetSB   = 250
+	telnetWILL = 251
+	telnetWONT = 252
+	telnetDO   = 253
+	telnetDONT = 254
+	telnetIAC  = 255
+
+	telnetBinary          = 0
+	telnetSuppressGoAhead = 3
+	telnetComPort         = 44
+
+	comPortSetBaudRate = 1
+	comPortSetDataSize = 2
+	comPortSetParity   = 3
+	comPortSetStopSize = 4
+	comPortSetControl  = 5
+	comPortServer      = 100 // offset of the answers of the access server
+)
+
+// RFC2217Dialer dials a serial device server with Telnet COM port control, e.g. a Moxa
+// NPort in RFC 2217 mode, and sets the serial port to cfg. Raw TCP serial bridges need no
+// translation and work with TCPDialer, their port is configured on the device server.
+func RFC2217Dialer(addr string, cfg SerialConfig) Dialer {
+	tcp := TCPDialer(addr)
+	return DialerFunc(func() (net.Conn, error) {
+		conn, err := tcp.Dial()
+		if err != nil {
+			return nil, err
+		}
+		t := &telnetConn{Conn: conn, raw: make([]byte, 512)}
+		if err := t.configure(cfg); err != nil {
+			conn.Close()
+			return nil, fmt.Errorf("rfc2217 %v: %w", addr, err)
+		}
+		return t, nil
+	})
+}
+
+// ParseDialer returns the Dialer of an address:
+//
+//	host:port                       TCP, the LAN port of the projector or a raw serial bridge
+//	tcp://host:port                 same as host:port
+//	rfc2217://host:port?baud=9600   RFC 2217 device server, also parity=none|odd|even,
+//	                                databits=8 and stopbits=1; defaults to DefaultSerialConfig
+func ParseDialer(addr string) (Dialer, error) {
+	if !strings.Contains(addr, "://") {
+		return TCPDialer(addr), nil
+	}
+	u, err := url.Parse(addr)
+	if err != nil {
+		return nil, err
+	}
+	switch u.Scheme {
+	case "tcp":
+		return TCPDialer(u.Host), nil
+	case "rfc2217":
+		cfg := DefaultSerialConfig
+		query := u.Query()
+		for key, value := range map[string]*int{"baud": &cfg.BaudRate, "databits": &cfg.DataBits, "stopbits": &cfg.StopBits} {
+			if s := query.Get(key); s != "" {
+				if *value, err = strconv.Atoi(s); err != nil {
+					return nil, fmt.Errorf("invalid %v %q", key, s)
+				}
+			}
+		}
+		switch query.Get("parity") {
+		case "", "none":
+		case "odd":
+			cfg.Parity = ParityOdd
+		case "even":
+			cfg.Parity = ParityEven
+		default:
+			return nil, fmt.Errorf("invalid parity %q", query.Get("parity"))
+		}
+		if err := cfg.validate(); err != nil {
+			return nil, err
+		}
+		return RFC2217Dialer(u.Host, cfg), nil
+	}
+	return nil, fmt.Errorf("unknown scheme %q", u.Scheme)
+}
+
+// telnetConn escapes the data bytes 0xFF of a Telnet connection and answers the option
+// negotiation of the server.
+type telnetConn struct {
+	net.Conn
+
+	writeMutex sync.Mutex
+
+	// decoder state, only used by Read and configure
+	raw     []byte
+	pending []byte
+	state   int
+	command byte
+	sub     []byte
+
+	// set while decoding
+	binaryIn  bool  // the server sends binary data
+	binaryOut bool  // the server accepts binary data
+	comPort   bool  // the server accepts COM port control
+	baudAck   bool  // the server answered the baud rate
+	refused   error // the server refused an option
+}
+
+const (
+	telnetStateData = iota
+	telnetStateIAC
+	telnetStateOption
+	telnetStateSub
+	telnetStateSubIAC
+)
+
+// configure negotiates the binary mode and COM port control, then sets the serial port and
+// waits until the server acknowledges the baud rate.
+func (c *telnetConn) configure(cfg SerialConfig) error {
+	if err := cfg.validate(); err != nil {
+		return err
+	}
+	err := c.writeRaw([]byte{
+		telnetIAC, telnetWILL, telnetBinary, telnetIAC, telnetDO, telnetBinary,
+		telnetIAC, telnetWILL, telnetSuppressGoAhead, telnetIAC, telnetDO, telnetSuppressGoAhead,
+		telnetIAC, telnetWILL, telnetComPort,
+	})
+	if err != nil {
+		return err
+	}
+
+	c.SetReadDeadline(time.Now().Add(5 * time.Second))
+	defer c.SetReadDeadline(time.Time{})
+
+	// RFC 2217 allows the subnegotiations only after the server agreed to COM port control
+	err = c.await("the option negotiation", func() bool { return c.comPort && c.binaryIn && c.binaryOut })
+	if err != nil {
+		return err
+	}
+
+	var b []byte
+	b = appendSub(b, comPortSetBaudRate, binary.BigEndian.AppendUint32(nil, uint32(cfg.BaudRate))...)
+	b = appendSub(b, comPortSetDataSize, byte(cfg.DataBits))
+	b = appendSub(b, comPortSetParity, byte(cfg.Parity))
+	b = appendSub(b, comPortSetStopSize, byte(cfg.StopBits))
+	b = appendSub(b, comPortSetControl, 1) // no flow control
+	if err := c.writeRaw(b); err != nil {
+		return err
+	}
+	return c.await("the baud rate", func() bool { return c.baudAck })
+}
+
+// await decodes the input of the server until done or a refused option.
+func (c *telnetConn) await(what string, done func() bool) error {
+	for !done() {
+		n, err := c.Conn.Read(c.raw)
+		if err != nil {
+			return fmt.Errorf("waiting for %v: %w", what, err)
+		}
+		if err := c.decode(c.raw[:n]); err != nil {
+			return err
+		}
+		if c.refused != nil {
+			return c.refused
+		}
+	}
+	return nil
+}
+
+// appendSub appends a COM port control subnegotiation, escaping 0xFF in the value.
+func appendSub(b []byte, command byte, value ...byte) []byte {
+	b = append(b, telnetIAC, telnetSB, telnetComPort, command)
+	b = append(b, bytes.ReplaceAll(value, []byte{telnetIAC}, []byte{telnetIAC, telnetIAC})...)
+	return append(b, telnetIAC, telnetSE)
+}
+
+func (c *telnetConn) writeRaw(b []byte) error {
+	c.writeMutex.Lock()
+	defer c.writeMutex.Unlock()
+	_, err := c.Conn.Write(b)
+	return err
+}
+
+// Write doubles every 0xFF, which occurs in checksums and negative values.
+func (c *telnetConn) Write(p []byte) (int, error) {
+	if err := c.writeRaw(bytes.ReplaceAll(p, []byte{telnetIAC}, []byte{telnetIAC, telnetIAC})); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+// Read returns the data bytes and handles the Telnet commands in between.
+func (c *telnetConn) Read(p []byte) (int, error) {
+	for len(c.pending) == 0 {
+		n, err := c.Conn.Read(c.raw)
+		if n > 0 {
+			if err := c.decode(c.raw[:n]); err != nil {
+				return 0, err
+			}
+		}
+		if err != nil && len(c.pending) == 0 {
+			return 0, err
+		}
+	}
+	n := copy(p, c.pending)
+	c.pending = c.pending[n:]
+	return n, nil
+}
+
+func (c *telnetConn) decode(raw []byte) error {
+	for _, b := range raw {
+		switch c.state {
+		case telnetStateData:
+			if b == telnetIAC {
+				c.state = telnetStateIAC
+			} else {
+				c.pending = append(c.pending, b)
+			}
+		case telnetStateIAC:
+			switch b {
+			case telnetIAC:
+				c.pending = append(c.pending, b)
+				c.state = telnetStateData
+			case telnetWILL, telnetWONT, telnetDO, telnetDONT:
+				c.command = b
+				c.state = telnetStateOption
+			case telnetSB:
+				c.sub = c.sub[:0]
+				c.state = telnetStateSub
+			default: // NOP, GA and the other commands without option
+				c.state = telnetStateData
+			}
+		case telnetStateOption:
+			c.state = telnetStateData
+			if err := c.negotiate(c.command, b); err != nil {
+				return err
+			}
+		case telnetStateSub:
+			if b == telnetIAC {
+				c.state = telnetStateSubIAC
+			} else {
+				c.sub = append(c.sub, b)
+			}
+		case telnetStateSubIAC:
+			switch b {
+			case telnetIAC:
+				c.sub = append(c.sub, b)
+				c.state = telnetStateSub
+			case telnetSE:
+				c.state = telnetStateData
+				if len(c.sub) >= 2 && c.sub[0] == telnetComPort && c.sub[1] == comPortServer+comPortSetBaudRate {
+					c.baudAck = true
+				}
+			default:
+				c.state = telnetStateData
+			}
+		}
+	}
+	return nil
+}
+
+// negotiate records the answers to the options requested by configure, which need no
+// answer, and refuses every other option.
+func (c *telnetConn) negotiate(command, option byte) error {
+	switch command {
+	case telnetDO:
+		switch option {
+		case telnetBinary:
+			c.binaryOut = true
+		case telnetComPort:
+			c.comPort = true
+		case telnetSuppressGoAhead:
+		default:
+			return c.writeRaw([]byte{telnetIAC, telnetWONT, option})
+		}
+	case telnetWILL:
+		switch option {
+		case telnetBinary:
+			c.binaryIn = true
+		case telnetSuppressGoAhead:
+		default:
+			return c.writeRaw([]byte{telnetIAC, telnetDONT, option})
+		}
+	case telnetDONT, telnetWONT:
+		switch option {
+		case telnetBinary:
+			c.refused = errors.New("server refused binary mode")
+		case telnetComPort:
+			c.refused = errors.New("server refused COM port control")
+		}
+	}
+	return nil
+}
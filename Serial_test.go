@@ -0,0 +1,114 @@
+package viewsonic
+
+import (
+	"bytes"
+	"io"
+	"net"
+	"strings"
+	"testing"
+	"time"
+)
+
+// deviceServer is a device server with RFC 2217 that answers the options after a pause.
+// It passes the bytes received before its answers and after the subnegotiations to early
+// and data.
+func deviceServer(t *testing.T, answer []byte) (addr string, early, data chan []byte) {
+	t.Helper()
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { l.Close() })
+	early, data = make(chan []byte, 1), make(chan []byte, 1)
+
+	go func() {
+		conn, err := l.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		// Everything the client sends before the options are agreed
+		var buf bytes.Buffer
+		conn.SetReadDeadline(time.Now().Add(100 * time.Millisecond))
+		io.Copy(&buf, conn)
+		early <- bytes.Clone(buf.Bytes())
+		conn.Write(answer)
+
+		// Wait for the last subnegotiation, the flow control
+		buf.Reset()
+		conn.SetReadDeadline(time.Now().Add(2 * time.Second))
+		chunk := make([]byte, 256)
+		control := []byte{telnetIAC, telnetSB, telnetComPort, comPortSetControl, 1, telnetIAC, telnetSE}
+		for !bytes.Contains(buf.Bytes(), control) {
+			n, err := conn.Read(chunk)
+			if err != nil {
+				return
+			}
+			buf.Write(chunk[:n])
+		}
+		conn.Write([]byte{telnetIAC, telnetSB, telnetComPort, comPortServer + comPortSetBaudRate, 0x00, 0x01, 0xC2, 0x00, telnetIAC, telnetSE})
+
+		// Data, 0xFF is escaped in both directions
+		conn.Write([]byte{0x05, telnetIAC, telnetIAC, 0x14})
+		n, _ := io.ReadFull(conn, chunk[:4])
+		data <- chunk[:n]
+	}()
+	return l.Addr().String(), early, data
+}
+
+func TestRFC2217Dialer(t *testing.T) {
+	addr, early, data := deviceServer(t, []byte{
+		telnetIAC, telnetDO, telnetBinary, telnetIAC, telnetWILL, telnetBinary,
+		telnetIAC, telnetDO, telnetComPort,
+	})
+
+	conn, err := RFC2217Dialer(addr, DefaultSerialConfig).Dial()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+
+	if b := <-early; bytes.Contains(b, []byte{telnetIAC, telnetSB}) {
+		t.Errorf("subnegotiation before the server agreed: % X", b)
+	}
+
+	buf := make([]byte, 3)
+	if _, err := io.ReadFull(conn, buf); err != nil || !bytes.Equal(buf, []byte{0x05, 0xFF, 0x14}) {
+		t.Errorf("read % X, %v", buf, err)
+	}
+	if _, err := conn.Write([]byte{0x06, 0xFF, 0x14}); err != nil {
+		t.Fatal(err)
+	}
+	if got := <-data; !bytes.Equal(got, []byte{0x06, telnetIAC, telnetIAC, 0x14}) {
+		t.Errorf("server received % X", got)
+	}
+}
+
+func TestRFC2217DialerRefused(t *testing.T) {
+	addr, _, _ := deviceServer(t, []byte{
+		telnetIAC, telnetDO, telnetBinary, telnetIAC, telnetWILL, telnetBinary,
+		telnetIAC, telnetDONT, telnetComPort,
+	})
+
+	_, err := RFC2217Dialer(addr, DefaultSerialConfig).Dial()
+	if err == nil || !strings.Contains(err.Error(), "refused COM port control") {
+		t.Errorf("Dial = %v, want refused", err)
+	}
+}
+
+func TestParseDialerValidates(t *testing.T) {
+	for _, addr := range []string{
+		"rfc2217://host:4001?databits=9",
+		"rfc2217://host:4001?stopbits=4",
+		"rfc2217://host:4001?baud=-1",
+		"rfc2217://host:4001?parity=mark",
+	} {
+		if _, err := ParseDialer(addr); err == nil {
+			t.Errorf("ParseDialer(%q) succeeded", addr)
+		}
+	}
+	if _, err := ParseDialer("rfc2217://host:4001?baud=9600&databits=7&parity=even&stopbits=2"); err != nil {
+		t.Error(err)
+	}
+}
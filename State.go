@@ -0,0 +1,210 @@
+package viewsonic
+
+import (
+	"context"
+	"errors"
+	"log"
+	"sync"
+	"time"
+)
+
+// StateField names a value of the polled projector state.
+type StateField string
+
+const (
+	StateFieldPower        StateField = "power"
+	StateFieldStatus       StateField = "status"
+	StateFieldSource       StateField = "source"
+	StateFieldBlank        StateField = "blank"
+	StateFieldFreeze       StateField = "freeze"
+	StateFieldMute         StateField = "mute"
+	StateFieldVolume       StateField = "volume"
+	StateFieldTemperature1 StateField = "temp1"
+	StateFieldTemperature2 StateField = "temp2"
+)
+
+// StateFields lists all fields in the order they are polled.
+var StateFields = []StateField{
+	StateFieldPower,
+	StateFieldStatus,
+	StateFieldSource,
+	StateFieldBlank,
+	StateFieldFreeze,
+	StateFieldMute,
+	StateFieldVolume,
+	StateFieldTemperature1,
+	StateFieldTemperature2,
+}
+
+// StateChange is emitted when a polled value changes.
+// Value is nil if the function is currently disabled on the projector.
+type StateChange struct {
+	Projector string     `json:"projector"`
+	Field     StateField `json:"field"`
+	Value     any        `json:"value"`
+	Time      time.Time  `json:"time"`
+}
+
+// StatePoller polls the state of a projector and notifies subscribers about changes.
+type StatePoller struct {
+	name string
+	conn *ViewSonic
+
+	mutex       sync.Mutex
+	state       map[StateField]any
+	subscribers map[int]func(StateChange)
+	nextID      int
+}
+
+// NewStatePoller creates a poller for the projector. It reads with PriorityPolling.
+func NewStatePoller(conn *ViewSonic, name string) *StatePoller {
+	return &StatePoller{
+		name:        name,
+		conn:        conn.WithPriority(PriorityPolling),
+		state:       map[StateField]any{},
+		subscribers: map[int]func(StateChange){},
+	}
+}
+
+// Name returns the projector name used in the state changes.
+func (p *StatePoller) Name() string {
+	return p.name
+}
+
+// Subscribe registers fn for all future changes. fn must not block.
+// The returned function removes the subscription.
+func (p *StatePoller) Subscribe(fn func(StateChange)) func() {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	id := p.nextID
+	p.nextID++
+	p.subscribers[id] = fn
+
+	return func() {
+		p.mutex.Lock()
+		defer p.mutex.Unlock()
+		delete(p.subscribers, id)
+	}
+}
+
+// State returns a copy of the last polled state.
+func (p *StatePoller) State() map[StateField]any {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	state := make(map[StateField]any, len(p.state))
+	for k, v := range p.state {
+		state[k] = v
+	}
+	return state
+}
+
+// pollRound reads the fields of one poll, reading the temperatures only once.
+type pollRound struct {
+	conn     *ViewSonic
+	temps    [2]float32
+	tempErr  error
+	tempRead bool
+}
+
+func (r *pollRound) temperature(i int) (float32, error) {
+	if !r.tempRead {
+		r.temps[0], r.temps[1], r.tempErr = r.conn.GetOperatingTemperature()
+		r.tempRead = true
+	}
+	return r.temps[i], r.tempErr
+}
+
+func (r *pollRound) read(field StateField) (any, error) {
+	c := r.conn
+	switch field {
+	case StateFieldPower:
+		power, err := c.GetPower()
+		return power == PowerStateOn, err
+	case StateFieldStatus:
+		return c.GetProjectorStatus()
+	case StateFieldSource:
+		return c.GetSourceInput()
+	case StateFieldBlank:
+		return c.GetBlank()
+	case StateFieldFreeze:
+		return c.GetFreeze()
+	case StateFieldMute:
+		return c.GetMute()
+	case StateFieldVolume:
+		return c.GetVolume()
+	case StateFieldTemperature1:
+		return r.temperature(0)
+	case StateFieldTemperature2:
+		return r.temperature(1)
+	}
+	return nil, errors.New("unknown state field")
+}
+
+// ReadStateField reads a single field of the projector state. The value has the type
+// stored by the StatePoller, e.g. bool for power or SourceInput for source.
+func ReadStateField(conn *ViewSonic, field StateField) (any, error) {
+	return (&pollRound{conn: conn}).read(field)
+}
+
+// Poll reads the given fields, all if none are given, once and notifies the subscribers
+// about changed values. Fields disabled on the projector or not supported by its model
+// change to nil, other read errors keep the last value.
+func (p *StatePoller) Poll(fields ...StateField) []StateChange {
+	now := time.Now()
+	round := &pollRound{conn: p.conn}
+	var changes []StateChange
+
+	if len(fields) == 0 {
+		fields = StateFields
+	}
+	for _, field := range fields {
+		value, err := round.read(field)
+		if errors.Is(err, ErrFunctionDisabled) || errors.Is(err, ErrUnsupported) {
+			value, err = nil, nil
+		}
+		if err != nil {
+			log.Printf("poll %v %v: %v", p.name, field, err)
+			continue
+		}
+
+		p.mutex.Lock()
+		old, known := p.state[field]
+		if !known || old != value {
+			p.state[field] = value
+			changes = append(changes, StateChange{Projector: p.name, Field: field, Value: value, Time: now})
+		}
+		p.mutex.Unlock()
+	}
+
+	p.mutex.Lock()
+	subscribers := make([]func(StateChange), 0, len(p.subscribers))
+	for _, fn := range p.subscribers {
+		subscribers = append(subscribers, fn)
+	}
+	p.mutex.Unlock()
+
+	for _, change := range changes {
+		for _, fn := range subscribers {
+			fn(change)
+		}
+	}
+	return changes
+}
+
+// Run polls the projector every interval until the context is cancelled.
+func (p *StatePoller) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		p.Poll()
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
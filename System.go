@@ -22,15 +22,29 @@ const (
 	ProjectorStatusCoolDown ProjectorStatusValue = 0x03
 )
 
+var powerStateEnum = enum[PowerState]{"PowerState", []CommandValue{{"Off", 0x00}, {"On", 0x01}}}
+
+func (s PowerState) String() string { return powerStateEnum.name(s) }
+
+func (s PowerState) MarshalText() ([]byte, error) { return powerStateEnum.marshal(s) }
+
+func (s *PowerState) UnmarshalText(text []byte) error { return powerStateEnum.unmarshal(s, text) }
+
+// ParsePowerState returns the PowerState with the case insensitive name or number.
+func ParsePowerState(s string) (PowerState, error) { return powerStateEnum.parse(s) }
+
+// PowerStateValues returns all named PowerState values.
+func PowerStateValues() []PowerState { return powerStateEnum.all() }
+
 func (conn *ViewSonic) SetPower(state PowerState) error {
 	if state == PowerStateOn {
-		return conn.Write(0x1100, 0x00) // PDF #1
+		return conn.Write(cmdPower.Code, 0x00)
 	}
-	return conn.Write(0x1101, 0x00) // PDF #2
+	return conn.Write(cmdPowerOff.Code, 0x00)
 }
 
 func (conn *ViewSonic) GetPower() (PowerState, error) {
-	value, err := conn.Read(0x1100) // PDF #3
+	value, err := conn.Read(cmdPower.Code)
 	if err != nil {
 		return 0, err
 	}
@@ -38,7 +52,7 @@ func (conn *ViewSonic) GetPower() (PowerState, error) {
 }
 
 func (conn *ViewSonic) GetProjectorStatus() (ProjectorStatusValue, error) {
-	value, err := conn.Read(0x1126) // PDF #4
+	value, err := conn.Read(cmdProjectorStatus.Code)
 	if err != nil {
 		return 0, err
 	}
@@ -47,11 +61,11 @@ func (conn *ViewSonic) GetProjectorStatus() (ProjectorStatusValue, error) {
 
 // Reset All Settings
 func (conn *ViewSonic) ResetAllSettings() error {
-	return conn.Write(0x1102, 0x00) // PDF #5
+	return conn.Write(cmdResetAllSettings.Code, 0x00)
 }
 
 func (conn *ViewSonic) ResetCurrentColorSettings() error {
-	return conn.Write(0x112A, 0x00) // PDF #6
+	return conn.Write(cmdResetCurrentColorSettings.Code, 0x00)
 }
 
 // Quick Power Off
@@ -60,11 +74,11 @@ func (conn *ViewSonic) SetQuickPowerOff(enable bool) error {
 	if enable {
 		value = 0x01
 	}
-	return conn.Write(0x110B, value) // PDF #13, 14
+	return conn.Write(cmdQuickPowerOff.Code, value)
 }
 
 func (conn *ViewSonic) GetQuickPowerOff() (bool, error) {
-	value, err := conn.Read(0x110B) // PDF #15
+	value, err := conn.Read(cmdQuickPowerOff.Code)
 	return value == 0x01, err
 }
 
@@ -126,14 +140,15 @@ type ErrorStatus struct {
 
 // GetErrorStatus returns the decoded error status.
 func (conn *ViewSonic) GetErrorStatus() (*ErrorStatus, error) {
-	data, err := conn.ReadNBytes(0x0C0D) // PDF #177
+	data, err := conn.ReadNBytes(cmdErrorStatus.Code)
 	if err != nil {
 		return nil, err
 	}
 
-	if len(data) < 24 {
-		return nil, fmt.Errorf("not enough data for error status, expected 24 bytes, got %d", len(data))
+	if len(data) < 2+cmdErrorStatus.Width {
+		return nil, fmt.Errorf("%w: not enough data for error status, expected %d bytes, got %d", ErrUnexpectedResponse, 2+cmdErrorStatus.Width, len(data))
 	}
+	data = data[2:]
 
 	lampModeStatus := LampModeStatus(data[21])
 	switch lampModeStatus {
@@ -171,12 +186,12 @@ func (conn *ViewSonic) GetErrorStatus() (*ErrorStatus, error) {
 
 // Temperature status
 func (conn *ViewSonic) GetOperatingTemperature() (float32, float32, error) {
-	data, err := conn.ReadNBytes(0x1503) // PDF #223
+	data, err := conn.ReadNBytes(cmdOperatingTemperature.Code)
 	if err != nil {
 		return 0, 0, err
 	}
-	if len(data) < 4 {
-		return 0, 0, fmt.Errorf("not enough data for temperature")
+	if len(data) < 10 {
+		return 0, 0, fmt.Errorf("%w: not enough data for temperature", ErrUnexpectedResponse)
 	}
 	// Note 1: HEX2DEC(ddccbbaa)/10
 	val := binary.LittleEndian.Uint32(data[2:])
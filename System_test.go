@@ -0,0 +1,36 @@
+package viewsonic
+
+import (
+	"testing"
+
+	"github.com/m-baertschi/viewsonic/emulator"
+)
+
+func TestGetErrorStatus(t *testing.T) {
+	e := emulator.New()
+	if err := e.Listen("127.0.0.1:0"); err != nil {
+		t.Fatal(err)
+	}
+	defer e.Close()
+	conn := New(e.Addr())
+	defer conn.Close()
+
+	// The example response of note 3
+	e.SetValue(cmdErrorStatus.Code,
+		0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0A, 0x0B, 0x0C, 0x0D, 0x0E, 0x0F, 0x10, 0x11,
+		0x01, 0x02, 0x03, 0x04, 0x01, 0x01, 0x02)
+
+	status, err := conn.GetErrorStatus()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if status.LampFailCount != 0x01 || status.AbnormalPowerdown != 0x11 {
+		t.Errorf("counters %+v, want 0x01 to 0x11", status)
+	}
+	if status.FirstBurnInErrorMinute != 0x04030201 {
+		t.Errorf("first burn-in error minute 0x%X, want 0x04030201", status.FirstBurnInErrorMinute)
+	}
+	if status.LampStatus != LampModeStatusIgnition || status.LampErrorStatus != 0x0201 {
+		t.Errorf("lamp status %v, error status 0x%X", status.LampStatus, status.LampErrorStatus)
+	}
+}
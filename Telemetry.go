@@ -0,0 +1,434 @@
+package viewsonic
+
+import (
+	"bufio"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// TelemetrySample is a periodic snapshot of a projector.
+// Fields that could not be read are nil, e.g. most values while the projector is off.
+type TelemetrySample struct {
+	Device     string           `json:"device"`
+	Time       time.Time        `json:"time"`
+	PowerOn    *bool            `json:"powerOn,omitempty"`
+	Status     string           `json:"status,omitempty"`
+	Temp1      *float32         `json:"temp1,omitempty"`
+	Temp2      *float32         `json:"temp2,omitempty"`
+	UsageHours *uint32          `json:"usageHours,omitempty"`
+	Counters   map[string]uint8 `json:"counters,omitempty"`
+}
+
+// CollectTelemetry reads a sample from the projector. The returned sample contains every
+// value that could be read, the error joins all failed reads.
+func CollectTelemetry(conn *ViewSonic, device string) (TelemetrySample, error) {
+	sample := TelemetrySample{Device: device, Time: time.Now()}
+	var errs []error
+
+	if power, err := conn.GetPower(); err == nil {
+		on := power == PowerStateOn
+		sample.PowerOn = &on
+	} else {
+		errs = append(errs, fmt.Errorf("power: %w", err))
+	}
+
+	if status, err := conn.GetProjectorStatus(); err == nil {
+		sample.Status = status.String()
+	} else {
+		errs = append(errs, fmt.Errorf("status: %w", err))
+	}
+
+	if t1, t2, err := conn.GetOperatingTemperature(); err == nil {
+		sample.Temp1, sample.Temp2 = &t1, &t2
+	} else {
+		errs = append(errs, fmt.Errorf("temperature: %w", err))
+	}
+
+	if hours, err := conn.GetLightSourceUsageTime(); err == nil {
+		sample.UsageHours = &hours
+	} else {
+		errs = append(errs, fmt.Errorf("usage time: %w", err))
+	}
+
+	if status, err := conn.GetErrorStatus(); err == nil {
+		sample.Counters = map[string]uint8{}
+		for _, c := range status.Counters() {
+			sample.Counters[c.Name] = c.Value
+		}
+	} else {
+		errs = append(errs, fmt.Errorf("error status: %w", err))
+	}
+
+	return sample, errors.Join(errs...)
+}
+
+// TelemetryStore is a file based time series log.
+// Samples are stored as JSON lines in one file per device and day: Dir/<device>/<YYYY-MM-DD>.jsonl
+type TelemetryStore struct {
+	Dir string
+	// Retention is the age after which Prune deletes samples. Zero keeps everything.
+	Retention time.Duration
+
+	mutex sync.Mutex
+}
+
+const telemetryDayFormat = "2006-01-02"
+
+func (s *TelemetryStore) deviceDir(device string) (string, error) {
+	if device == "" || device == "." || device == ".." || strings.ContainsAny(device, `/\`) {
+		return "", fmt.Errorf("invalid device name %q", device)
+	}
+	return filepath.Join(s.Dir, device), nil
+}
+
+// Append adds samples to the log.
+func (s *TelemetryStore) Append(samples ...TelemetrySample) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	for _, sample := range samples {
+		dir, err := s.deviceDir(sample.Device)
+		if err != nil {
+			return err
+		}
+		if err := os.MkdirAll(dir, 0o755); err != nil {
+			return err
+		}
+
+		line, err := json.Marshal(sample)
+		if err != nil {
+			return err
+		}
+
+		name := filepath.Join(dir, sample.Time.UTC().Format(telemetryDayFormat)+".jsonl")
+		f, err := os.OpenFile(name, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o644)
+		if err != nil {
+			return err
+		}
+		_, err = f.Write(append(line, '\n'))
+		if cerr := f.Close(); err == nil {
+			err = cerr
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Devices returns the names of all devices in the store.
+func (s *TelemetryStore) Devices() ([]string, error) {
+	entries, err := os.ReadDir(s.Dir)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var devices []string
+	for _, e := range entries {
+		if e.IsDir() {
+			devices = append(devices, e.Name())
+		}
+	}
+	return devices, nil
+}
+
+// days returns the day files of a device, sorted by date.
+func (s *TelemetryStore) days(device string) ([]time.Time, error) {
+	dir, err := s.deviceDir(device)
+	if err != nil {
+		return nil, err
+	}
+
+	entries, err := os.ReadDir(dir)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var days []time.Time
+	for _, e := range entries {
+		day, err := time.Parse(telemetryDayFormat, strings.TrimSuffix(e.Name(), ".jsonl"))
+		if err != nil || e.IsDir() {
+			continue
+		}
+		days = append(days, day)
+	}
+	sort.Slice(days, func(i, j int) bool { return days[i].Before(days[j]) })
+	return days, nil
+}
+
+// Query returns the samples of the device with from <= Time < to, sorted by time.
+// An empty device queries all devices. A zero from or to leaves that side open.
+func (s *TelemetryStore) Query(device string, from, to time.Time) ([]TelemetrySample, error) {
+	devices := []string{device}
+	if device == "" {
+		var err error
+		if devices, err = s.Devices(); err != nil {
+			return nil, err
+		}
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	var samples []TelemetrySample
+	for _, d := range devices {
+		days, err := s.days(d)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, day := range days {
+			if !from.IsZero() && !day.Add(24*time.Hour).After(from.UTC()) {
+				continue
+			}
+			if !to.IsZero() && !day.Before(to.UTC()) {
+				continue
+			}
+
+			dir, _ := s.deviceDir(d)
+			daySamples, err := readTelemetryFile(filepath.Join(dir, day.Format(telemetryDayFormat)+".jsonl"))
+			if err != nil {
+				return nil, err
+			}
+			for _, sample := range daySamples {
+				if (from.IsZero() || !sample.Time.Before(from)) && (to.IsZero() || sample.Time.Before(to)) {
+					samples = append(samples, sample)
+				}
+			}
+		}
+	}
+
+	sort.SliceStable(samples, func(i, j int) bool { return samples[i].Time.Before(samples[j].Time) })
+	return samples, nil
+}
+
+func readTelemetryFile(name string) ([]TelemetrySample, error) {
+	f, err := os.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var samples []TelemetrySample
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		var sample TelemetrySample
+		if err := json.Unmarshal(scanner.Bytes(), &sample); err != nil {
+			// A partially written last line after a crash is skipped
+			log.Printf("skipping invalid telemetry line in %v: %v", name, err)
+			continue
+		}
+		samples = append(samples, sample)
+	}
+	return samples, scanner.Err()
+}
+
+// Prune deletes all day files older than the retention.
+func (s *TelemetryStore) Prune() error {
+	if s.Retention <= 0 {
+		return nil
+	}
+	cutoff := time.Now().UTC().Add(-s.Retention)
+
+	devices, err := s.Devices()
+	if err != nil {
+		return err
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	for _, d := range devices {
+		days, err := s.days(d)
+		if err != nil {
+			return err
+		}
+		dir, _ := s.deviceDir(d)
+		for _, day := range days {
+			if day.Add(24 * time.Hour).Before(cutoff) {
+				if err := os.Remove(filepath.Join(dir, day.Format(telemetryDayFormat)+".jsonl")); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// Record collects a sample from every device each interval until the context is cancelled.
+// The store is pruned once per day. Samples are read with PriorityPolling.
+func (s *TelemetryStore) Record(ctx context.Context, interval time.Duration, devices map[string]*ViewSonic) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	var lastPrune time.Time
+	for {
+		for name, conn := range devices {
+			sample, err := CollectTelemetry(conn.WithPriority(PriorityPolling), name)
+			if err != nil {
+				log.Printf("telemetry %v: %v", name, err)
+			}
+			if err := s.Append(sample); err != nil {
+				log.Printf("telemetry append %v: %v", name, err)
+			}
+		}
+
+		if time.Since(lastPrune) > 24*time.Hour {
+			if err := s.Prune(); err != nil {
+				log.Printf("telemetry prune: %v", err)
+			}
+			lastPrune = time.Now()
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// DownsampleTelemetry aggregates the samples of each device into buckets of the given interval.
+// Temperatures are averaged, usage hours and counters take the maximum and
+// power and status take the last known value of the bucket.
+func DownsampleTelemetry(samples []TelemetrySample, interval time.Duration) []TelemetrySample {
+	type key struct {
+		device string
+		bucket time.Time
+	}
+	type acc struct {
+		sample   TelemetrySample
+		t1, t2   float32
+		nt1, nt2 int
+	}
+
+	buckets := map[key]*acc{}
+	var order []key
+	for _, s := range samples {
+		k := key{s.Device, s.Time.Truncate(interval)}
+		a, ok := buckets[k]
+		if !ok {
+			a = &acc{sample: TelemetrySample{Device: s.Device, Time: k.bucket}}
+			buckets[k] = a
+			order = append(order, k)
+		}
+
+		if s.PowerOn != nil {
+			on := *s.PowerOn
+			a.sample.PowerOn = &on
+		}
+		if s.Status != "" {
+			a.sample.Status = s.Status
+		}
+		if s.Temp1 != nil {
+			a.t1 += *s.Temp1
+			a.nt1++
+		}
+		if s.Temp2 != nil {
+			a.t2 += *s.Temp2
+			a.nt2++
+		}
+		if s.UsageHours != nil && (a.sample.UsageHours == nil || *s.UsageHours > *a.sample.UsageHours) {
+			hours := *s.UsageHours
+			a.sample.UsageHours = &hours
+		}
+		for name, v := range s.Counters {
+			if a.sample.Counters == nil {
+				a.sample.Counters = map[string]uint8{}
+			}
+			a.sample.Counters[name] = max(a.sample.Counters[name], v)
+		}
+	}
+
+	result := make([]TelemetrySample, 0, len(order))
+	for _, k := range order {
+		a := buckets[k]
+		if a.nt1 > 0 {
+			t := a.t1 / float32(a.nt1)
+			a.sample.Temp1 = &t
+		}
+		if a.nt2 > 0 {
+			t := a.t2 / float32(a.nt2)
+			a.sample.Temp2 = &t
+		}
+		result = append(result, a.sample)
+	}
+	sort.SliceStable(result, func(i, j int) bool { return result[i].Time.Before(result[j].Time) })
+	return result
+}
+
+// ExportTelemetryJSON writes the samples as a JSON array.
+func ExportTelemetryJSON(w io.Writer, samples []TelemetrySample) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	if samples == nil {
+		samples = []TelemetrySample{}
+	}
+	return enc.Encode(samples)
+}
+
+// ExportTelemetryCSV writes the samples as CSV with a header row.
+// Values that were not read are left empty.
+func ExportTelemetryCSV(w io.Writer, samples []TelemetrySample) error {
+	counters := (&ErrorStatus{}).Counters()
+
+	header := []string{"device", "time", "power_on", "status", "temp1", "temp2", "usage_hours"}
+	for _, c := range counters {
+		header = append(header, c.Name)
+	}
+
+	cw := csv.NewWriter(w)
+	if err := cw.Write(header); err != nil {
+		return err
+	}
+
+	for _, s := range samples {
+		row := []string{s.Device, s.Time.Format(time.RFC3339), "", s.Status, "", "", ""}
+		if s.PowerOn != nil {
+			row[2] = strconv.FormatBool(*s.PowerOn)
+		}
+		if s.Temp1 != nil {
+			row[4] = strconv.FormatFloat(float64(*s.Temp1), 'f', 1, 32)
+		}
+		if s.Temp2 != nil {
+			row[5] = strconv.FormatFloat(float64(*s.Temp2), 'f', 1, 32)
+		}
+		if s.UsageHours != nil {
+			row[6] = strconv.FormatUint(uint64(*s.UsageHours), 10)
+		}
+		for _, c := range counters {
+			v, ok := s.Counters[c.Name]
+			if ok {
+				row = append(row, strconv.Itoa(int(v)))
+			} else {
+				row = append(row, "")
+			}
+		}
+		if err := cw.Write(row); err != nil {
+			return err
+		}
+	}
+
+	cw.Flush()
+	return cw.Error()
+}
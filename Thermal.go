@@ -0,0 +1,280 @@
+package viewsonic
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+)
+
+// ThermalLevel is the state of the thermal monitor.
+type ThermalLevel int
+
+const (
+	ThermalNormal ThermalLevel = iota
+	ThermalWarning
+	ThermalCritical
+)
+
+func (l ThermalLevel) String() string {
+	switch l {
+	case ThermalNormal:
+		return "normal"
+	case ThermalWarning:
+		return "warning"
+	case ThermalCritical:
+		return "critical"
+	}
+	return fmt.Sprintf("ThermalLevel(%d)", int(l))
+}
+
+// ThermalAction is a protective action the monitor can take.
+type ThermalAction int
+
+const (
+	ThermalActionNone ThermalAction = iota
+	ThermalActionEco
+	ThermalActionHighAltitude
+	ThermalActionPowerOff
+)
+
+func (a ThermalAction) String() string {
+	switch a {
+	case ThermalActionNone:
+		return "none"
+	case ThermalActionEco:
+		return "eco"
+	case ThermalActionHighAltitude:
+		return "high-altitude"
+	case ThermalActionPowerOff:
+		return "power-off"
+	}
+	return fmt.Sprintf("ThermalAction(%d)", int(a))
+}
+
+// TemperatureSample is a single reading of GetOperatingTemperature.
+type TemperatureSample struct {
+	Time  time.Time
+	Temp1 float32
+	Temp2 float32
+}
+
+// Max returns the higher of both temperatures.
+func (s TemperatureSample) Max() float32 {
+	return max(s.Temp1, s.Temp2)
+}
+
+// ThermalEvent is emitted whenever the level of the monitor changes.
+type ThermalEvent struct {
+	Projector string
+	Time      time.Time
+	Level     ThermalLevel
+	Previous  ThermalLevel
+	Sample    TemperatureSample
+	Rise      float32 // degrees per minute over the window
+	Reason    string
+}
+
+// ThermalAuditEntry records a protective action taken by the monitor.
+type ThermalAuditEntry struct {
+	Time   time.Time
+	Action ThermalAction
+	Reason string
+	Err    error
+}
+
+// ThermalMonitor samples the operating temperatures, keeps a rolling window and
+// takes protective actions when thresholds are exceeded or the temperature rises too fast.
+type ThermalMonitor struct {
+	// WarningTemp and CriticalTemp are the thresholds in °C for the hotter of both sensors.
+	WarningTemp  float32
+	CriticalTemp float32
+	// Hysteresis in °C a temperature must drop below a threshold before the level is lowered.
+	Hysteresis float32
+	// MaxRise in °C per minute across the window raises a warning. Zero disables it.
+	MaxRise float32
+	// Window is the number of samples kept for the rise calculation.
+	Window int
+	// WarningActions and CriticalActions are taken once when the level is entered.
+	WarningActions  []ThermalAction
+	CriticalActions []ThermalAction
+	// OnEvent is called on every level change. It must not block.
+	OnEvent func(ThermalEvent)
+
+	name    string
+	conn    *ViewSonic
+	mutex   sync.Mutex
+	samples []TemperatureSample
+	level   ThermalLevel
+	audit   []ThermalAuditEntry
+}
+
+// NewThermalMonitor creates a monitor with conservative defaults and no protective actions.
+// It reads and acts with PriorityAutomation.
+func NewThermalMonitor(conn *ViewSonic, name string) *ThermalMonitor {
+	return &ThermalMonitor{
+		WarningTemp:  55,
+		CriticalTemp: 65,
+		Hysteresis:   2,
+		MaxRise:      2,
+		Window:       20,
+		name:         name,
+		conn:         conn.WithPriority(PriorityAutomation),
+	}
+}
+
+// Sample reads the temperatures from the projector and records them.
+func (m *ThermalMonitor) Sample() (TemperatureSample, error) {
+	t1, t2, err := m.conn.GetOperatingTemperature()
+	if err != nil {
+		return TemperatureSample{}, err
+	}
+
+	sample := TemperatureSample{Time: time.Now(), Temp1: t1, Temp2: t2}
+	m.Record(sample)
+	return sample, nil
+}
+
+// Record adds a sample to the window, evaluates the level and takes actions on a level increase.
+func (m *ThermalMonitor) Record(sample TemperatureSample) {
+	m.mutex.Lock()
+	m.samples = append(m.samples, sample)
+	if m.Window > 0 && len(m.samples) > m.Window {
+		m.samples = m.samples[len(m.samples)-m.Window:]
+	}
+
+	rise := m.rise()
+	level, reason := m.evaluate(sample, rise)
+	previous := m.level
+	m.level = level
+	m.mutex.Unlock()
+
+	if level == previous {
+		return
+	}
+
+	event := ThermalEvent{
+		Projector: m.name,
+		Time:      sample.Time,
+		Level:     level,
+		Previous:  previous,
+		Sample:    sample,
+		Rise:      rise,
+		Reason:    reason,
+	}
+	log.Printf("thermal %v: %v: %v", level, m.name, reason)
+	if m.OnEvent != nil {
+		m.OnEvent(event)
+	}
+
+	if level > previous {
+		switch level {
+		case ThermalWarning:
+			m.act(m.WarningActions, reason)
+		case ThermalCritical:
+			m.act(m.CriticalActions, reason)
+		}
+	}
+}
+
+// rise returns the temperature change in °C per minute across the window.
+func (m *ThermalMonitor) rise() float32 {
+	if len(m.samples) < 2 {
+		return 0
+	}
+	first, last := m.samples[0], m.samples[len(m.samples)-1]
+	minutes := last.Time.Sub(first.Time).Minutes()
+	if minutes <= 0 {
+		return 0
+	}
+	return (last.Max() - first.Max()) / float32(minutes)
+}
+
+func (m *ThermalMonitor) evaluate(sample TemperatureSample, rise float32) (ThermalLevel, string) {
+	temp := sample.Max()
+
+	// Keep the current level until the temperature drops below the threshold minus hysteresis
+	critical, warning := m.CriticalTemp, m.WarningTemp
+	if m.level >= ThermalCritical {
+		critical -= m.Hysteresis
+	}
+	if m.level >= ThermalWarning {
+		warning -= m.Hysteresis
+	}
+
+	switch {
+	case temp >= critical:
+		return ThermalCritical, fmt.Sprintf("temperature %.1f°C above critical threshold %.1f°C", temp, m.CriticalTemp)
+	case temp >= warning:
+		return ThermalWarning, fmt.Sprintf("temperature %.1f°C above warning threshold %.1f°C", temp, m.WarningTemp)
+	case m.MaxRise > 0 && rise > m.MaxRise:
+		return ThermalWarning, fmt.Sprintf("temperature rising %.1f°C/min, limit %.1f°C/min", rise, m.MaxRise)
+	}
+	return ThermalNormal, fmt.Sprintf("temperature %.1f°C back to normal", temp)
+}
+
+func (m *ThermalMonitor) act(actions []ThermalAction, reason string) {
+	for _, action := range actions {
+		var err error
+		switch action {
+		case ThermalActionNone:
+			continue
+		case ThermalActionEco:
+			err = m.conn.SetLightSourceMode(LightSourceModeEco)
+		case ThermalActionHighAltitude:
+			err = m.conn.SetHighAltitudeMode(true)
+		case ThermalActionPowerOff:
+			err = m.conn.SetPower(PowerStateOff)
+		default:
+			err = fmt.Errorf("unknown thermal action %v", action)
+		}
+
+		if err != nil {
+			log.Printf("thermal action %v failed for %v: %v", action, m.name, err)
+		}
+
+		m.mutex.Lock()
+		m.audit = append(m.audit, ThermalAuditEntry{Time: time.Now(), Action: action, Reason: reason, Err: err})
+		m.mutex.Unlock()
+	}
+}
+
+// Level returns the current thermal level.
+func (m *ThermalMonitor) Level() ThermalLevel {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	return m.level
+}
+
+// Samples returns a copy of the rolling window.
+func (m *ThermalMonitor) Samples() []TemperatureSample {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	return append([]TemperatureSample(nil), m.samples...)
+}
+
+// Audit returns a copy of all protective actions taken so far.
+func (m *ThermalMonitor) Audit() []ThermalAuditEntry {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	return append([]ThermalAuditEntry(nil), m.audit...)
+}
+
+// Run samples the projector every interval until the context is cancelled.
+func (m *ThermalMonitor) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := m.Sample(); err != nil {
+			log.Printf("temperature sample failed for %v: %v", m.name, err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -3,14 +3,13 @@ package viewsonic
 import (
 	"context"
 	"encoding/binary"
+	"errors"
 	"fmt"
-	"io"
 	"log"
 	"net"
 	"sync"
+	"sync/atomic"
 	"time"
-
-	"github.com/jpillora/backoff"
 )
 
 // ErrFunctionDisabled is returned when the projector indicates that a function is disabled (greyed out).
@@ -18,113 +17,141 @@ import (
 // like "Aspect Ratio" unavailable via OSD menu or remote control.
 var ErrFunctionDisabled = fmt.Errorf("function is disabled (greyed out) on the projector")
 
+// ErrUnexpectedResponse is returned when a response of the projector can not be decoded,
+// e.g. a read response of the wrong length.
+var ErrUnexpectedResponse = errors.New("unexpected response")
+
 // ViewSonic is a connection to the projector. It is designed to be thread-safe.
 // It automatically handles reconnects in the background.
+// Commands are queued by priority and sent one at a time, see WithPriority.
 type ViewSonic struct {
-	conn             net.Conn
-	mutex            sync.Mutex
+	*client
+
+	priority Priority
+	caller   string
+}
+
+// client is the state shared by the handles of a connection.
+type client struct {
+	// conn is only used by the dispatcher goroutine
+	conn             *frameConn
+	ctx              context.Context
 	cancelContext    context.CancelFunc
 	triggerReconnect chan struct{}
+	wake             chan struct{}
+
+	// mutex guards the queues, the pacing and the connection handlers
+	mutex      sync.Mutex
+	queues     [numPriorities]queue
+	queueLimit int
+	closed     bool
+	pacer      pacer
+
+	connectionHandlers map[int]func(connected bool)
+	nextHandler        int
+
+	// macroMutex keeps macros from interleaving their key presses
+	macroMutex sync.Mutex
+
+	model atomic.Pointer[Model]
 }
 
-// New generates a Connection and starts a background goroutine to maintain the connection.
-func New(ip string) *ViewSonic {
+// Dialer opens the byte stream to the projector, e.g. a TCP connection to its LAN port.
+// Wrapping a Dialer can record or translate the stream.
+type Dialer interface {
+	Dial() (net.Conn, error)
+}
+
+// DialerFunc adapts a function to the Dialer interface.
+type DialerFunc func() (net.Conn, error)
+
+func (f DialerFunc) Dial() (net.Conn, error) {
+	return f()
+}
+
+// TCPDialer dials the RS-232-over-LAN port of the projector, e.g. "192.168.1.50:4661".
+func TCPDialer(addr string) Dialer {
 	// Create custom dialer in order to set TCP KeepAlive
-	var DefaultDialer = &net.Dialer{
+	dialer := &net.Dialer{
 		Timeout:   5 * time.Second,
 		KeepAlive: 10 * time.Second,
 	}
+	return DialerFunc(func() (net.Conn, error) {
+		return dialer.Dial("tcp", addr)
+	})
+}
 
-	// Create Context to cancel reconnect loop
+// New generates a Connection and starts a background goroutine to maintain the connection.
+func New(ip string) *ViewSonic {
+	return NewWithDialer(ip, TCPDialer(ip))
+}
+
+// NewWithDialer is New with another transport than TCP. The name identifies the projector
+// in log messages.
+func NewWithDialer(name string, dialer Dialer) *ViewSonic {
+	// Create Context to cancel the dispatcher
 	ctx, cancel := context.WithCancel(context.Background())
 
-	c := &ViewSonic{
+	c := &client{
+		ctx:              ctx,
 		cancelContext:    cancel,
 		triggerReconnect: make(chan struct{}, 1),
+		wake:             make(chan struct{}, 1),
+		queueLimit:       DefaultQueueLimit,
+		pacer:            newPacer(Pacing{}),
 	}
 
 	// Initial connection attempt
-	tmpConn, err := DefaultDialer.Dial("tcp", ip)
+	tmpConn, err := dialer.Dial()
 	if err != nil {
+		// The dispatcher dials again in the background
 		log.Println(err)
-		// Trigger immediate reconnect attempt in the background
-		select {
-		case c.triggerReconnect <- struct{}{}:
-		default:
-		}
 	} else {
-		c.conn = tmpConn
-	}
-
-	b := &backoff.Backoff{
-		Min:    2 * time.Second,
-		Max:    30 * time.Second,
-		Factor: 1.19,
-		Jitter: false,
-	}
-
-	go func() {
-		ticker := time.NewTicker(30 * time.Second)
-		defer ticker.Stop()
-
-		for {
-			select {
-			case <-ctx.Done():
-				log.Println("Stopping Reconnect loop")
-				c.mutex.Lock()
-				if c.conn != nil {
-					c.conn.Close()
-				}
-				c.mutex.Unlock()
-				return
-			case <-c.triggerReconnect:
-				c.mutex.Lock()
-				if c.conn != nil {
-					c.conn.Close()
-					c.conn = nil
-				}
-
-				conn, err := DefaultDialer.Dial("tcp", ip)
-				if err != nil {
-					log.Println("reconnect error: ", err)
-					c.mutex.Unlock()
-					// Try again with backoff
-					time.Sleep(b.Duration())
-					select {
-					case c.triggerReconnect <- struct{}{}:
-					default:
-					}
-				} else {
-					log.Println("reconnect success:", ip)
-					b.Reset()
-					c.conn = conn
-					c.mutex.Unlock()
-				}
-			case <-ticker.C:
-				// Health check: read power status
-				c.mutex.Lock()
-				if c.conn != nil {
-					_, _, err := tx(c.conn, c.triggerReconnect, cmdRead, []byte{0x34, 0x00, 0x00, 0x11, 0x00})
-					if err != nil {
-						log.Printf("Health check failed for %v: %v", ip, err)
-					}
-				} else {
-					c.mutex.Unlock()
-					// If there's no connection, trigger a reconnect.
-					select {
-					case c.triggerReconnect <- struct{}{}:
-					default:
-					}
-				}
-				c.mutex.Unlock()
-			}
-		}
-	}()
+		c.conn = newFrameConn(tmpConn)
+	}
+
+	go c.run(dialer, name)
+
+	return &ViewSonic{client: c}
+}
+
+// OnConnectionChange registers a function that is called from the reconnect loop
+// whenever the connection is lost or restored. It must not block.
+// The returned function removes the registration.
+func (conn *ViewSonic) OnConnectionChange(fn func(connected bool)) func() {
+	conn.mutex.Lock()
+	defer conn.mutex.Unlock()
+
+	if conn.connectionHandlers == nil {
+		conn.connectionHandlers = map[int]func(bool){}
+	}
+	id := conn.nextHandler
+	conn.nextHandler++
+	conn.connectionHandlers[id] = fn
+
+	return func() {
+		conn.mutex.Lock()
+		defer conn.mutex.Unlock()
+		delete(conn.connectionHandlers, id)
+	}
+}
 
-	return c
+// connectionChanged calls the registered connection handlers.
+func (c *client) connectionChanged(connected bool) {
+	c.mutex.Lock()
+	handlers := make([]func(bool), 0, len(c.connectionHandlers))
+	for _, fn := range c.connectionHandlers {
+		handlers = append(handlers, fn)
+	}
+	c.mutex.Unlock()
+
+	for _, fn := range handlers {
+		fn(connected)
+	}
 }
 
 // Close closes the connection to the projector and stops the reconnect loop.
+// Queued commands fail with ErrClosed.
 func (conn *ViewSonic) Close() {
 	conn.cancelContext()
 }
@@ -138,30 +165,42 @@ const (
 	cmdRead          = 0x07
 )
 
-// tx handles the low-level transmission of a command and reception of a response.
-// It is responsible for locking the connection, sending the packet, and parsing the response.
+// tx queues a command and waits for the response. The dispatcher sends it with the
+// package level tx, which parses the response.
+// Only a response of the type and width the request expects is returned, other frames are
+// discarded and reported as ResponseError if nothing fitting arrives.
 // If any network error occurs, it triggers a reconnect and returns the error.
 // The caller is responsible for retrying the command if necessary.
 func (conn *ViewSonic) tx(cmd1 uint8, data []byte) (uint8, []byte, error) {
-	conn.mutex.Lock()
-	defer conn.mutex.Unlock()
+	r, err := conn.enqueue(cmd1, data, conn.priority, conn.caller)
+	if err != nil {
+		return 0, nil, err
+	}
+	<-r.done
+	return r.respCmd, r.resp, r.err
+}
 
-	if conn.conn == nil {
-		// If connection is not available, trigger a reconnect and return an error immediately.
+func tx(conn *frameConn, triggerReconnect chan struct{}, cmd1 uint8, data []byte) (uint8, []byte, error) {
+	reconnect := func() {
 		select {
-		case conn.triggerReconnect <- struct{}{}:
+		case triggerReconnect <- struct{}{}:
 		default:
 		}
-		return 0, nil, fmt.Errorf("connection not established")
 	}
 
-	return tx(conn.conn, conn.triggerReconnect, cmd1, data)
-}
-
-func tx(conn net.Conn, triggerReconnect chan struct{}, cmd1 uint8, data []byte) (uint8, []byte, error) {
-	// Clear Buffer by setting a short deadline and reading whatever is there.
-	conn.SetReadDeadline(time.Now().Add(1 * time.Millisecond))
-	_, _ = io.Copy(io.Discard, conn)
+	// Drop frames nobody waits for. After a timeout the late reply gets more time to arrive,
+	// so that it is not taken for the response to this request.
+	wait := 1 * time.Millisecond
+	if conn.stale > 0 {
+		wait = staleWait
+	}
+	discarded, err := conn.discard(time.Now().Add(wait))
+	if err != nil {
+		reconnect()
+		return 0, nil, fmt.Errorf("read error: %w", err)
+	}
+	// The requests that timed out and may still be answered
+	late := max(conn.stale-discarded, 0)
 
 	// Build Packet
 	packet := make([]byte, 0, 4+len(data)+1)
@@ -171,54 +210,44 @@ func tx(conn net.Conn, triggerReconnect chan struct{}, cmd1 uint8, data []byte)
 	packet = append(packet, checkSum(packet[1:]))
 
 	conn.SetWriteDeadline(time.Now().Add(2 * time.Second))
-	_, err := conn.Write(packet)
-	if err != nil {
-		select {
-		case triggerReconnect <- struct{}{}:
-		default:
-		}
+	sent := time.Now()
+	if _, err := conn.Write(packet); err != nil {
+		reconnect()
 		return 0, nil, fmt.Errorf("write error: %w", err)
 	}
 
-	// Read Response
-	head := make([]byte, 5)
-
-	conn.SetReadDeadline(time.Now().Add(2 * time.Second))
-	n, err := io.ReadFull(conn, head)
-	if err != nil {
-		log.Printf("Error reading Command Head: %x", head[:n])
-		select {
-		case triggerReconnect <- struct{}{}:
-		default:
+	// Read Response, skipping frames that do not fit the request
+	want := expect(cmd1, data)
+	deadline := time.Now().Add(responseTimeout)
+	var mismatch *ResponseError
+	for {
+		frame, err := conn.readFrame(deadline)
+		if isTimeout(err) {
+			if mismatch != nil {
+				// The projector answered, only the late replies still pending are stale
+				conn.stale = late
+				return 0, nil, mismatch
+			}
+			conn.stale++
+			if conn.stale >= maxStale {
+				reconnect()
+			}
+			return 0, nil, ErrNoResponse
 		}
-		return 0, nil, err
-	}
-
-	dataLen := binary.LittleEndian.Uint16(head[3:5]) // Command Length
-
-	rxData := make([]byte, dataLen+1) // +1 for Checksum
-	conn.SetReadDeadline(time.Now().Add(100 * time.Millisecond))
-	n, err = io.ReadFull(conn, rxData)
-	if err != nil {
-		log.Printf("Error reading Command Data: %x", rxData[:n])
-		select {
-		case triggerReconnect <- struct{}{}:
-		default:
+		if err != nil {
+			reconnect()
+			return 0, nil, fmt.Errorf("read error: %w", err)
 		}
-		return 0, nil, err
-	}
-
-	// Verify Checksum
-	if rxData[len(rxData)-1] != checkSum(head[1:], rxData[:len(rxData)-1]) {
-		log.Printf("Error: invalid checksum: %x", rxData)
-		select {
-		case triggerReconnect <- struct{}{}:
-		default:
+		// The error frame fits every request, while late replies are pending it is only taken
+		// for the response after staleWait
+		if want.matches(frame) || frame[0] == cmdError && (late == 0 || time.Since(sent) > staleWait) {
+			conn.stale = 0
+			return frame[0], frame[5 : len(frame)-1], nil // return cmdType and data without head and checksum
 		}
-		return 0, nil, fmt.Errorf("invalid checksum")
+		late = max(late-1, 0)
+		log.Printf("Discarded response % X to % X", frame, packet)
+		mismatch = &ResponseError{Request: packet, Response: frame, Expected: want.String()}
 	}
-
-	return head[0], rxData[:len(rxData)-1], nil // return cmdType and data without checksum
 }
 
 // checkSum adds alle values toggetter and returns the sum
@@ -236,6 +265,9 @@ func checkSum(data ...[]byte) byte {
 // If the connection is down, it will return an error. The background process is responsible for reconnecting.
 // The caller may choose to retry the command after a short delay.
 func (conn *ViewSonic) Write(command uint16, value int8) error {
+	if err := conn.checkModel(command, value, true); err != nil {
+		return err
+	}
 	cmd1, data, err := conn.tx(cmdWrite, []byte{0x34, byte(command >> 8), byte(command), byte(value)})
 	if err != nil {
 		return err
@@ -246,7 +278,7 @@ func (conn *ViewSonic) Write(command uint16, value int8) error {
 	}
 
 	if cmd1 != cmdWriteResponse || len(data) != 0 {
-		return fmt.Errorf("unexpected response command: 0x%02X, %x", cmd1, data)
+		return fmt.Errorf("%w command: 0x%02X, %x", ErrUnexpectedResponse, cmd1, data)
 	}
 
 	return nil
@@ -254,6 +286,9 @@ func (conn *ViewSonic) Write(command uint16, value int8) error {
 
 // WriteKey is a specialized version of Write for Remote Key commands such as Menu, Enter, etc.
 func (conn *ViewSonic) WriteKey(command uint16, value uint8) error {
+	if err := conn.checkModel(command, int8(value), true); err != nil {
+		return err
+	}
 	cmd1, data, err := conn.tx(cmdWriteKey, []byte{0x34, byte(command >> 8), byte(command), value})
 	if err != nil {
 		return err
@@ -264,7 +299,7 @@ func (conn *ViewSonic) WriteKey(command uint16, value uint8) error {
 	}
 
 	if cmd1 != cmdWriteResponse || len(data) != 0 {
-		return fmt.Errorf("unexpected response command: 0x%02X, %x", cmd1, data)
+		return fmt.Errorf("%w command: 0x%02X, %x", ErrUnexpectedResponse, cmd1, data)
 	}
 
 	return nil
@@ -274,6 +309,9 @@ func (conn *ViewSonic) WriteKey(command uint16, value uint8) error {
 // If the connection is down, it will return an error. The background process is responsible for reconnecting.
 // The caller may choose to retry the command after a short delay.
 func (conn *ViewSonic) Read(command uint16) (int8, error) {
+	if err := conn.checkModel(command, 0, false); err != nil {
+		return 0, err
+	}
 	cmd1, data, err := conn.tx(cmdRead, []byte{0x34, 0x00, 0x00, byte(command >> 8), byte(command)})
 	if err != nil {
 		return 0, err
@@ -284,7 +322,7 @@ func (conn *ViewSonic) Read(command uint16) (int8, error) {
 	}
 
 	if cmd1 != cmdReadResponse || len(data) != 3 {
-		return 0, fmt.Errorf("unexpected response command: 0x%02X, %x", cmd1, data)
+		return 0, fmt.Errorf("%w command: 0x%02X, %x", ErrUnexpectedResponse, cmd1, data)
 	}
 
 	return int8(data[2]), nil
@@ -294,6 +332,9 @@ func (conn *ViewSonic) Read(command uint16) (int8, error) {
 // If the connection is down, it will return an error. The background process is responsible for reconnecting.
 // The caller may choose to retry the command after a short delay.
 func (conn *ViewSonic) Read2Bytes(command uint16) (int16, error) {
+	if err := conn.checkModel(command, 0, false); err != nil {
+		return 0, err
+	}
 	cmd1, data, err := conn.tx(cmdRead, []byte{0x34, 0x00, 0x00, byte(command >> 8), byte(command)})
 	if err != nil {
 		return 0, err
@@ -304,7 +345,7 @@ func (conn *ViewSonic) Read2Bytes(command uint16) (int16, error) {
 	}
 
 	if cmd1 != cmdReadResponse || len(data) != 4 {
-		return 0, fmt.Errorf("unexpected response command: 0x%02X, %x", cmd1, data)
+		return 0, fmt.Errorf("%w command: 0x%02X, %x", ErrUnexpectedResponse, cmd1, data)
 	}
 
 	return int16(binary.LittleEndian.Uint16(data[2:4])), nil
@@ -314,6 +355,9 @@ func (conn *ViewSonic) Read2Bytes(command uint16) (int16, error) {
 // If the connection is down, it will return an error. The background process is responsible for reconnecting.
 // The caller may choose to retry the command after a short delay.
 func (conn *ViewSonic) ReadNBytes(command uint16) ([]byte, error) {
+	if err := conn.checkModel(command, 0, false); err != nil {
+		return nil, err
+	}
 	cmd1, data, err := conn.tx(cmdRead, []byte{0x34, 0x00, 0x00, byte(command >> 8), byte(command)})
 	if err != nil {
 		return nil, err
@@ -324,7 +368,7 @@ func (conn *ViewSonic) ReadNBytes(command uint16) ([]byte, error) {
 	}
 
 	if cmd1 != cmdReadResponse {
-		return nil, fmt.Errorf("unexpected response command: 0x%02X, %x", cmd1, data)
+		return nil, fmt.Errorf("%w command: 0x%02X, %x", ErrUnexpectedResponse, cmd1, data)
 	}
 
 	return data, nil
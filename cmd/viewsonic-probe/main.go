@@ -0,0 +1,85 @@
+// Command viewsonic-probe reads every command of the registry in each power state and writes
+// a capability report that can seed a model profile.
+//
+// Usage:
+//
+//	viewsonic-probe [-format markdown] [-model name] [-current] [-settle 60s] host:port
+//
+// Without -current the projector is switched off and on, which takes several minutes.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"os/signal"
+	"time"
+
+	"github.com/m-baertschi/viewsonic"
+)
+
+func main() {
+	format := flag.String("format", "json", "report format, json or markdown")
+	model := flag.String("model", "Unknown", "model name of the report and the seeded profile")
+	current := flag.Bool("current", false, "probe only the current power state")
+	settle := flag.Duration("settle", 60*time.Second, "pause after a power change")
+	timeout := flag.Duration("timeout", 3*time.Minute, "maximum wait for a power change")
+	out := flag.String("o", "", "output file, stdout if empty")
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "Usage: %s [flags] host:port\n", os.Args[0])
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	if flag.NArg() != 1 || (*format != "json" && *format != "markdown") {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	dialer, err := viewsonic.ParseDialer(flag.Arg(0))
+	if err != nil {
+		log.Fatal(err)
+	}
+	conn := viewsonic.NewWithDialer(flag.Arg(0), dialer)
+	defer conn.Close()
+
+	opts := viewsonic.ProbeOptions{
+		Settle:  *settle,
+		Timeout: *timeout,
+		Logf:    log.Printf,
+	}
+	if !*current {
+		opts.PowerStates = []viewsonic.PowerState{viewsonic.PowerStateOff, viewsonic.PowerStateOn}
+	}
+	report, err := viewsonic.ProbeCapabilities(ctx, conn, opts)
+	if err != nil {
+		if report == nil || len(report.Results) == 0 {
+			log.Fatal(err)
+		}
+		log.Printf("probe incomplete: %v", err)
+	}
+
+	w := os.Stdout
+	if *out != "" {
+		f, err := os.Create(*out)
+		if err != nil {
+			log.Fatal(err)
+		}
+		defer f.Close()
+		w = f
+	}
+
+	if *format == "markdown" {
+		err = viewsonic.ExportCapabilityMarkdown(w, report, *model)
+	} else {
+		err = viewsonic.ExportCapabilityJSON(w, report)
+	}
+	if err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,164 @@
+// Command viewsonic-trace records, decodes and replays the traffic between a client and the
+// projector.
+//
+// Usage:
+//
+//	viewsonic-trace record [-listen :4661] [-o trace.jsonl] host:port
+//	viewsonic-trace view trace.jsonl
+//	viewsonic-trace replay [-listen :4661] trace.jsonl
+//
+// record is a proxy that captures every frame between its clients and the projector, replay
+// serves the recorded responses so a bug report can be reproduced without the projector.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"net"
+	"os"
+
+	"github.com/m-baertschi/viewsonic"
+)
+
+func main() {
+	log.SetFlags(log.Ltime)
+	if len(os.Args) < 2 {
+		usage()
+	}
+	args := os.Args[2:]
+	var err error
+	switch os.Args[1] {
+	case "record":
+		err = record(args)
+	case "view":
+		err = view(args)
+	case "replay":
+		err = replay(args)
+	default:
+		usage()
+	}
+	if err != nil {
+		log.Fatal(err)
+	}
+}
+
+func usage() {
+	fmt.Fprintf(os.Stderr, "Usage:\n"+
+		"  %[1]s record [-listen :4661] [-o trace.jsonl] host:port\n"+
+		"  %[1]s view trace.jsonl\n"+
+		"  %[1]s replay [-listen :4661] trace.jsonl\n", os.Args[0])
+	os.Exit(2)
+}
+
+// record forwards the connections of clients to the projector and logs the frames.
+func record(args []string) error {
+	flags := flag.NewFlagSet("record", flag.ExitOnError)
+	listen := flags.String("listen", ":4661", "listen address of the proxy")
+	out := flags.String("o", "trace.jsonl", "output file, appended to")
+	flags.Parse(args)
+	if flags.NArg() != 1 {
+		usage()
+	}
+
+	f, err := os.OpenFile(*out, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o644)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	frames := viewsonic.NewFrameLog(f)
+	projector, err := viewsonic.ParseDialer(flags.Arg(0))
+	if err != nil {
+		return err
+	}
+	dialer := viewsonic.TapDialer(projector, func(frame viewsonic.Frame) {
+		frames.Record(frame)
+		log.Printf("%v %v", frame.Direction, viewsonic.DescribeFrame(frame.Data, nil))
+	})
+
+	l, err := net.Listen("tcp", *listen)
+	if err != nil {
+		return err
+	}
+	log.Printf("recording %v to %v on %v", flags.Arg(0), *out, l.Addr())
+	for {
+		client, err := l.Accept()
+		if err != nil {
+			return err
+		}
+		go func() {
+			defer client.Close()
+			projector, err := dialer.Dial()
+			if err != nil {
+				log.Println(err)
+				return
+			}
+			defer projector.Close()
+			go io.Copy(client, projector)
+			io.Copy(projector, client)
+		}()
+	}
+}
+
+// view prints the decoded frames of a trace.
+func view(args []string) error {
+	if len(args) != 1 {
+		usage()
+	}
+	frames, err := readTrace(args[0])
+	if err != nil {
+		return err
+	}
+	var request []byte
+	for _, f := range frames {
+		if f.Direction == viewsonic.FrameRequest {
+			request = f.Data
+		}
+		fmt.Printf("%-50v %v\n", f, viewsonic.DescribeFrame(f.Data, request))
+	}
+	return nil
+}
+
+// replay answers clients with the responses of a trace.
+func replay(args []string) error {
+	flags := flag.NewFlagSet("replay", flag.ExitOnError)
+	listen := flags.String("listen", ":4661", "listen address")
+	flags.Parse(args)
+	if flags.NArg() != 1 {
+		usage()
+	}
+
+	frames, err := readTrace(flags.Arg(0))
+	if err != nil {
+		return err
+	}
+	replayer := viewsonic.NewReplayer(frames)
+	replayer.Logf = log.Printf
+
+	l, err := net.Listen("tcp", *listen)
+	if err != nil {
+		return err
+	}
+	log.Printf("replaying %v frames on %v", len(frames), l.Addr())
+	for {
+		conn, err := l.Accept()
+		if err != nil {
+			return err
+		}
+		go func() {
+			if err := replayer.Serve(conn); err != nil {
+				log.Println(err)
+			}
+		}()
+	}
+}
+
+func readTrace(name string) ([]viewsonic.Frame, error) {
+	f, err := os.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return viewsonic.ReadFrames(f)
+}
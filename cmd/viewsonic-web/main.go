@@ -0,0 +1,91 @@
+// Command viewsonic-web serves the web control panel for one or more projectors.
+//
+// Usage:
+//
+//	viewsonic-web [-listen :8080] [-interval 2s] [-model LS920WU] name=host:port...
+//
+// A projector behind an RFC 2217 serial device server is given as name=rfc2217://host:port?baud=115200.
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"os/signal"
+	"strings"
+	"time"
+
+	"github.com/m-baertschi/viewsonic"
+	"github.com/m-baertschi/viewsonic/web"
+	"github.com/m-baertschi/viewsonic/wsapi"
+)
+
+func main() {
+	listen := flag.String("listen", ":8080", "HTTP listen address")
+	interval := flag.Duration("interval", 2*time.Second, "poll interval per projector")
+	modelName := flag.String("model", "", "model profile of the projectors, e.g. LS920WU or v1.19")
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "Usage: %s [flags] name=host:port...\n", os.Args[0])
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	if flag.NArg() == 0 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	var model *viewsonic.Model
+	if *modelName != "" {
+		if model = viewsonic.LookupModel(*modelName); model == nil {
+			log.Fatalf("unknown model %q", *modelName)
+		}
+	}
+
+	projectors := map[string]*viewsonic.ViewSonic{}
+	for _, arg := range flag.Args() {
+		name, addr, ok := strings.Cut(arg, "=")
+		if !ok || name == "" || addr == "" {
+			log.Fatalf("invalid projector %q, expected name=host:port", arg)
+		}
+		if _, exists := projectors[name]; exists {
+			log.Fatalf("duplicate projector %q", name)
+		}
+		dialer, err := viewsonic.ParseDialer(addr)
+		if err != nil {
+			log.Fatalf("projector %q: %v", name, err)
+		}
+		conn := viewsonic.NewWithDialer(addr, dialer)
+		conn.SetModel(model)
+		projectors[name] = conn
+	}
+	defer func() {
+		for _, conn := range projectors {
+			conn.Close()
+		}
+	}()
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	api := wsapi.NewServer(projectors)
+	api.Interval = *interval
+	go api.Run(ctx)
+
+	server := &http.Server{Addr: *listen, Handler: web.Handler(api)}
+	go func() {
+		<-ctx.Done()
+		shutdown, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		server.Shutdown(shutdown)
+	}()
+
+	log.Printf("serving control panel on %v", *listen)
+	if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,112 @@
+package dmx
+
+import (
+	"context"
+	"errors"
+	"log"
+	"net"
+	"strconv"
+	"sync"
+)
+
+// Listener receives DMX frames and passes them to its outputs.
+type Listener struct {
+	outputs []*Output
+}
+
+// NewListener creates a listener feeding the outputs.
+func NewListener(outputs ...*Output) *Listener {
+	return &Listener{outputs: outputs}
+}
+
+// Handle passes a frame to every output.
+func (l *Listener) Handle(frame Frame) {
+	for _, o := range l.outputs {
+		o.Handle(frame)
+	}
+}
+
+// Run starts the outputs and listens on all given connections until the context is cancelled.
+// Use ListenSACN and ListenArtNet to open the connections.
+func (l *Listener) Run(ctx context.Context, sacn, artNet []net.PacketConn) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var wg sync.WaitGroup
+	for _, o := range l.outputs {
+		wg.Add(1)
+		go func(o *Output) {
+			defer wg.Done()
+			o.Run(ctx)
+		}(o)
+	}
+
+	errs := make(chan error, len(sacn)+len(artNet))
+	serve := func(conn net.PacketConn, parse func([]byte) (Frame, error)) {
+		errs <- l.serve(conn, parse)
+		cancel()
+	}
+	for _, conn := range sacn {
+		go serve(conn, ParseSACN)
+	}
+	for _, conn := range artNet {
+		go serve(conn, ParseArtNet)
+	}
+
+	<-ctx.Done()
+	for _, conn := range append(sacn, artNet...) {
+		conn.Close()
+	}
+	wg.Wait()
+
+	var result []error
+	for range len(sacn) + len(artNet) {
+		result = append(result, <-errs)
+	}
+	return errors.Join(result...)
+}
+
+func (l *Listener) serve(conn net.PacketConn, parse func([]byte) (Frame, error)) error {
+	buf := make([]byte, 1500)
+	for {
+		n, from, err := conn.ReadFrom(buf)
+		if err != nil {
+			if errors.Is(err, net.ErrClosed) {
+				return nil
+			}
+			return err
+		}
+
+		frame, err := parse(buf[:n])
+		if err != nil {
+			if !errors.Is(err, ErrIgnored) {
+				log.Printf("dmx: invalid packet from %v: %v", from, err)
+			}
+			continue
+		}
+		l.Handle(frame)
+	}
+}
+
+// ListenSACN joins the sACN multicast groups of the universes on the interface
+// (nil for the system default). Unicast sACN is received on the same sockets.
+func ListenSACN(ifi *net.Interface, universes ...uint16) ([]net.PacketConn, error) {
+	var conns []net.PacketConn
+	for _, u := range universes {
+		group := &net.UDPAddr{IP: net.IPv4(239, 255, byte(u>>8), byte(u)), Port: SACNPort}
+		conn, err := net.ListenMulticastUDP("udp4", ifi, group)
+		if err != nil {
+			for _, c := range conns {
+				c.Close()
+			}
+			return nil, err
+		}
+		conns = append(conns, conn)
+	}
+	return conns, nil
+}
+
+// ListenArtNet listens for Art-Net on the given IP (empty for all interfaces).
+func ListenArtNet(ip string) (net.PacketConn, error) {
+	return net.ListenPacket("udp4", net.JoinHostPort(ip, strconv.Itoa(ArtNetPort)))
+}
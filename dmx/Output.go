@@ -0,0 +1,204 @@
+package dmx
+
+import (
+	"context"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/m-baertschi/viewsonic"
+)
+
+// Range maps the DMX values Min to Max (inclusive) to Value.
+type Range[T any] struct {
+	Min   uint8
+	Max   uint8
+	Value T
+}
+
+func lookup[T any](ranges []Range[T], v uint8) (T, bool) {
+	for _, r := range ranges {
+		if v >= r.Min && v <= r.Max {
+			return r.Value, true
+		}
+	}
+	var zero T
+	return zero, false
+}
+
+// Mapping assigns DMX channels of a universe to projector functions.
+// Channels are 1-512, 0 disables a function.
+type Mapping struct {
+	Universe uint16
+
+	// Shutter blanks the image at values >= 128.
+	Shutter int
+	// Freeze freezes the image at values >= 128.
+	Freeze int
+
+	Source           int
+	Sources          []Range[viewsonic.SourceInput]
+	ColorMode        int
+	ColorModes       []Range[viewsonic.ColorMode]
+	LightSourceMode  int
+	LightSourceModes []Range[viewsonic.LightSourceMode]
+
+	// Debounce is how long a value must be stable before it is sent to the projector.
+	Debounce time.Duration
+	// MinInterval is the minimum time between two commands to the projector.
+	MinInterval time.Duration
+}
+
+type function int
+
+const (
+	functionShutter function = iota
+	functionFreeze
+	functionSource
+	functionColorMode
+	functionLightSourceMode
+	functionCount
+)
+
+// channel is the debounce state of one mapped function.
+type channel struct {
+	applied      int // value last sent to the projector, -1 if unknown
+	pending      int // value requested by the console, -1 if none
+	pendingSince time.Time
+}
+
+// Output applies DMX frames to a single projector with debounce and rate limiting,
+// so a fader moving through the ranges does not flood the serial link.
+type Output struct {
+	Mapping Mapping
+
+	conn      *viewsonic.ViewSonic
+	mutex     sync.Mutex
+	channels  [functionCount]channel
+	lastWrite time.Time
+}
+
+// NewOutput creates an output for the projector with the given mapping.
+func NewOutput(conn *viewsonic.ViewSonic, mapping Mapping) *Output {
+	o := &Output{Mapping: mapping, conn: conn}
+	for i := range o.channels {
+		o.channels[i] = channel{applied: -1, pending: -1}
+	}
+	return o
+}
+
+// Handle updates the requested values from a frame. Frames of other universes are ignored.
+func (o *Output) Handle(frame Frame) {
+	if frame.Universe != o.Mapping.Universe {
+		return
+	}
+
+	m := o.Mapping
+	now := time.Now()
+
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+
+	if v, ok := frame.Channel(m.Shutter); ok {
+		o.request(functionShutter, onOff(v), now)
+	}
+	if v, ok := frame.Channel(m.Freeze); ok {
+		o.request(functionFreeze, onOff(v), now)
+	}
+	if v, ok := frame.Channel(m.Source); ok {
+		if input, ok := lookup(m.Sources, v); ok {
+			o.request(functionSource, int(input), now)
+		}
+	}
+	if v, ok := frame.Channel(m.ColorMode); ok {
+		if mode, ok := lookup(m.ColorModes, v); ok {
+			o.request(functionColorMode, int(mode), now)
+		}
+	}
+	if v, ok := frame.Channel(m.LightSourceMode); ok {
+		if mode, ok := lookup(m.LightSourceModes, v); ok {
+			o.request(functionLightSourceMode, int(mode), now)
+		}
+	}
+}
+
+func onOff(v uint8) int {
+	if v >= 128 {
+		return 1
+	}
+	return 0
+}
+
+func (o *Output) request(f function, value int, now time.Time) {
+	c := &o.channels[f]
+	if c.pending != value {
+		c.pending = value
+		c.pendingSince = now
+	}
+}
+
+// next returns a function whose pending value is stable and differs from the applied one.
+func (o *Output) next(now time.Time) (function, int, bool) {
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+
+	if now.Sub(o.lastWrite) < o.Mapping.MinInterval {
+		return 0, 0, false
+	}
+
+	for f := range o.channels {
+		c := &o.channels[f]
+		if c.pending >= 0 && c.pending != c.applied && now.Sub(c.pendingSince) >= o.Mapping.Debounce {
+			return function(f), c.pending, true
+		}
+	}
+	return 0, 0, false
+}
+
+func (o *Output) apply(f function, value int) error {
+	switch f {
+	case functionShutter:
+		return o.conn.SetBlank(value == 1)
+	case functionFreeze:
+		return o.conn.SetFreeze(value == 1)
+	case functionSource:
+		return o.conn.SetSourceInput(viewsonic.SourceInput(value))
+	case functionColorMode:
+		return o.conn.SetColorMode(viewsonic.ColorMode(value))
+	case functionLightSourceMode:
+		return o.conn.SetLightSourceMode(viewsonic.LightSourceMode(value))
+	}
+	return nil
+}
+
+// Run sends stable values to the projector until the context is cancelled.
+// A failed command is retried after MinInterval.
+func (o *Output) Run(ctx context.Context) {
+	ticker := time.NewTicker(10 * time.Millisecond)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			f, value, ok := o.next(now)
+			if !ok {
+				continue
+			}
+
+			err := o.apply(f, value)
+
+			o.mutex.Lock()
+			o.lastWrite = time.Now()
+			if err == nil {
+				o.channels[f].applied = value
+			}
+			o.mutex.Unlock()
+
+			if err != nil {
+				log.Printf("dmx: universe %d function %d value %d: %v", o.Mapping.Universe, f, value, err)
+			}
+		}
+	}
+}
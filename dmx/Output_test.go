@@ -0,0 +1,64 @@
+package dmx
+
+import (
+	"testing"
+	"time"
+
+	"github.com/m-baertschi/viewsonic"
+)
+
+func TestOutputDebounce(t *testing.T) {
+	o := NewOutput(nil, Mapping{
+		Universe: 1,
+		Source:   1,
+		Sources: []Range[viewsonic.SourceInput]{
+			{0, 99, viewsonic.SourceInputHDMI1},
+			{100, 199, viewsonic.SourceInputHDMI2},
+			{200, 255, viewsonic.SourceInputUSBC},
+		},
+		Debounce:    100 * time.Millisecond,
+		MinInterval: time.Second,
+	})
+	start := time.Now()
+
+	// A fader moving through HDMI 2 to USB-C
+	o.Handle(Frame{Universe: 1, Data: []byte{150}})
+	o.Handle(Frame{Universe: 1, Data: []byte{250}})
+	// Other universes are ignored
+	o.Handle(Frame{Universe: 2, Data: []byte{50}})
+
+	if _, _, ok := o.next(start.Add(50 * time.Millisecond)); ok {
+		t.Error("value sent before the debounce time")
+	}
+	f, value, ok := o.next(start.Add(150 * time.Millisecond))
+	if !ok || f != functionSource || viewsonic.SourceInput(value) != viewsonic.SourceInputUSBC {
+		t.Fatalf("next = %v %v %v, want source USB-C", f, value, ok)
+	}
+
+	o.channels[f].applied = value
+	o.lastWrite = start.Add(150 * time.Millisecond)
+	o.Handle(Frame{Universe: 1, Data: []byte{0}})
+	if _, _, ok := o.next(start.Add(500 * time.Millisecond)); ok {
+		t.Error("value sent within the minimum interval")
+	}
+	if f, value, ok := o.next(start.Add(1200 * time.Millisecond)); !ok || viewsonic.SourceInput(value) != viewsonic.SourceInputHDMI1 {
+		t.Errorf("next = %v %v %v, want source HDMI 1", f, value, ok)
+	}
+}
+
+func TestParseArtNet(t *testing.T) {
+	packet := append([]byte("Art-Net\x00"), 0x00, 0x50, 0x00, 14, 0x00, 0x00, 0x21, 0x01, 0x00, 0x02, 0x80, 0xFF)
+	frame, err := ParseArtNet(packet)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if frame.Universe != 0x0121 || len(frame.Data) != 2 {
+		t.Errorf("frame = %+v, want universe 0x0121 with 2 channels", frame)
+	}
+	if v, ok := frame.Channel(2); !ok || v != 0xFF {
+		t.Errorf("channel 2 = %v %v", v, ok)
+	}
+	if _, ok := frame.Channel(3); ok {
+		t.Error("channel 3 outside the frame")
+	}
+}
@@ -0,0 +1,107 @@
+// Package dmx drives ViewSonic projectors from a lighting console.
+// It receives DMX512 over sACN (E1.31) and Art-Net and maps channels to projector functions.
+package dmx
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+)
+
+// Default UDP ports.
+const (
+	SACNPort   = 5568
+	ArtNetPort = 6454
+)
+
+// Frame is the DMX data of one universe. Data[0] is channel 1.
+type Frame struct {
+	Universe uint16
+	Data     []byte
+}
+
+// Channel returns the value of the channel (1-512) and false if the frame does not contain it.
+func (f Frame) Channel(ch int) (uint8, bool) {
+	if ch < 1 || ch > len(f.Data) {
+		return 0, false
+	}
+	return f.Data[ch-1], true
+}
+
+var (
+	sacnIdentifier   = []byte("ASC-E1.17\x00\x00\x00")
+	artNetIdentifier = []byte("Art-Net\x00")
+)
+
+const (
+	sacnVectorRootData     = 0x00000004
+	sacnVectorFramingData  = 0x00000002
+	sacnVectorDMPSetProp   = 0x02
+	sacnOptionPreview      = 0x80
+	sacnOptionTerminated   = 0x40
+	sacnHeaderLength       = 126
+	artNetOpDmx            = 0x5000
+	artNetDmxHeaderLength  = 18
+	artNetProtocolRevision = 14
+)
+
+// ErrIgnored is returned for valid packets that carry no DMX data for us,
+// e.g. sACN preview data or Art-Net packets other than ArtDmx.
+var ErrIgnored = fmt.Errorf("dmx: packet ignored")
+
+// ParseSACN decodes an E1.31 data packet.
+func ParseSACN(b []byte) (Frame, error) {
+	if len(b) < sacnHeaderLength || !bytes.Equal(b[4:16], sacnIdentifier) {
+		return Frame{}, fmt.Errorf("dmx: not an sACN packet")
+	}
+	if binary.BigEndian.Uint32(b[18:22]) != sacnVectorRootData ||
+		binary.BigEndian.Uint32(b[40:44]) != sacnVectorFramingData ||
+		b[117] != sacnVectorDMPSetProp {
+		return Frame{}, ErrIgnored
+	}
+
+	options := b[112]
+	if options&(sacnOptionPreview|sacnOptionTerminated) != 0 {
+		return Frame{}, ErrIgnored
+	}
+
+	count := int(binary.BigEndian.Uint16(b[123:125])) // includes the start code
+	if count < 1 || 125+count > len(b) {
+		return Frame{}, fmt.Errorf("dmx: invalid sACN property count %d", count)
+	}
+	if b[125] != 0x00 {
+		// Only the null start code carries dimmer data
+		return Frame{}, ErrIgnored
+	}
+
+	return Frame{
+		Universe: binary.BigEndian.Uint16(b[113:115]),
+		Data:     b[126 : 125+count],
+	}, nil
+}
+
+// ParseArtNet decodes an ArtDmx packet.
+func ParseArtNet(b []byte) (Frame, error) {
+	if len(b) < 10 || !bytes.Equal(b[:8], artNetIdentifier) {
+		return Frame{}, fmt.Errorf("dmx: not an Art-Net packet")
+	}
+	if binary.LittleEndian.Uint16(b[8:10]) != artNetOpDmx {
+		return Frame{}, ErrIgnored
+	}
+	if len(b) < artNetDmxHeaderLength {
+		return Frame{}, fmt.Errorf("dmx: ArtDmx packet too short")
+	}
+	if b[11] < artNetProtocolRevision && b[10] == 0 {
+		return Frame{}, fmt.Errorf("dmx: unsupported Art-Net protocol revision %d", b[11])
+	}
+
+	length := int(binary.BigEndian.Uint16(b[16:18]))
+	if length > 512 || artNetDmxHeaderLength+length > len(b) {
+		return Frame{}, fmt.Errorf("dmx: invalid ArtDmx length %d", length)
+	}
+
+	return Frame{
+		Universe: uint16(b[15]&0x7F)<<8 | uint16(b[14]), // Net and SubUni form the 15 bit port address
+		Data:     b[artNetDmxHeaderLength : artNetDmxHeaderLength+length],
+	}, nil
+}
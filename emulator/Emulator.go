@@ -0,0 +1,356 @@
+// Package emulator is a projector speaking the ViewSonic RS-232 protocol over TCP, for tests
+// and development without hardware. The command handlers are generated from the command spec
+// of the viewsonic package, every command of the registry is answered like the projector does.
+package emulator
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"sync"
+)
+
+const (
+	cmdError         = 0x00
+	cmdWriteKey      = 0x02
+	cmdWriteResponse = 0x03
+	cmdReadResponse  = 0x05
+	cmdWrite         = 0x06
+	cmdRead          = 0x07
+)
+
+// handler emulates a command code.
+type handler struct {
+	name  string
+	width int    // value bytes of a read response, 0 if the command can not be read
+	value []byte // initial read value
+	key   bool   // written by WriteKey instead of Write
+	// write handles a written value, false rejects it. It is nil if the command can not be written.
+	write func(e *Emulator, value int8) bool
+}
+
+// Emulator is an emulated projector. It is safe for concurrent use.
+type Emulator struct {
+	mutex    sync.Mutex
+	state    map[uint16][]byte
+	disabled map[uint16]bool
+	listener net.Listener
+	conns    map[net.Conn]struct{}
+
+	// Logf receives every handled request, nothing is logged if nil.
+	Logf func(format string, args ...any)
+}
+
+// New creates an emulator with the initial value of every command.
+func New() *Emulator {
+	e := &Emulator{
+		disabled: make(map[uint16]bool),
+		conns:    make(map[net.Conn]struct{}),
+	}
+	e.Reset()
+	return e
+}
+
+// Reset restores the initial value of every command and enables all commands.
+func (e *Emulator) Reset() {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+
+	e.state = make(map[uint16][]byte, len(handlers))
+	for code, h := range handlers {
+		if h.width > 0 {
+			e.state[code] = append([]byte(nil), h.value...)
+		}
+	}
+	clear(e.disabled)
+}
+
+// Value returns the read value of a command, nil if the command can not be read.
+func (e *Emulator) Value(code uint16) []byte {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+	return append([]byte(nil), e.state[code]...)
+}
+
+// SetValue sets the read value of a command, e.g. to emulate a temperature or a usage time.
+func (e *Emulator) SetValue(code uint16, value ...byte) {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+	e.state[code] = append([]byte(nil), value...)
+}
+
+// SetDisabled makes a command answer with the function disabled error, like commands that are
+// greyed out in the OSD.
+func (e *Emulator) SetDisabled(code uint16, disabled bool) {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+	if disabled {
+		e.disabled[code] = true
+	} else {
+		delete(e.disabled, code)
+	}
+}
+
+// Listen accepts connections on the TCP address, e.g. ":4661" or "127.0.0.1:0", until Close.
+func (e *Emulator) Listen(addr string) error {
+	l, err := net.Listen("tcp", addr)
+	if err != nil {
+		return err
+	}
+
+	e.mutex.Lock()
+	e.listener = l
+	e.mutex.Unlock()
+
+	go func() {
+		for {
+			conn, err := l.Accept()
+			if err != nil {
+				return
+			}
+			e.mutex.Lock()
+			e.conns[conn] = struct{}{}
+			e.mutex.Unlock()
+			go e.serve(conn)
+		}
+	}()
+	return nil
+}
+
+// Addr returns the address the emulator listens on, empty before Listen.
+func (e *Emulator) Addr() string {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+	if e.listener == nil {
+		return ""
+	}
+	return e.listener.Addr().String()
+}
+
+// Close stops listening and closes all connections.
+func (e *Emulator) Close() error {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+
+	var err error
+	if e.listener != nil {
+		err = e.listener.Close()
+	}
+	for conn := range e.conns {
+		conn.Close()
+	}
+	clear(e.conns)
+	return err
+}
+
+func (e *Emulator) serve(conn net.Conn) {
+	defer func() {
+		e.mutex.Lock()
+		delete(e.conns, conn)
+		e.mutex.Unlock()
+		conn.Close()
+	}()
+
+	for {
+		packet, err := readPacket(conn)
+		if err != nil {
+			return
+		}
+		if _, err := conn.Write(e.Handle(packet)); err != nil {
+			return
+		}
+	}
+}
+
+// readPacket reads a request packet including the checksum.
+func readPacket(r io.Reader) ([]byte, error) {
+	head := make([]byte, 5)
+	if _, err := io.ReadFull(r, head); err != nil {
+		return nil, err
+	}
+	packet := make([]byte, 5+int(binary.LittleEndian.Uint16(head[3:5]))+1)
+	copy(packet, head)
+	if _, err := io.ReadFull(r, packet[5:]); err != nil {
+		return nil, err
+	}
+	return packet, nil
+}
+
+// Handle answers a request packet with the response packet.
+func (e *Emulator) Handle(packet []byte) []byte {
+	response, err := e.handle(packet)
+	if err != nil {
+		e.logf("% X: %v", packet, err)
+		return encode(cmdError, nil)
+	}
+	e.logf("% X: % X", packet, response)
+	return response
+}
+
+func (e *Emulator) handle(packet []byte) ([]byte, error) {
+	if len(packet) < 6 || int(binary.LittleEndian.Uint16(packet[3:5])) != len(packet)-6 {
+		return nil, errors.New("invalid length")
+	}
+	if checkSum(packet[1:len(packet)-1]) != packet[len(packet)-1] {
+		return nil, errors.New("invalid checksum")
+	}
+	data := packet[5 : len(packet)-1]
+
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+
+	switch packet[0] {
+	case cmdWrite, cmdWriteKey:
+		if len(data) != 4 || data[0] != 0x34 {
+			return nil, errors.New("invalid write")
+		}
+		code := binary.BigEndian.Uint16(data[1:3])
+		h, ok := handlers[code]
+		if !ok || h.write == nil || h.key != (packet[0] == cmdWriteKey) {
+			return nil, fmt.Errorf("0x%04X can not be written", code)
+		}
+		if e.disabled[code] {
+			return nil, fmt.Errorf("%v disabled", h.name)
+		}
+		if !h.write(e, int8(data[3])) {
+			return nil, fmt.Errorf("%v: invalid value 0x%02X", h.name, data[3])
+		}
+		return encode(cmdWriteResponse, nil), nil
+
+	case cmdRead:
+		if len(data) != 5 || data[0] != 0x34 {
+			return nil, errors.New("invalid read")
+		}
+		code := binary.BigEndian.Uint16(data[3:5])
+		value, ok := e.state[code]
+		if !ok {
+			return nil, fmt.Errorf("0x%04X can not be read", code)
+		}
+		if e.disabled[code] {
+			return nil, fmt.Errorf("%v disabled", handlers[code].name)
+		}
+		return encode(cmdReadResponse, append([]byte{0x00, 0x00}, value...)), nil
+	}
+	return nil, errors.New("unknown packet type")
+}
+
+func (e *Emulator) logf(format string, args ...any) {
+	if e.Logf != nil {
+		e.Logf(format, args...)
+	}
+}
+
+func encode(cmd1 byte, data []byte) []byte {
+	packet := []byte{cmd1, 0x14, 0x00}
+	packet = binary.LittleEndian.AppendUint16(packet, uint16(len(data)))
+	packet = append(packet, data...)
+	return append(packet, checkSum(packet[1:]))
+}
+
+func checkSum(data []byte) byte {
+	cs := byte(0)
+	for _, b := range data {
+		cs += b
+	}
+	return cs
+}
+
+// Write handlers, called with the mutex locked
+
+type action func(e *Emulator, value int8)
+
+// do accepts the valid values, any value if valid is nil, and applies the actions.
+func do(valid func(int8) bool, actions ...action) func(*Emulator, int8) bool {
+	return func(e *Emulator, value int8) bool {
+		if valid != nil && !valid(value) {
+			return false
+		}
+		for _, a := range actions {
+			a(e, value)
+		}
+		return true
+	}
+}
+
+func values(valid ...int8) func(int8) bool {
+	return func(value int8) bool {
+		for _, v := range valid {
+			if v == value {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+func between(min, max int) func(int8) bool {
+	return func(value int8) bool {
+		return int(value) >= min && int(value) <= max
+	}
+}
+
+// set stores the written value.
+func set(code uint16) action {
+	return func(e *Emulator, value int8) {
+		e.store(code, int(value))
+	}
+}
+
+// assign stores a fixed value.
+func assign(code uint16, v int) action {
+	return func(e *Emulator, _ int8) {
+		e.store(code, v)
+	}
+}
+
+// add adds delta to the value, limited to min and max.
+func add(code uint16, delta, min, max int) action {
+	return func(e *Emulator, _ int8) {
+		e.store(code, clamp(e.load(code)+delta, min, max))
+	}
+}
+
+// step decreases the value if decrease is written and increases it otherwise.
+func step(code uint16, decrease int8, min, max int) action {
+	return func(e *Emulator, value int8) {
+		delta := 1
+		if value == decrease {
+			delta = -1
+		}
+		e.store(code, clamp(e.load(code)+delta, min, max))
+	}
+}
+
+func clamp(v, min, max int) int {
+	if v < min {
+		return min
+	}
+	if v > max {
+		return max
+	}
+	return v
+}
+
+// load returns the little endian signed value.
+func (e *Emulator) load(code uint16) int {
+	data := e.state[code]
+	v := 0
+	for i := len(data) - 1; i >= 0; i-- {
+		v = v<<8 | int(data[i])
+	}
+	if n := len(data); n > 0 && n < 8 && data[n-1]&0x80 != 0 {
+		v -= 1 << (8 * n)
+	}
+	return v
+}
+
+// store sets the value as little endian bytes of the read width.
+func (e *Emulator) store(code uint16, v int) {
+	data := make([]byte, len(e.state[code]))
+	for i := range data {
+		data[i] = byte(v >> (8 * i))
+	}
+	e.state[code] = data
+}
@@ -0,0 +1,337 @@
+// Code generated by mkcommands from Commands.csv. DO NOT EDIT.
+
+package emulator
+
+var handlers = map[uint16]handler{
+	0x1100: {
+		name:  "Power",
+		width: 1,
+		value: []byte{0x01},
+		write: do(values(0x00), assign(0x1100, 1), assign(0x1126, 2)),
+	},
+	0x1101: {
+		name:  "PowerOff",
+		write: do(values(0x00), assign(0x1100, 0), assign(0x1126, 0)),
+	},
+	0x1134: {
+		name:  "TogglePower",
+		write: do(values(0x00)),
+	},
+	0x1126: {
+		name:  "ProjectorStatus",
+		width: 1,
+		value: []byte{0x02},
+	},
+	0x1102: {
+		name:  "ResetAllSettings",
+		write: do(values(0x00)),
+	},
+	0x112A: {
+		name:  "ResetCurrentColorSettings",
+		write: do(values(0x00)),
+	},
+	0x110B: {
+		name:  "QuickPowerOff",
+		width: 1,
+		value: []byte{0x00},
+		write: do(values(0x00, 0x01), set(0x110B)),
+	},
+	0x0C0D: {
+		name:  "ErrorStatus",
+		width: 24,
+		value: []byte{0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00},
+	},
+	0x1503: {
+		name:  "OperatingTemperature",
+		width: 8,
+		value: []byte{0x29, 0x01, 0x00, 0x00, 0x2C, 0x01, 0x00, 0x00},
+	},
+	0x110A: {
+		name:  "SplashScreen",
+		width: 1,
+		value: []byte{0x02},
+		write: do(values(0x00, 0x01, 0x02, 0x03, 0x04), set(0x110A)),
+	},
+	0x1200: {
+		name:  "ProjectorPosition",
+		width: 1,
+		value: []byte{0x00},
+		write: do(values(0x00, 0x01, 0x02, 0x03), set(0x1200)),
+	},
+	0x1202: {
+		name:  "Contrast",
+		width: 2,
+		value: []byte{0x32, 0x00},
+		write: do(values(0x00, 0x01), step(0x1202, 0x00, 0, 100)),
+	},
+	0x1203: {
+		name:  "Brightness",
+		width: 2,
+		value: []byte{0x32, 0x00},
+		write: do(values(0x00, 0x01), step(0x1203, 0x00, 0, 100)),
+	},
+	0x1204: {
+		name:  "AspectRatio",
+		width: 1,
+		value: []byte{0x00},
+		write: do(values(0x00, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09), set(0x1204)),
+	},
+	0x1331: {
+		name:  "CycleAspectRatio",
+		write: do(values(0x00)),
+	},
+	0x1205: {
+		name:  "AutoAdjust",
+		write: do(values(0x00)),
+	},
+	0x1209: {
+		name:  "Blank",
+		width: 1,
+		value: []byte{0x00},
+		write: do(values(0x00, 0x01), set(0x1209)),
+	},
+	0x1300: {
+		name:  "Freeze",
+		width: 1,
+		value: []byte{0x00},
+		write: do(values(0x00, 0x01), set(0x1300)),
+	},
+	0x1133: {
+		name:  "OverScan",
+		width: 1,
+		value: []byte{0x00},
+		write: do(between(0, 5), set(0x1133)),
+	},
+	0x1220: {
+		name:  "ThreeDSyncMode",
+		width: 1,
+		value: []byte{0x00},
+		write: do(values(0x00, 0x01, 0x02, 0x03, 0x04, 0x05), set(0x1220)),
+	},
+	0x1221: {
+		name:  "ThreeDSyncInvert",
+		width: 1,
+		value: []byte{0x00},
+		write: do(values(0x00, 0x01), set(0x1221)),
+	},
+	0x1239: {
+		name:  "HdrMode",
+		width: 1,
+		value: []byte{0x00},
+		write: do(values(0x00, 0x01), set(0x1239)),
+	},
+	0x112C: {
+		name:  "Eotf",
+		width: 1,
+		value: []byte{0x01},
+		write: do(values(0x00, 0x01, 0x02), set(0x112C)),
+	},
+	0x1139: {
+		name:  "DigitalLensShiftVertical",
+		width: 1,
+		value: []byte{0x00},
+		write: do(values(0x00, 0x01), step(0x1139, 0x00, -20, 20)),
+	},
+	0x113A: {
+		name:  "DigitalLensShiftHorizontal",
+		width: 1,
+		value: []byte{0x00},
+		write: do(values(0x00, 0x01), step(0x113A, 0x00, -20, 20)),
+	},
+	0x1301: {
+		name:  "SourceInput",
+		width: 1,
+		value: []byte{0x03},
+		write: do(values(0x00, 0x08, 0x03, 0x07, 0x09, 0x0E, 0x05, 0x06, 0x0A, 0x0B, 0x0C, 0x0F, 0x1A, 0x1B, 0x1C), set(0x1301)),
+	},
+	0x1302: {
+		name:  "QuickAutoSearch",
+		width: 1,
+		value: []byte{0x00},
+		write: do(values(0x00, 0x01), set(0x1302)),
+	},
+	0x1128: {
+		name:  "HdmiFormat",
+		width: 1,
+		value: []byte{0x02},
+		write: do(values(0x00, 0x01, 0x02), set(0x1128)),
+	},
+	0x1129: {
+		name:  "HdmiRange",
+		width: 1,
+		value: []byte{0x02},
+		write: do(values(0x00, 0x01, 0x02), set(0x1129)),
+	},
+	0x112B: {
+		name:  "CEC",
+		width: 1,
+		value: []byte{0x00},
+		write: do(values(0x00, 0x01), set(0x112B)),
+	},
+	0x1206: {
+		name:  "HorizontalPosition",
+		width: 1,
+		value: []byte{0x00},
+		write: do(values(0x00, 0x01), step(0x1206, 0x00, -10, 10)),
+	},
+	0x1207: {
+		name:  "VerticalPosition",
+		width: 1,
+		value: []byte{0x00},
+		write: do(values(0x00, 0x01), step(0x1207, 0x00, -10, 10)),
+	},
+	0x120A: {
+		name:  "KeystoneVertical",
+		width: 1,
+		value: []byte{0x00},
+		write: do(values(0x00, 0x01), step(0x120A, 0x00, -40, 40)),
+	},
+	0x1131: {
+		name:  "KeystoneHorizontal",
+		width: 1,
+		value: []byte{0x00},
+		write: do(values(0x00, 0x01), step(0x1131, 0x00, -40, 40)),
+	},
+	0x1208: {
+		name:  "ColorTemperature",
+		width: 1,
+		value: []byte{0x01},
+		write: do(values(0x00, 0x01, 0x02, 0x03), set(0x1208)),
+	},
+	0x120B: {
+		name:  "ColorMode",
+		width: 1,
+		value: []byte{0x04},
+		write: do(values(0x00, 0x01, 0x04, 0x05, 0x08, 0x09, 0x0A, 0x11, 0x12, 0x13, 0x14, 0x15, 0x16, 0x17), set(0x120B)),
+	},
+	0x1333: {
+		name:  "CycleColorMode",
+		write: do(values(0x00)),
+	},
+	0x1210: {
+		name:  "PrimaryColor",
+		width: 2,
+		value: []byte{0x00, 0x00},
+		write: do(values(0x00, 0x01, 0x02, 0x03, 0x04, 0x05), set(0x1210)),
+	},
+	0x1211: {
+		name:  "Hue",
+		width: 2,
+		value: []byte{0x00, 0x00},
+		write: do(values(0x00, 0x01), step(0x1211, 0x00, -99, 99)),
+	},
+	0x1212: {
+		name:  "Saturation",
+		width: 2,
+		value: []byte{0x00, 0x00},
+		write: do(values(0x00, 0x01), step(0x1212, 0x00, -99, 99)),
+	},
+	0x120E: {
+		name:  "Sharpness",
+		width: 2,
+		value: []byte{0x0F, 0x00},
+		write: do(values(0x00, 0x01), step(0x120E, 0x00, 0, 31)),
+	},
+	0x1213: {
+		name:  "Gain",
+		width: 2,
+		value: []byte{0x00, 0x00},
+		write: do(values(0x00, 0x01), step(0x1213, 0x00, -99, 99)),
+	},
+	0x120F: {
+		name:  "BrilliantColor",
+		width: 1,
+		value: []byte{0x0A},
+		write: do(between(0, 10), set(0x120F)),
+	},
+	0x1132: {
+		name:  "ScreenColor",
+		width: 1,
+		value: []byte{0x00},
+		write: do(values(0x00, 0x01, 0x02, 0x03, 0x04), set(0x1132)),
+	},
+	0x1238: {
+		name:  "IsfMode",
+		width: 1,
+		value: []byte{0x00},
+		write: do(values(0x00, 0x01), set(0x1238)),
+	},
+	0x05CA: {
+		name:  "Gamma",
+		width: 1,
+		value: []byte{0x02},
+		write: do(values(0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06), set(0x05CA)),
+	},
+	0x1400: {
+		name:  "Mute",
+		width: 1,
+		value: []byte{0x00},
+		write: do(values(0x00, 0x01), set(0x1400)),
+	},
+	0x1401: {
+		name:  "VolumeUp",
+		write: do(values(0x00), add(0x1403, 1, 0, 20)),
+	},
+	0x1402: {
+		name:  "VolumeDown",
+		write: do(values(0x00), add(0x1403, -1, 0, 20)),
+	},
+	0x132A: {
+		name:  "SetVolume",
+		write: do(between(0, 20), set(0x1403)),
+	},
+	0x1403: {
+		name:  "GetVolume",
+		width: 1,
+		value: []byte{0x0A},
+	},
+	0x1335: {
+		name:  "CycleAudioMode",
+		write: do(values(0x00)),
+	},
+	0x110C: {
+		name:  "HighAltitudeMode",
+		width: 1,
+		value: []byte{0x00},
+		write: do(values(0x00, 0x01), set(0x110C)),
+	},
+	0x1127: {
+		name:  "MessageDisplay",
+		width: 1,
+		value: []byte{0x01},
+		write: do(values(0x00, 0x01), set(0x1127)),
+	},
+	0x1500: {
+		name:  "Language",
+		width: 1,
+		value: []byte{0x00},
+		write: do(values(0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0A, 0x0B, 0x0C, 0x0D, 0x0E, 0x0F, 0x10, 0x11, 0x12, 0x13, 0x14, 0x15), set(0x1500)),
+	},
+	0x0C48: {
+		name:  "RemoteControlCode",
+		width: 1,
+		value: []byte{0x00},
+		write: do(between(0, 7), set(0x0C48)),
+	},
+	0x0204: {
+		name:  "RemoteKey",
+		key:   true,
+		write: do(values(0x0F, 0x13, 0x0B, 0x0C, 0x0D, 0x0E, 0x04, 0x15, 0x08, 0x11)),
+	},
+	0x1501: {
+		name:  "LightSourceUsageTime",
+		width: 4,
+		value: []byte{0xB8, 0x0B, 0x00, 0x00},
+		write: do(values(0x00), assign(0x1501, 0)),
+	},
+	0x1110: {
+		name:  "LightSourceMode",
+		width: 1,
+		value: []byte{0x00},
+		write: do(values(0x00, 0x01, 0x02, 0x03), set(0x1110)),
+	},
+	0x1336: {
+		name:  "CycleLampMode",
+		write: do(values(0x00)),
+	},
+}
@@ -2,4 +2,16 @@ module github.com/m-baertschi/viewsonic
 
 go 1.23.3
 
-require github.com/jpillora/backoff v1.0.0
+require (
+	github.com/gorilla/websocket v1.5.3
+	github.com/jpillora/backoff v1.0.0
+	google.golang.org/grpc v1.75.0
+	google.golang.org/protobuf v1.36.12
+)
+
+require (
+	golang.org/x/net v0.41.0 // indirect
+	golang.org/x/sys v0.33.0 // indirect
+	golang.org/x/text v0.26.0 // indirect
+	google.golang.org/genproto/googleapis/rpc v0.0.0-20250707201910-8d1bb00bc6a7 // indirect
+)
@@ -0,0 +1,41 @@
+package grpcapi
+
+import (
+	"context"
+
+	"github.com/m-baertschi/viewsonic"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+type audioServer struct {
+	UnimplementedAudioServer
+	*Server
+}
+
+func (s *audioServer) SetMute(ctx context.Context, req *SetBoolRequest) (*emptypb.Empty, error) {
+	return s.setBool(req, (*viewsonic.ViewSonic).SetMute)
+}
+
+func (s *audioServer) GetMute(ctx context.Context, req *ProjectorRequest) (*BoolResponse, error) {
+	return getBool(s.Server, req.Projector, (*viewsonic.ViewSonic).GetMute)
+}
+
+func (s *audioServer) IncreaseVolume(ctx context.Context, req *ProjectorRequest) (*emptypb.Empty, error) {
+	return s.do(req.Projector, (*viewsonic.ViewSonic).IncreaseVolume)
+}
+
+func (s *audioServer) DecreaseVolume(ctx context.Context, req *ProjectorRequest) (*emptypb.Empty, error) {
+	return s.do(req.Projector, (*viewsonic.ViewSonic).DecreaseVolume)
+}
+
+func (s *audioServer) SetVolume(ctx context.Context, req *SetInt32Request) (*emptypb.Empty, error) {
+	return s.setInt8(req, (*viewsonic.ViewSonic).SetVolume)
+}
+
+func (s *audioServer) GetVolume(ctx context.Context, req *ProjectorRequest) (*Int32Response, error) {
+	return getInt32(s.Server, req.Projector, (*viewsonic.ViewSonic).GetVolume)
+}
+
+func (s *audioServer) CycleAudioMode(ctx context.Context, req *ProjectorRequest) (*emptypb.Empty, error) {
+	return s.do(req.Projector, (*viewsonic.ViewSonic).CycleAudioMode)
+}
@@ -0,0 +1,27 @@
+package grpcapi
+
+import "google.golang.org/grpc"
+
+// Client bundles the generated clients of all services.
+type Client struct {
+	Power         PowerClient
+	Status        StatusClient
+	Source        SourceClient
+	Image         ImageClient
+	Color         ColorClient
+	Audio         AudioClient
+	Miscellaneous MiscellaneousClient
+}
+
+// NewClient creates the clients on a connection, e.g. from grpc.NewClient.
+func NewClient(cc grpc.ClientConnInterface) *Client {
+	return &Client{
+		Power:         NewPowerClient(cc),
+		Status:        NewStatusClient(cc),
+		Source:        NewSourceClient(cc),
+		Image:         NewImageClient(cc),
+		Color:         NewColorClient(cc),
+		Audio:         NewAudioClient(cc),
+		Miscellaneous: NewMiscellaneousClient(cc),
+	}
+}
@@ -0,0 +1,125 @@
+package grpcapi
+
+import (
+	"context"
+
+	"github.com/m-baertschi/viewsonic"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+type colorServer struct {
+	UnimplementedColorServer
+	*Server
+}
+
+func (s *colorServer) SetColorTemperature(ctx context.Context, req *SetColorTemperatureRequest) (*emptypb.Empty, error) {
+	return setEnum(s.Server, req.Projector, req.Temperature, viewsonic.ColorTemperatureValues(), (*viewsonic.ViewSonic).SetColorTemperature)
+}
+
+func (s *colorServer) GetColorTemperature(ctx context.Context, req *ProjectorRequest) (*ColorTemperatureResponse, error) {
+	temp, err := get(s.Server, req.Projector, (*viewsonic.ViewSonic).GetColorTemperature)
+	if err != nil {
+		return nil, err
+	}
+	return &ColorTemperatureResponse{Temperature: ColorTemperature(temp)}, nil
+}
+
+func (s *colorServer) SetColorMode(ctx context.Context, req *SetColorModeRequest) (*emptypb.Empty, error) {
+	return setEnum(s.Server, req.Projector, req.Mode, viewsonic.ColorModeValues(), (*viewsonic.ViewSonic).SetColorMode)
+}
+
+func (s *colorServer) GetColorMode(ctx context.Context, req *ProjectorRequest) (*ColorModeResponse, error) {
+	mode, err := get(s.Server, req.Projector, (*viewsonic.ViewSonic).GetColorMode)
+	if err != nil {
+		return nil, err
+	}
+	return &ColorModeResponse{Mode: ColorMode(mode)}, nil
+}
+
+func (s *colorServer) CycleColorMode(ctx context.Context, req *ProjectorRequest) (*emptypb.Empty, error) {
+	return s.do(req.Projector, (*viewsonic.ViewSonic).CycleColorMode)
+}
+
+func (s *colorServer) SelectPrimaryColor(ctx context.Context, req *SelectPrimaryColorRequest) (*emptypb.Empty, error) {
+	return setEnum(s.Server, req.Projector, req.Color, viewsonic.PrimaryColorValues(), (*viewsonic.ViewSonic).SelectPrimaryColor)
+}
+
+func (s *colorServer) GetSelectedPrimaryColor(ctx context.Context, req *ProjectorRequest) (*PrimaryColorResponse, error) {
+	color, err := get(s.Server, req.Projector, (*viewsonic.ViewSonic).GetSelectedPrimaryColor)
+	if err != nil {
+		return nil, err
+	}
+	return &PrimaryColorResponse{Color: PrimaryColor(color)}, nil
+}
+
+func (s *colorServer) IncreaseHue(ctx context.Context, req *ProjectorRequest) (*emptypb.Empty, error) {
+	return s.do(req.Projector, (*viewsonic.ViewSonic).IncreaseHue)
+}
+
+func (s *colorServer) DecreaseHue(ctx context.Context, req *ProjectorRequest) (*emptypb.Empty, error) {
+	return s.do(req.Projector, (*viewsonic.ViewSonic).DecreaseHue)
+}
+
+func (s *colorServer) GetHue(ctx context.Context, req *ProjectorRequest) (*Int32Response, error) {
+	return getInt32(s.Server, req.Projector, (*viewsonic.ViewSonic).GetHue)
+}
+
+func (s *colorServer) IncreaseSaturation(ctx context.Context, req *ProjectorRequest) (*emptypb.Empty, error) {
+	return s.do(req.Projector, (*viewsonic.ViewSonic).IncreaseSaturation)
+}
+
+func (s *colorServer) DecreaseSaturation(ctx context.Context, req *ProjectorRequest) (*emptypb.Empty, error) {
+	return s.do(req.Projector, (*viewsonic.ViewSonic).DecreaseSaturation)
+}
+
+func (s *colorServer) GetSaturation(ctx context.Context, req *ProjectorRequest) (*Int32Response, error) {
+	return getInt32(s.Server, req.Projector, (*viewsonic.ViewSonic).GetSaturation)
+}
+
+func (s *colorServer) IncreaseSharpness(ctx context.Context, req *ProjectorRequest) (*emptypb.Empty, error) {
+	return s.do(req.Projector, (*viewsonic.ViewSonic).IncreaseSharpness)
+}
+
+func (s *colorServer) DecreaseSharpness(ctx context.Context, req *ProjectorRequest) (*emptypb.Empty, error) {
+	return s.do(req.Projector, (*viewsonic.ViewSonic).DecreaseSharpness)
+}
+
+func (s *colorServer) GetSharpness(ctx context.Context, req *ProjectorRequest) (*Int32Response, error) {
+	return getInt32(s.Server, req.Projector, (*viewsonic.ViewSonic).GetSharpness)
+}
+
+func (s *colorServer) IncreaseGain(ctx context.Context, req *ProjectorRequest) (*emptypb.Empty, error) {
+	return s.do(req.Projector, (*viewsonic.ViewSonic).IncreaseGain)
+}
+
+func (s *colorServer) DecreaseGain(ctx context.Context, req *ProjectorRequest) (*emptypb.Empty, error) {
+	return s.do(req.Projector, (*viewsonic.ViewSonic).DecreaseGain)
+}
+
+func (s *colorServer) GetGain(ctx context.Context, req *ProjectorRequest) (*Int32Response, error) {
+	return getInt32(s.Server, req.Projector, (*viewsonic.ViewSonic).GetGain)
+}
+
+func (s *colorServer) SetBrilliantColor(ctx context.Context, req *SetInt32Request) (*emptypb.Empty, error) {
+	return s.setInt8(req, (*viewsonic.ViewSonic).SetBrilliantColor)
+}
+
+func (s *colorServer) GetBrilliantColor(ctx context.Context, req *ProjectorRequest) (*Int32Response, error) {
+	return getInt32(s.Server, req.Projector, (*viewsonic.ViewSonic).GetBrilliantColor)
+}
+
+func (s *colorServer) SetScreenColor(ctx context.Context, req *SetScreenColorRequest) (*emptypb.Empty, error) {
+	return setEnum(s.Server, req.Projector, req.Color, viewsonic.ScreenColorValues(), (*viewsonic.ViewSonic).SetScreenColor)
+}
+
+func (s *colorServer) GetScreenColor(ctx context.Context, req *ProjectorRequest) (*ScreenColorResponse, error) {
+	color, err := get(s.Server, req.Projector, (*viewsonic.ViewSonic).GetScreenColor)
+	if err != nil {
+		return nil, err
+	}
+	return &ScreenColorResponse{Color: ScreenColor(color)}, nil
+}
+
+func (s *colorServer) ResetCurrentColorSettings(ctx context.Context, req *ProjectorRequest) (*emptypb.Empty, error) {
+	return s.do(req.Projector, (*viewsonic.ViewSonic).ResetCurrentColorSettings)
+}
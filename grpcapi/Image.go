@@ -0,0 +1,125 @@
+package grpcapi
+
+import (
+	"context"
+
+	"github.com/m-baertschi/viewsonic"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+type imageServer struct {
+	UnimplementedImageServer
+	*Server
+}
+
+func (s *imageServer) SetSplashScreen(ctx context.Context, req *SetSplashScreenRequest) (*emptypb.Empty, error) {
+	return setEnum(s.Server, req.Projector, req.Screen, viewsonic.SplashScreenValues(), (*viewsonic.ViewSonic).SetSplashScreen)
+}
+
+func (s *imageServer) GetSplashScreen(ctx context.Context, req *ProjectorRequest) (*SplashScreenResponse, error) {
+	screen, err := get(s.Server, req.Projector, (*viewsonic.ViewSonic).GetSplashScreen)
+	if err != nil {
+		return nil, err
+	}
+	return &SplashScreenResponse{Screen: SplashScreen(screen)}, nil
+}
+
+func (s *imageServer) SetProjectorPosition(ctx context.Context, req *SetProjectorPositionRequest) (*emptypb.Empty, error) {
+	return setEnum(s.Server, req.Projector, req.Position, viewsonic.ProjectorPositionValues(), (*viewsonic.ViewSonic).SetProjectorPosition)
+}
+
+func (s *imageServer) GetProjectorPosition(ctx context.Context, req *ProjectorRequest) (*ProjectorPositionResponse, error) {
+	position, err := get(s.Server, req.Projector, (*viewsonic.ViewSonic).GetProjectorPosition)
+	if err != nil {
+		return nil, err
+	}
+	return &ProjectorPositionResponse{Position: ProjectorPosition(position)}, nil
+}
+
+func (s *imageServer) IncreaseContrast(ctx context.Context, req *ProjectorRequest) (*emptypb.Empty, error) {
+	return s.do(req.Projector, (*viewsonic.ViewSonic).IncreaseContrast)
+}
+
+func (s *imageServer) DecreaseContrast(ctx context.Context, req *ProjectorRequest) (*emptypb.Empty, error) {
+	return s.do(req.Projector, (*viewsonic.ViewSonic).DecreaseContrast)
+}
+
+func (s *imageServer) GetContrast(ctx context.Context, req *ProjectorRequest) (*Int32Response, error) {
+	return getInt32(s.Server, req.Projector, (*viewsonic.ViewSonic).GetContrast)
+}
+
+func (s *imageServer) IncreaseBrightness(ctx context.Context, req *ProjectorRequest) (*emptypb.Empty, error) {
+	return s.do(req.Projector, (*viewsonic.ViewSonic).IncreaseBrightness)
+}
+
+func (s *imageServer) DecreaseBrightness(ctx context.Context, req *ProjectorRequest) (*emptypb.Empty, error) {
+	return s.do(req.Projector, (*viewsonic.ViewSonic).DecreaseBrightness)
+}
+
+func (s *imageServer) GetBrightness(ctx context.Context, req *ProjectorRequest) (*Int32Response, error) {
+	return getInt32(s.Server, req.Projector, (*viewsonic.ViewSonic).GetBrightness)
+}
+
+func (s *imageServer) SetAspectRatio(ctx context.Context, req *SetAspectRatioRequest) (*emptypb.Empty, error) {
+	return setEnum(s.Server, req.Projector, req.Ratio, viewsonic.AspectRatioValues(), (*viewsonic.ViewSonic).SetAspectRatio)
+}
+
+func (s *imageServer) GetAspectRatio(ctx context.Context, req *ProjectorRequest) (*AspectRatioResponse, error) {
+	ratio, err := get(s.Server, req.Projector, (*viewsonic.ViewSonic).GetAspectRatio)
+	if err != nil {
+		return nil, err
+	}
+	return &AspectRatioResponse{Ratio: AspectRatio(ratio)}, nil
+}
+
+func (s *imageServer) CycleAspectRatio(ctx context.Context, req *ProjectorRequest) (*emptypb.Empty, error) {
+	return s.do(req.Projector, (*viewsonic.ViewSonic).CycleAspectRatio)
+}
+
+func (s *imageServer) AutoAdjust(ctx context.Context, req *ProjectorRequest) (*emptypb.Empty, error) {
+	return s.do(req.Projector, (*viewsonic.ViewSonic).AutoAdjust)
+}
+
+func (s *imageServer) SetBlank(ctx context.Context, req *SetBoolRequest) (*emptypb.Empty, error) {
+	return s.setBool(req, (*viewsonic.ViewSonic).SetBlank)
+}
+
+func (s *imageServer) GetBlank(ctx context.Context, req *ProjectorRequest) (*BoolResponse, error) {
+	return getBool(s.Server, req.Projector, (*viewsonic.ViewSonic).GetBlank)
+}
+
+func (s *imageServer) SetFreeze(ctx context.Context, req *SetBoolRequest) (*emptypb.Empty, error) {
+	return s.setBool(req, (*viewsonic.ViewSonic).SetFreeze)
+}
+
+func (s *imageServer) GetFreeze(ctx context.Context, req *ProjectorRequest) (*BoolResponse, error) {
+	return getBool(s.Server, req.Projector, (*viewsonic.ViewSonic).GetFreeze)
+}
+
+func (s *imageServer) SetOverScan(ctx context.Context, req *SetInt32Request) (*emptypb.Empty, error) {
+	return s.setInt8(req, (*viewsonic.ViewSonic).SetOverScan)
+}
+
+func (s *imageServer) GetOverScan(ctx context.Context, req *ProjectorRequest) (*Int32Response, error) {
+	return getInt32(s.Server, req.Projector, (*viewsonic.ViewSonic).GetOverScan)
+}
+
+func (s *imageServer) SetThreeDSyncMode(ctx context.Context, req *SetThreeDSyncModeRequest) (*emptypb.Empty, error) {
+	return setEnum(s.Server, req.Projector, req.Mode, viewsonic.ThreeDSyncModeValues(), (*viewsonic.ViewSonic).SetThreeDSyncMode)
+}
+
+func (s *imageServer) GetThreeDSyncMode(ctx context.Context, req *ProjectorRequest) (*ThreeDSyncModeResponse, error) {
+	mode, err := get(s.Server, req.Projector, (*viewsonic.ViewSonic).GetThreeDSyncMode)
+	if err != nil {
+		return nil, err
+	}
+	return &ThreeDSyncModeResponse{Mode: ThreeDSyncMode(mode)}, nil
+}
+
+func (s *imageServer) SetThreeDSyncInvert(ctx context.Context, req *SetBoolRequest) (*emptypb.Empty, error) {
+	return s.setBool(req, (*viewsonic.ViewSonic).SetThreeDSyncInvert)
+}
+
+func (s *imageServer) GetThreeDSyncInvert(ctx context.Context, req *ProjectorRequest) (*BoolResponse, error) {
+	return getBool(s.Server, req.Projector, (*viewsonic.ViewSonic).GetThreeDSyncInvert)
+}
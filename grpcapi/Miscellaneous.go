@@ -0,0 +1,82 @@
+package grpcapi
+
+import (
+	"context"
+
+	"github.com/m-baertschi/viewsonic"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+type miscellaneousServer struct {
+	UnimplementedMiscellaneousServer
+	*Server
+}
+
+func (s *miscellaneousServer) SetHighAltitudeMode(ctx context.Context, req *SetBoolRequest) (*emptypb.Empty, error) {
+	return s.setBool(req, (*viewsonic.ViewSonic).SetHighAltitudeMode)
+}
+
+func (s *miscellaneousServer) GetHighAltitudeMode(ctx context.Context, req *ProjectorRequest) (*BoolResponse, error) {
+	return getBool(s.Server, req.Projector, (*viewsonic.ViewSonic).GetHighAltitudeMode)
+}
+
+func (s *miscellaneousServer) SetMessageDisplay(ctx context.Context, req *SetBoolRequest) (*emptypb.Empty, error) {
+	return s.setBool(req, (*viewsonic.ViewSonic).SetMessageDisplay)
+}
+
+func (s *miscellaneousServer) GetMessageDisplay(ctx context.Context, req *ProjectorRequest) (*BoolResponse, error) {
+	return getBool(s.Server, req.Projector, (*viewsonic.ViewSonic).GetMessageDisplay)
+}
+
+func (s *miscellaneousServer) SetLanguage(ctx context.Context, req *SetLanguageRequest) (*emptypb.Empty, error) {
+	return setEnum(s.Server, req.Projector, req.Language, viewsonic.LanguageValues(), (*viewsonic.ViewSonic).SetLanguage)
+}
+
+func (s *miscellaneousServer) GetLanguage(ctx context.Context, req *ProjectorRequest) (*LanguageResponse, error) {
+	lang, err := get(s.Server, req.Projector, (*viewsonic.ViewSonic).GetLanguage)
+	if err != nil {
+		return nil, err
+	}
+	return &LanguageResponse{Language: Language(lang)}, nil
+}
+
+func (s *miscellaneousServer) SetRemoteControlCode(ctx context.Context, req *SetInt32Request) (*emptypb.Empty, error) {
+	return s.setInt8(req, (*viewsonic.ViewSonic).SetRemoteControlCode)
+}
+
+func (s *miscellaneousServer) GetRemoteControlCode(ctx context.Context, req *ProjectorRequest) (*Int32Response, error) {
+	return getInt32(s.Server, req.Projector, (*viewsonic.ViewSonic).GetRemoteControlCode)
+}
+
+func (s *miscellaneousServer) SendRemoteKey(ctx context.Context, req *SendRemoteKeyRequest) (*emptypb.Empty, error) {
+	if req.Key == RemoteKey_REMOTE_KEY_UNSPECIFIED {
+		return nil, status.Error(codes.InvalidArgument, "remote key not specified")
+	}
+	return setEnum(s.Server, req.Projector, req.Key, viewsonic.RemoteKeyValues(), (*viewsonic.ViewSonic).SendRemoteKey)
+}
+
+func (s *miscellaneousServer) ResetLightSourceUsageTime(ctx context.Context, req *ProjectorRequest) (*emptypb.Empty, error) {
+	return s.do(req.Projector, (*viewsonic.ViewSonic).ResetLightSourceUsageTime)
+}
+
+func (s *miscellaneousServer) SetLightSourceMode(ctx context.Context, req *SetLightSourceModeRequest) (*emptypb.Empty, error) {
+	return setEnum(s.Server, req.Projector, req.Mode, viewsonic.LightSourceModeValues(), (*viewsonic.ViewSonic).SetLightSourceMode)
+}
+
+func (s *miscellaneousServer) GetLightSourceMode(ctx context.Context, req *ProjectorRequest) (*LightSourceModeResponse, error) {
+	mode, err := get(s.Server, req.Projector, (*viewsonic.ViewSonic).GetLightSourceMode)
+	if err != nil {
+		return nil, err
+	}
+	return &LightSourceModeResponse{Mode: LightSourceMode(mode)}, nil
+}
+
+func (s *miscellaneousServer) CycleLampMode(ctx context.Context, req *ProjectorRequest) (*emptypb.Empty, error) {
+	return s.do(req.Projector, (*viewsonic.ViewSonic).CycleLampMode)
+}
+
+func (s *miscellaneousServer) ResetAllSettings(ctx context.Context, req *ProjectorRequest) (*emptypb.Empty, error) {
+	return s.do(req.Projector, (*viewsonic.ViewSonic).ResetAllSettings)
+}
@@ -0,0 +1,33 @@
+package grpcapi
+
+import (
+	"context"
+
+	"github.com/m-baertschi/viewsonic"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+type powerServer struct {
+	UnimplementedPowerServer
+	*Server
+}
+
+func (s *powerServer) SetPower(ctx context.Context, req *SetPowerRequest) (*emptypb.Empty, error) {
+	return setEnum(s.Server, req.Projector, req.State, viewsonic.PowerStateValues(), (*viewsonic.ViewSonic).SetPower)
+}
+
+func (s *powerServer) GetPower(ctx context.Context, req *ProjectorRequest) (*PowerResponse, error) {
+	state, err := get(s.Server, req.Projector, (*viewsonic.ViewSonic).GetPower)
+	if err != nil {
+		return nil, err
+	}
+	return &PowerResponse{State: PowerState(state)}, nil
+}
+
+func (s *powerServer) SetQuickPowerOff(ctx context.Context, req *SetBoolRequest) (*emptypb.Empty, error) {
+	return s.setBool(req, (*viewsonic.ViewSonic).SetQuickPowerOff)
+}
+
+func (s *powerServer) GetQuickPowerOff(ctx context.Context, req *ProjectorRequest) (*BoolResponse, error) {
+	return getBool(s.Server, req.Projector, (*viewsonic.ViewSonic).GetQuickPowerOff)
+}
@@ -0,0 +1,164 @@
+// Package grpcapi is a gRPC API mirroring the viewsonic library, see viewsonic.proto.
+// Server serves the API backed by ViewSonic connections, Client bundles the generated clients.
+package grpcapi
+
+import (
+	"context"
+	"errors"
+	"math"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/m-baertschi/viewsonic"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+//go:generate protoc --go_out=. --go_opt=paths=source_relative --go-grpc_out=. --go-grpc_opt=paths=source_relative viewsonic.proto
+
+type target struct {
+	conn   *viewsonic.ViewSonic
+	poller *viewsonic.StatePoller
+}
+
+// Server implements all services of the API.
+type Server struct {
+	// Interval between two polls of each projector, used by the Watch RPC.
+	Interval time.Duration
+
+	targets map[string]*target
+}
+
+// NewServer creates a server for the projectors, keyed by the name used in the requests.
+func NewServer(projectors map[string]*viewsonic.ViewSonic) *Server {
+	s := &Server{
+		Interval: 2 * time.Second,
+		targets:  map[string]*target{},
+	}
+	for name, conn := range projectors {
+		s.targets[name] = &target{conn: conn, poller: viewsonic.NewStatePoller(conn, name)}
+	}
+	return s
+}
+
+// Register registers all services on r, e.g. a *grpc.Server.
+func (s *Server) Register(r grpc.ServiceRegistrar) {
+	RegisterPowerServer(r, &powerServer{Server: s})
+	RegisterStatusServer(r, &statusServer{Server: s})
+	RegisterSourceServer(r, &sourceServer{Server: s})
+	RegisterImageServer(r, &imageServer{Server: s})
+	RegisterColorServer(r, &colorServer{Server: s})
+	RegisterAudioServer(r, &audioServer{Server: s})
+	RegisterMiscellaneousServer(r, &miscellaneousServer{Server: s})
+}
+
+// Run polls all projectors for the Watch RPC until the context is cancelled.
+func (s *Server) Run(ctx context.Context) {
+	var wg sync.WaitGroup
+	for _, t := range s.targets {
+		wg.Add(1)
+		go func(t *target) {
+			defer wg.Done()
+			t.poller.Run(ctx, s.Interval)
+		}(t)
+	}
+	wg.Wait()
+}
+
+func (s *Server) lookup(projector string) (*target, error) {
+	t, ok := s.targets[projector]
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "unknown projector %q", projector)
+	}
+	return t, nil
+}
+
+// statusError maps library errors to gRPC status codes.
+func statusError(err error) error {
+	code := codes.Unavailable
+	switch {
+	case errors.Is(err, viewsonic.ErrFunctionDisabled):
+		code = codes.FailedPrecondition
+	case errors.Is(err, viewsonic.ErrUnsupported):
+		code = codes.Unimplemented
+	case errors.Is(err, viewsonic.ErrQueueFull):
+		code = codes.ResourceExhausted
+	case errors.Is(err, viewsonic.ErrUnexpectedResponse):
+		code = codes.Internal
+	}
+	return status.Error(code, err.Error())
+}
+
+// do runs a command without result on the projector.
+func (s *Server) do(projector string, fn func(*viewsonic.ViewSonic) error) (*emptypb.Empty, error) {
+	t, err := s.lookup(projector)
+	if err != nil {
+		return nil, err
+	}
+	if err := fn(t.conn); err != nil {
+		return nil, statusError(err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// get reads a value from the projector.
+func get[T any](s *Server, projector string, fn func(*viewsonic.ViewSonic) (T, error)) (T, error) {
+	var zero T
+	t, err := s.lookup(projector)
+	if err != nil {
+		return zero, err
+	}
+	value, err := fn(t.conn)
+	if err != nil {
+		return zero, statusError(err)
+	}
+	return value, nil
+}
+
+func getBool(s *Server, projector string, fn func(*viewsonic.ViewSonic) (bool, error)) (*BoolResponse, error) {
+	value, err := get(s, projector, fn)
+	if err != nil {
+		return nil, err
+	}
+	return &BoolResponse{Value: value}, nil
+}
+
+func getInt32[T int8 | int16](s *Server, projector string, fn func(*viewsonic.ViewSonic) (T, error)) (*Int32Response, error) {
+	value, err := get(s, projector, fn)
+	if err != nil {
+		return nil, err
+	}
+	return &Int32Response{Value: int32(value)}, nil
+}
+
+// setInt8 runs a setter taking the int8 value of the request.
+func (s *Server) setInt8(req *SetInt32Request, fn func(*viewsonic.ViewSonic, int8) error) (*emptypb.Empty, error) {
+	if req.Value < math.MinInt8 || req.Value > math.MaxInt8 {
+		return nil, status.Errorf(codes.InvalidArgument, "value %d out of range", req.Value)
+	}
+	return s.do(req.Projector, func(conn *viewsonic.ViewSonic) error {
+		return fn(conn, int8(req.Value))
+	})
+}
+
+// setEnum runs a setter taking the enum value of the request. Proto enums are open, values
+// the library does not know are rejected instead of being sent to the projector.
+func setEnum[P ~int32, T ~int8](s *Server, projector string, value P, values []T, fn func(*viewsonic.ViewSonic, T) error) (*emptypb.Empty, error) {
+	i := slices.IndexFunc(values, func(v T) bool { return int32(v) == int32(value) })
+	if i < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "value %d out of range", value)
+	}
+	return s.do(projector, func(conn *viewsonic.ViewSonic) error {
+		return fn(conn, values[i])
+	})
+}
+
+// setBool runs a setter taking the bool value of the request.
+func (s *Server) setBool(req *SetBoolRequest, fn func(*viewsonic.ViewSonic, bool) error) (*emptypb.Empty, error) {
+	return s.do(req.Projector, func(conn *viewsonic.ViewSonic) error {
+		return fn(conn, req.Value)
+	})
+}
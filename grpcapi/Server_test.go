@@ -0,0 +1,56 @@
+package grpcapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/m-baertschi/viewsonic"
+	"github.com/m-baertschi/viewsonic/emulator"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestStatusError(t *testing.T) {
+	for _, tc := range []struct {
+		err  error
+		code codes.Code
+	}{
+		{viewsonic.ErrFunctionDisabled, codes.FailedPrecondition},
+		{fmt.Errorf("%w LS920WU: Freeze", viewsonic.ErrUnsupported), codes.Unimplemented},
+		{fmt.Errorf("%w: user", viewsonic.ErrQueueFull), codes.ResourceExhausted},
+		{&viewsonic.ResponseError{Expected: "write response"}, codes.Internal},
+		{viewsonic.ErrNoResponse, codes.Unavailable},
+		{errors.New("connection not established"), codes.Unavailable},
+	} {
+		if got := status.Code(statusError(tc.err)); got != tc.code {
+			t.Errorf("statusError(%v) = %v, want %v", tc.err, got, tc.code)
+		}
+	}
+}
+
+func TestSetEnumRejectsUnknownValues(t *testing.T) {
+	e := emulator.New()
+	if err := e.Listen("127.0.0.1:0"); err != nil {
+		t.Fatal(err)
+	}
+	defer e.Close()
+	conn := viewsonic.New(e.Addr())
+	defer conn.Close()
+	power := &powerServer{Server: NewServer(map[string]*viewsonic.ViewSonic{"hall": conn})}
+	ctx := context.Background()
+
+	if _, err := power.SetPower(ctx, &SetPowerRequest{Projector: "hall", State: PowerState_POWER_STATE_ON}); err != nil {
+		t.Fatal(err)
+	}
+	for _, state := range []PowerState{2, 257, -1} {
+		_, err := power.SetPower(ctx, &SetPowerRequest{Projector: "hall", State: state})
+		if status.Code(err) != codes.InvalidArgument {
+			t.Errorf("SetPower(%d) = %v, want InvalidArgument", state, err)
+		}
+	}
+	if state, err := conn.GetPower(); err != nil || state != viewsonic.PowerStateOn {
+		t.Errorf("power %v, %v after invalid requests, want on", state, err)
+	}
+}
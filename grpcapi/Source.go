@@ -0,0 +1,105 @@
+package grpcapi
+
+import (
+	"context"
+
+	"github.com/m-baertschi/viewsonic"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+type sourceServer struct {
+	UnimplementedSourceServer
+	*Server
+}
+
+func (s *sourceServer) SetSourceInput(ctx context.Context, req *SetSourceInputRequest) (*emptypb.Empty, error) {
+	return setEnum(s.Server, req.Projector, req.Input, viewsonic.SourceInputValues(), (*viewsonic.ViewSonic).SetSourceInput)
+}
+
+func (s *sourceServer) GetSourceInput(ctx context.Context, req *ProjectorRequest) (*SourceInputResponse, error) {
+	input, err := get(s.Server, req.Projector, (*viewsonic.ViewSonic).GetSourceInput)
+	if err != nil {
+		return nil, err
+	}
+	return &SourceInputResponse{Input: SourceInput(input)}, nil
+}
+
+func (s *sourceServer) SetQuickAutoSearch(ctx context.Context, req *SetBoolRequest) (*emptypb.Empty, error) {
+	return s.setBool(req, (*viewsonic.ViewSonic).SetQuickAutoSearch)
+}
+
+func (s *sourceServer) GetQuickAutoSearch(ctx context.Context, req *ProjectorRequest) (*BoolResponse, error) {
+	return getBool(s.Server, req.Projector, (*viewsonic.ViewSonic).GetQuickAutoSearch)
+}
+
+func (s *sourceServer) SetHdmiFormat(ctx context.Context, req *SetHdmiFormatRequest) (*emptypb.Empty, error) {
+	return setEnum(s.Server, req.Projector, req.Format, viewsonic.HdmiFormatValues(), (*viewsonic.ViewSonic).SetHdmiFormat)
+}
+
+func (s *sourceServer) GetHdmiFormat(ctx context.Context, req *ProjectorRequest) (*HdmiFormatResponse, error) {
+	format, err := get(s.Server, req.Projector, (*viewsonic.ViewSonic).GetHdmiFormat)
+	if err != nil {
+		return nil, err
+	}
+	return &HdmiFormatResponse{Format: HdmiFormat(format)}, nil
+}
+
+func (s *sourceServer) SetHdmiRange(ctx context.Context, req *SetHdmiRangeRequest) (*emptypb.Empty, error) {
+	return setEnum(s.Server, req.Projector, req.Range, viewsonic.HdmiRangeValues(), (*viewsonic.ViewSonic).SetHdmiRange)
+}
+
+func (s *sourceServer) GetHdmiRange(ctx context.Context, req *ProjectorRequest) (*HdmiRangeResponse, error) {
+	r, err := get(s.Server, req.Projector, (*viewsonic.ViewSonic).GetHdmiRange)
+	if err != nil {
+		return nil, err
+	}
+	return &HdmiRangeResponse{Range: HdmiRange(r)}, nil
+}
+
+func (s *sourceServer) SetCEC(ctx context.Context, req *SetBoolRequest) (*emptypb.Empty, error) {
+	return s.setBool(req, (*viewsonic.ViewSonic).SetCEC)
+}
+
+func (s *sourceServer) GetCEC(ctx context.Context, req *ProjectorRequest) (*BoolResponse, error) {
+	return getBool(s.Server, req.Projector, (*viewsonic.ViewSonic).GetCEC)
+}
+
+func (s *sourceServer) ShiftHorizontalPosition(ctx context.Context, req *SetBoolRequest) (*emptypb.Empty, error) {
+	return s.setBool(req, (*viewsonic.ViewSonic).ShiftHorizontalPosition)
+}
+
+func (s *sourceServer) GetHorizontalPosition(ctx context.Context, req *ProjectorRequest) (*Int32Response, error) {
+	return getInt32(s.Server, req.Projector, (*viewsonic.ViewSonic).GetHorizontalPosition)
+}
+
+func (s *sourceServer) ShiftVerticalPosition(ctx context.Context, req *SetBoolRequest) (*emptypb.Empty, error) {
+	return s.setBool(req, (*viewsonic.ViewSonic).ShiftVerticalPosition)
+}
+
+func (s *sourceServer) GetVerticalPosition(ctx context.Context, req *ProjectorRequest) (*Int32Response, error) {
+	return getInt32(s.Server, req.Projector, (*viewsonic.ViewSonic).GetVerticalPosition)
+}
+
+func (s *sourceServer) IncreaseKeystoneVertical(ctx context.Context, req *ProjectorRequest) (*emptypb.Empty, error) {
+	return s.do(req.Projector, (*viewsonic.ViewSonic).IncreaseKeystoneVertical)
+}
+
+func (s *sourceServer) DecreaseKeystoneVertical(ctx context.Context, req *ProjectorRequest) (*emptypb.Empty, error) {
+	return s.do(req.Projector, (*viewsonic.ViewSonic).DecreaseKeystoneVertical)
+}
+
+func (s *sourceServer) GetKeystoneVertical(ctx context.Context, req *ProjectorRequest) (*Int32Response, error) {
+	return getInt32(s.Server, req.Projector, (*viewsonic.ViewSonic).GetKeystoneVertical)
+}
+
+func (s *sourceServer) IncreaseKeystoneHorizontal(ctx context.Context, req *ProjectorRequest) (*emptypb.Empty, error) {
+	return s.do(req.Projector, (*viewsonic.ViewSonic).IncreaseKeystoneHorizontal)
+}
+
+func (s *sourceServer) DecreaseKeystoneHorizontal(ctx context.Context, req *ProjectorRequest) (*emptypb.Empty, error) {
+	return s.do(req.Projector, (*viewsonic.ViewSonic).DecreaseKeystoneHorizontal)
+}
+
+func (s *sourceServer) GetKeystoneHorizontal(ctx context.Context, req *ProjectorRequest) (*Int32Response, error) {
+	return getInt32(s.Server, req.Projector, (*viewsonic.ViewSonic).GetKeystoneHorizontal)
+}
@@ -0,0 +1,168 @@
+package grpcapi
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/m-baertschi/viewsonic"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type statusServer struct {
+	UnimplementedStatusServer
+	*Server
+}
+
+func (s *statusServer) GetProjectorStatus(ctx context.Context, req *ProjectorRequest) (*ProjectorStatusResponse, error) {
+	value, err := get(s.Server, req.Projector, (*viewsonic.ViewSonic).GetProjectorStatus)
+	if err != nil {
+		return nil, err
+	}
+	return &ProjectorStatusResponse{Status: ProjectorStatus(value)}, nil
+}
+
+func (s *statusServer) GetErrorStatus(ctx context.Context, req *ProjectorRequest) (*ErrorStatusResponse, error) {
+	value, err := get(s.Server, req.Projector, (*viewsonic.ViewSonic).GetErrorStatus)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &ErrorStatusResponse{
+		FirstBurnInErrorMinute: value.FirstBurnInErrorMinute,
+		LampStatus:             LampModeStatus(value.LampStatus),
+		LampErrorStatus:        uint32(value.LampErrorStatus),
+	}
+	for _, counter := range value.Counters() {
+		resp.Counters = append(resp.Counters, &ErrorCounter{
+			Name:     counter.Name,
+			Category: string(counter.Category),
+			Value:    uint32(counter.Value),
+		})
+	}
+	return resp, nil
+}
+
+func (s *statusServer) GetOperatingTemperature(ctx context.Context, req *ProjectorRequest) (*TemperatureResponse, error) {
+	t, err := s.lookup(req.Projector)
+	if err != nil {
+		return nil, err
+	}
+	temp1, temp2, err := t.conn.GetOperatingTemperature()
+	if err != nil {
+		return nil, statusError(err)
+	}
+	return &TemperatureResponse{Temperature1: temp1, Temperature2: temp2}, nil
+}
+
+func (s *statusServer) GetLightSourceUsageTime(ctx context.Context, req *ProjectorRequest) (*UsageTimeResponse, error) {
+	hours, err := get(s.Server, req.Projector, (*viewsonic.ViewSonic).GetLightSourceUsageTime)
+	if err != nil {
+		return nil, err
+	}
+	return &UsageTimeResponse{Hours: hours}, nil
+}
+
+func (s *statusServer) Watch(req *WatchRequest, stream grpc.ServerStreamingServer[StateChange]) error {
+	names := req.Projectors
+	if len(names) == 0 {
+		for name := range s.targets {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+	}
+
+	var fields map[viewsonic.StateField]bool
+	if len(req.Fields) > 0 {
+		fields = map[viewsonic.StateField]bool{}
+		for _, f := range req.Fields {
+			fields[viewsonic.StateField(f)] = true
+		}
+	}
+	wants := func(field viewsonic.StateField) bool {
+		return fields == nil || fields[field]
+	}
+
+	targets := make([]*target, 0, len(names))
+	for _, name := range names {
+		t, err := s.lookup(name)
+		if err != nil {
+			return err
+		}
+		targets = append(targets, t)
+	}
+
+	changes := make(chan viewsonic.StateChange, 64)
+	overflow := make(chan struct{})
+	var overflowOnce sync.Once
+
+	// Subscribe before sending the current state, so no change is missed
+	for _, t := range targets {
+		unsubscribe := t.poller.Subscribe(func(change viewsonic.StateChange) {
+			if !wants(change.Field) {
+				return
+			}
+			select {
+			case changes <- change:
+			default:
+				overflowOnce.Do(func() { close(overflow) })
+			}
+		})
+		defer unsubscribe()
+	}
+
+	for _, t := range targets {
+		state := t.poller.State()
+		for _, field := range viewsonic.StateFields {
+			value, ok := state[field]
+			if !ok || !wants(field) {
+				continue
+			}
+			change := viewsonic.StateChange{Projector: t.poller.Name(), Field: field, Value: value}
+			if err := stream.Send(stateChange(change)); err != nil {
+				return err
+			}
+		}
+	}
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case <-overflow:
+			return status.Error(codes.ResourceExhausted, "client too slow")
+		case change := <-changes:
+			if err := stream.Send(stateChange(change)); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// stateChange converts a polled change to its message, a nil value stays unset.
+func stateChange(c viewsonic.StateChange) *StateChange {
+	msg := &StateChange{
+		Projector: c.Projector,
+		Field:     string(c.Field),
+	}
+	if !c.Time.IsZero() {
+		msg.Time = timestamppb.New(c.Time)
+	}
+
+	switch v := c.Value.(type) {
+	case bool:
+		msg.Value = &StateChange_BoolValue{BoolValue: v}
+	case int8:
+		msg.Value = &StateChange_IntValue{IntValue: int32(v)}
+	case float32:
+		msg.Value = &StateChange_FloatValue{FloatValue: v}
+	case viewsonic.ProjectorStatusValue:
+		msg.Value = &StateChange_Status{Status: ProjectorStatus(v)}
+	case viewsonic.SourceInput:
+		msg.Value = &StateChange_Source{Source: SourceInput(v)}
+	}
+	return msg
+}
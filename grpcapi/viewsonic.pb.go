@@ -0,0 +1,3915 @@
+// gRPC API mirroring the viewsonic library. Enum values equal the RS-232 values of the
+// matching Go types, every request names the projector configured on the server.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.12
+// 	protoc        (unknown)
+// source: viewsonic.proto
+
+package grpcapi
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type PowerState int32
+
+const (
+	PowerState_POWER_STATE_OFF PowerState = 0
+	PowerState_POWER_STATE_ON  PowerState = 1
+)
+
+// Enum value maps for PowerState.
+var (
+	PowerState_name = map[int32]string{
+		0: "POWER_STATE_OFF",
+		1: "POWER_STATE_ON",
+	}
+	PowerState_value = map[string]int32{
+		"POWER_STATE_OFF": 0,
+		"POWER_STATE_ON":  1,
+	}
+)
+
+func (x PowerState) Enum() *PowerState {
+	p := new(PowerState)
+	*p = x
+	return p
+}
+
+func (x PowerState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PowerState) Descriptor() protoreflect.EnumDescriptor {
+	return file_viewsonic_proto_enumTypes[0].Descriptor()
+}
+
+func (PowerState) Type() protoreflect.EnumType {
+	return &file_viewsonic_proto_enumTypes[0]
+}
+
+func (x PowerState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PowerState.Descriptor instead.
+func (PowerState) EnumDescriptor() ([]byte, []int) {
+	return file_viewsonic_proto_rawDescGZIP(), []int{0}
+}
+
+type ProjectorStatus int32
+
+const (
+	ProjectorStatus_PROJECTOR_STATUS_POWER_OFF ProjectorStatus = 0
+	ProjectorStatus_PROJECTOR_STATUS_WARM_UP   ProjectorStatus = 1
+	ProjectorStatus_PROJECTOR_STATUS_POWER_ON  ProjectorStatus = 2
+	ProjectorStatus_PROJECTOR_STATUS_COOL_DOWN ProjectorStatus = 3
+)
+
+// Enum value maps for ProjectorStatus.
+var (
+	ProjectorStatus_name = map[int32]string{
+		0: "PROJECTOR_STATUS_POWER_OFF",
+		1: "PROJECTOR_STATUS_WARM_UP",
+		2: "PROJECTOR_STATUS_POWER_ON",
+		3: "PROJECTOR_STATUS_COOL_DOWN",
+	}
+	ProjectorStatus_value = map[string]int32{
+		"PROJECTOR_STATUS_POWER_OFF": 0,
+		"PROJECTOR_STATUS_WARM_UP":   1,
+		"PROJECTOR_STATUS_POWER_ON":  2,
+		"PROJECTOR_STATUS_COOL_DOWN": 3,
+	}
+)
+
+func (x ProjectorStatus) Enum() *ProjectorStatus {
+	p := new(ProjectorStatus)
+	*p = x
+	return p
+}
+
+func (x ProjectorStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ProjectorStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_viewsonic_proto_enumTypes[1].Descriptor()
+}
+
+func (ProjectorStatus) Type() protoreflect.EnumType {
+	return &file_viewsonic_proto_enumTypes[1]
+}
+
+func (x ProjectorStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ProjectorStatus.Descriptor instead.
+func (ProjectorStatus) EnumDescriptor() ([]byte, []int) {
+	return file_viewsonic_proto_rawDescGZIP(), []int{1}
+}
+
+type LampModeStatus int32
+
+const (
+	LampModeStatus_LAMP_MODE_STATUS_STANDBY                      LampModeStatus = 0
+	LampModeStatus_LAMP_MODE_STATUS_IGNITION                     LampModeStatus = 1
+	LampModeStatus_LAMP_MODE_STATUS_LAMP_RUN_UP                  LampModeStatus = 4
+	LampModeStatus_LAMP_MODE_STATUS_COOL_DOWN                    LampModeStatus = 5
+	LampModeStatus_LAMP_MODE_STATUS_NORMAL_LAMP_OPERATION        LampModeStatus = 6
+	LampModeStatus_LAMP_MODE_STATUS_SHUTDOWN_UNRECOVERABLE_ERROR LampModeStatus = 8
+	LampModeStatus_LAMP_MODE_STATUS_PRE_HEATING_PHASE            LampModeStatus = 9
+)
+
+// Enum value maps for LampModeStatus.
+var (
+	LampModeStatus_name = map[int32]string{
+		0: "LAMP_MODE_STATUS_STANDBY",
+		1: "LAMP_MODE_STATUS_IGNITION",
+		4: "LAMP_MODE_STATUS_LAMP_RUN_UP",
+		5: "LAMP_MODE_STATUS_COOL_DOWN",
+		6: "LAMP_MODE_STATUS_NORMAL_LAMP_OPERATION",
+		8: "LAMP_MODE_STATUS_SHUTDOWN_UNRECOVERABLE_ERROR",
+		9: "LAMP_MODE_STATUS_PRE_HEATING_PHASE",
+	}
+	LampModeStatus_value = map[string]int32{
+		"LAMP_MODE_STATUS_STANDBY":                      0,
+		"LAMP_MODE_STATUS_IGNITION":                     1,
+		"LAMP_MODE_STATUS_LAMP_RUN_UP":                  4,
+		"LAMP_MODE_STATUS_COOL_DOWN":                    5,
+		"LAMP_MODE_STATUS_NORMAL_LAMP_OPERATION":        6,
+		"LAMP_MODE_STATUS_SHUTDOWN_UNRECOVERABLE_ERROR": 8,
+		"LAMP_MODE_STATUS_PRE_HEATING_PHASE":            9,
+	}
+)
+
+func (x LampModeStatus) Enum() *LampModeStatus {
+	p := new(LampModeStatus)
+	*p = x
+	return p
+}
+
+func (x LampModeStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (LampModeStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_viewsonic_proto_enumTypes[2].Descriptor()
+}
+
+func (LampModeStatus) Type() protoreflect.EnumType {
+	return &file_viewsonic_proto_enumTypes[2]
+}
+
+func (x LampModeStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use LampModeStatus.Descriptor instead.
+func (LampModeStatus) EnumDescriptor() ([]byte, []int) {
+	return file_viewsonic_proto_rawDescGZIP(), []int{2}
+}
+
+type SourceInput int32
+
+const (
+	SourceInput_SOURCE_INPUT_D_SUB1      SourceInput = 0
+	SourceInput_SOURCE_INPUT_HDMI1       SourceInput = 3
+	SourceInput_SOURCE_INPUT_COMPOSITE   SourceInput = 5
+	SourceInput_SOURCE_INPUT_S_VIDEO     SourceInput = 6
+	SourceInput_SOURCE_INPUT_HDMI2       SourceInput = 7
+	SourceInput_SOURCE_INPUT_D_SUB2      SourceInput = 8
+	SourceInput_SOURCE_INPUT_HDMI3       SourceInput = 9
+	SourceInput_SOURCE_INPUT_DVI         SourceInput = 10
+	SourceInput_SOURCE_INPUT_COMPONENT   SourceInput = 11
+	SourceInput_SOURCE_INPUT_HDBASET     SourceInput = 12
+	SourceInput_SOURCE_INPUT_HDMI_MHL4   SourceInput = 14
+	SourceInput_SOURCE_INPUT_USB_C       SourceInput = 15
+	SourceInput_SOURCE_INPUT_USB_READER  SourceInput = 26
+	SourceInput_SOURCE_INPUT_LAN_WIFI    SourceInput = 27
+	SourceInput_SOURCE_INPUT_USB_DISPLAY SourceInput = 28
+)
+
+// Enum value maps for SourceInput.
+var (
+	SourceInput_name = map[int32]string{
+		0:  "SOURCE_INPUT_D_SUB1",
+		3:  "SOURCE_INPUT_HDMI1",
+		5:  "SOURCE_INPUT_COMPOSITE",
+		6:  "SOURCE_INPUT_S_VIDEO",
+		7:  "SOURCE_INPUT_HDMI2",
+		8:  "SOURCE_INPUT_D_SUB2",
+		9:  "SOURCE_INPUT_HDMI3",
+		10: "SOURCE_INPUT_DVI",
+		11: "SOURCE_INPUT_COMPONENT",
+		12: "SOURCE_INPUT_HDBASET",
+		14: "SOURCE_INPUT_HDMI_MHL4",
+		15: "SOURCE_INPUT_USB_C",
+		26: "SOURCE_INPUT_USB_READER",
+		27: "SOURCE_INPUT_LAN_WIFI",
+		28: "SOURCE_INPUT_USB_DISPLAY",
+	}
+	SourceInput_value = map[string]int32{
+		"SOURCE_INPUT_D_SUB1":      0,
+		"SOURCE_INPUT_HDMI1":       3,
+		"SOURCE_INPUT_COMPOSITE":   5,
+		"SOURCE_INPUT_S_VIDEO":     6,
+		"SOURCE_INPUT_HDMI2":       7,
+		"SOURCE_INPUT_D_SUB2":      8,
+		"SOURCE_INPUT_HDMI3":       9,
+		"SOURCE_INPUT_DVI":         10,
+		"SOURCE_INPUT_COMPONENT":   11,
+		"SOURCE_INPUT_HDBASET":     12,
+		"SOURCE_INPUT_HDMI_MHL4":   14,
+		"SOURCE_INPUT_USB_C":       15,
+		"SOURCE_INPUT_USB_READER":  26,
+		"SOURCE_INPUT_LAN_WIFI":    27,
+		"SOURCE_INPUT_USB_DISPLAY": 28,
+	}
+)
+
+func (x SourceInput) Enum() *SourceInput {
+	p := new(SourceInput)
+	*p = x
+	return p
+}
+
+func (x SourceInput) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SourceInput) Descriptor() protoreflect.EnumDescriptor {
+	return file_viewsonic_proto_enumTypes[3].Descriptor()
+}
+
+func (SourceInput) Type() protoreflect.EnumType {
+	return &file_viewsonic_proto_enumTypes[3]
+}
+
+func (x SourceInput) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SourceInput.Descriptor instead.
+func (SourceInput) EnumDescriptor() ([]byte, []int) {
+	return file_viewsonic_proto_rawDescGZIP(), []int{3}
+}
+
+type HdmiFormat int32
+
+const (
+	HdmiFormat_HDMI_FORMAT_RGB  HdmiFormat = 0
+	HdmiFormat_HDMI_FORMAT_YUV  HdmiFormat = 1
+	HdmiFormat_HDMI_FORMAT_AUTO HdmiFormat = 2
+)
+
+// Enum value maps for HdmiFormat.
+var (
+	HdmiFormat_name = map[int32]string{
+		0: "HDMI_FORMAT_RGB",
+		1: "HDMI_FORMAT_YUV",
+		2: "HDMI_FORMAT_AUTO",
+	}
+	HdmiFormat_value = map[string]int32{
+		"HDMI_FORMAT_RGB":  0,
+		"HDMI_FORMAT_YUV":  1,
+		"HDMI_FORMAT_AUTO": 2,
+	}
+)
+
+func (x HdmiFormat) Enum() *HdmiFormat {
+	p := new(HdmiFormat)
+	*p = x
+	return p
+}
+
+func (x HdmiFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (HdmiFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_viewsonic_proto_enumTypes[4].Descriptor()
+}
+
+func (HdmiFormat) Type() protoreflect.EnumType {
+	return &file_viewsonic_proto_enumTypes[4]
+}
+
+func (x HdmiFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use HdmiFormat.Descriptor instead.
+func (HdmiFormat) EnumDescriptor() ([]byte, []int) {
+	return file_viewsonic_proto_rawDescGZIP(), []int{4}
+}
+
+type HdmiRange int32
+
+const (
+	HdmiRange_HDMI_RANGE_ENHANCED HdmiRange = 0
+	HdmiRange_HDMI_RANGE_NORMAL   HdmiRange = 1
+	HdmiRange_HDMI_RANGE_AUTO     HdmiRange = 2
+)
+
+// Enum value maps for HdmiRange.
+var (
+	HdmiRange_name = map[int32]string{
+		0: "HDMI_RANGE_ENHANCED",
+		1: "HDMI_RANGE_NORMAL",
+		2: "HDMI_RANGE_AUTO",
+	}
+	HdmiRange_value = map[string]int32{
+		"HDMI_RANGE_ENHANCED": 0,
+		"HDMI_RANGE_NORMAL":   1,
+		"HDMI_RANGE_AUTO":     2,
+	}
+)
+
+func (x HdmiRange) Enum() *HdmiRange {
+	p := new(HdmiRange)
+	*p = x
+	return p
+}
+
+func (x HdmiRange) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (HdmiRange) Descriptor() protoreflect.EnumDescriptor {
+	return file_viewsonic_proto_enumTypes[5].Descriptor()
+}
+
+func (HdmiRange) Type() protoreflect.EnumType {
+	return &file_viewsonic_proto_enumTypes[5]
+}
+
+func (x HdmiRange) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use HdmiRange.Descriptor instead.
+func (HdmiRange) EnumDescriptor() ([]byte, []int) {
+	return file_viewsonic_proto_rawDescGZIP(), []int{5}
+}
+
+type SplashScreen int32
+
+const (
+	SplashScreen_SPLASH_SCREEN_BLACK     SplashScreen = 0
+	SplashScreen_SPLASH_SCREEN_BLUE      SplashScreen = 1
+	SplashScreen_SPLASH_SCREEN_VIEWSONIC SplashScreen = 2
+	SplashScreen_SPLASH_SCREEN_CAPTURE   SplashScreen = 3
+	SplashScreen_SPLASH_SCREEN_OFF       SplashScreen = 4
+)
+
+// Enum value maps for SplashScreen.
+var (
+	SplashScreen_name = map[int32]string{
+		0: "SPLASH_SCREEN_BLACK",
+		1: "SPLASH_SCREEN_BLUE",
+		2: "SPLASH_SCREEN_VIEWSONIC",
+		3: "SPLASH_SCREEN_CAPTURE",
+		4: "SPLASH_SCREEN_OFF",
+	}
+	SplashScreen_value = map[string]int32{
+		"SPLASH_SCREEN_BLACK":     0,
+		"SPLASH_SCREEN_BLUE":      1,
+		"SPLASH_SCREEN_VIEWSONIC": 2,
+		"SPLASH_SCREEN_CAPTURE":   3,
+		"SPLASH_SCREEN_OFF":       4,
+	}
+)
+
+func (x SplashScreen) Enum() *SplashScreen {
+	p := new(SplashScreen)
+	*p = x
+	return p
+}
+
+func (x SplashScreen) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SplashScreen) Descriptor() protoreflect.EnumDescriptor {
+	return file_viewsonic_proto_enumTypes[6].Descriptor()
+}
+
+func (SplashScreen) Type() protoreflect.EnumType {
+	return &file_viewsonic_proto_enumTypes[6]
+}
+
+func (x SplashScreen) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SplashScreen.Descriptor instead.
+func (SplashScreen) EnumDescriptor() ([]byte, []int) {
+	return file_viewsonic_proto_rawDescGZIP(), []int{6}
+}
+
+type ProjectorPosition int32
+
+const (
+	ProjectorPosition_PROJECTOR_POSITION_FRONT_TABLE   ProjectorPosition = 0
+	ProjectorPosition_PROJECTOR_POSITION_REAR_TABLE    ProjectorPosition = 1
+	ProjectorPosition_PROJECTOR_POSITION_REAR_CEILING  ProjectorPosition = 2
+	ProjectorPosition_PROJECTOR_POSITION_FRONT_CEILING ProjectorPosition = 3
+)
+
+// Enum value maps for ProjectorPosition.
+var (
+	ProjectorPosition_name = map[int32]string{
+		0: "PROJECTOR_POSITION_FRONT_TABLE",
+		1: "PROJECTOR_POSITION_REAR_TABLE",
+		2: "PROJECTOR_POSITION_REAR_CEILING",
+		3: "PROJECTOR_POSITION_FRONT_CEILING",
+	}
+	ProjectorPosition_value = map[string]int32{
+		"PROJECTOR_POSITION_FRONT_TABLE":   0,
+		"PROJECTOR_POSITION_REAR_TABLE":    1,
+		"PROJECTOR_POSITION_REAR_CEILING":  2,
+		"PROJECTOR_POSITION_FRONT_CEILING": 3,
+	}
+)
+
+func (x ProjectorPosition) Enum() *ProjectorPosition {
+	p := new(ProjectorPosition)
+	*p = x
+	return p
+}
+
+func (x ProjectorPosition) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ProjectorPosition) Descriptor() protoreflect.EnumDescriptor {
+	return file_viewsonic_proto_enumTypes[7].Descriptor()
+}
+
+func (ProjectorPosition) Type() protoreflect.EnumType {
+	return &file_viewsonic_proto_enumTypes[7]
+}
+
+func (x ProjectorPosition) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ProjectorPosition.Descriptor instead.
+func (ProjectorPosition) EnumDescriptor() ([]byte, []int) {
+	return file_viewsonic_proto_rawDescGZIP(), []int{7}
+}
+
+type AspectRatio int32
+
+const (
+	AspectRatio_ASPECT_RATIO_AUTO       AspectRatio = 0
+	AspectRatio_ASPECT_RATIO_4_TO_3     AspectRatio = 2
+	AspectRatio_ASPECT_RATIO_16_TO_9    AspectRatio = 3
+	AspectRatio_ASPECT_RATIO_16_TO_10   AspectRatio = 4
+	AspectRatio_ASPECT_RATIO_ANAMORPHIC AspectRatio = 5
+	AspectRatio_ASPECT_RATIO_WIDE       AspectRatio = 6
+	AspectRatio_ASPECT_RATIO_235_TO_1   AspectRatio = 7
+	AspectRatio_ASPECT_RATIO_PANORAMA   AspectRatio = 8
+	AspectRatio_ASPECT_RATIO_NATIVE     AspectRatio = 9
+)
+
+// Enum value maps for AspectRatio.
+var (
+	AspectRatio_name = map[int32]string{
+		0: "ASPECT_RATIO_AUTO",
+		2: "ASPECT_RATIO_4_TO_3",
+		3: "ASPECT_RATIO_16_TO_9",
+		4: "ASPECT_RATIO_16_TO_10",
+		5: "ASPECT_RATIO_ANAMORPHIC",
+		6: "ASPECT_RATIO_WIDE",
+		7: "ASPECT_RATIO_235_TO_1",
+		8: "ASPECT_RATIO_PANORAMA",
+		9: "ASPECT_RATIO_NATIVE",
+	}
+	AspectRatio_value = map[string]int32{
+		"ASPECT_RATIO_AUTO":       0,
+		"ASPECT_RATIO_4_TO_3":     2,
+		"ASPECT_RATIO_16_TO_9":    3,
+		"ASPECT_RATIO_16_TO_10":   4,
+		"ASPECT_RATIO_ANAMORPHIC": 5,
+		"ASPECT_RATIO_WIDE":       6,
+		"ASPECT_RATIO_235_TO_1":   7,
+		"ASPECT_RATIO_PANORAMA":   8,
+		"ASPECT_RATIO_NATIVE":     9,
+	}
+)
+
+func (x AspectRatio) Enum() *AspectRatio {
+	p := new(AspectRatio)
+	*p = x
+	return p
+}
+
+func (x AspectRatio) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AspectRatio) Descriptor() protoreflect.EnumDescriptor {
+	return file_viewsonic_proto_enumTypes[8].Descriptor()
+}
+
+func (AspectRatio) Type() protoreflect.EnumType {
+	return &file_viewsonic_proto_enumTypes[8]
+}
+
+func (x AspectRatio) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AspectRatio.Descriptor instead.
+func (AspectRatio) EnumDescriptor() ([]byte, []int) {
+	return file_viewsonic_proto_rawDescGZIP(), []int{8}
+}
+
+type ThreeDSyncMode int32
+
+const (
+	ThreeDSyncMode_THREE_D_SYNC_MODE_OFF              ThreeDSyncMode = 0
+	ThreeDSyncMode_THREE_D_SYNC_MODE_AUTO             ThreeDSyncMode = 1
+	ThreeDSyncMode_THREE_D_SYNC_MODE_FRAME_SEQUENTIAL ThreeDSyncMode = 2
+	ThreeDSyncMode_THREE_D_SYNC_MODE_FRAME_PACKING    ThreeDSyncMode = 3
+	ThreeDSyncMode_THREE_D_SYNC_MODE_TOP_BOTTOM       ThreeDSyncMode = 4
+	ThreeDSyncMode_THREE_D_SYNC_MODE_SIDE_BY_SIDE     ThreeDSyncMode = 5
+)
+
+// Enum value maps for ThreeDSyncMode.
+var (
+	ThreeDSyncMode_name = map[int32]string{
+		0: "THREE_D_SYNC_MODE_OFF",
+		1: "THREE_D_SYNC_MODE_AUTO",
+		2: "THREE_D_SYNC_MODE_FRAME_SEQUENTIAL",
+		3: "THREE_D_SYNC_MODE_FRAME_PACKING",
+		4: "THREE_D_SYNC_MODE_TOP_BOTTOM",
+		5: "THREE_D_SYNC_MODE_SIDE_BY_SIDE",
+	}
+	ThreeDSyncMode_value = map[string]int32{
+		"THREE_D_SYNC_MODE_OFF":              0,
+		"THREE_D_SYNC_MODE_AUTO":             1,
+		"THREE_D_SYNC_MODE_FRAME_SEQUENTIAL": 2,
+		"THREE_D_SYNC_MODE_FRAME_PACKING":    3,
+		"THREE_D_SYNC_MODE_TOP_BOTTOM":       4,
+		"THREE_D_SYNC_MODE_SIDE_BY_SIDE":     5,
+	}
+)
+
+func (x ThreeDSyncMode) Enum() *ThreeDSyncMode {
+	p := new(ThreeDSyncMode)
+	*p = x
+	return p
+}
+
+func (x ThreeDSyncMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ThreeDSyncMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_viewsonic_proto_enumTypes[9].Descriptor()
+}
+
+func (ThreeDSyncMode) Type() protoreflect.EnumType {
+	return &file_viewsonic_proto_enumTypes[9]
+}
+
+func (x ThreeDSyncMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ThreeDSyncMode.Descriptor instead.
+func (ThreeDSyncMode) EnumDescriptor() ([]byte, []int) {
+	return file_viewsonic_proto_rawDescGZIP(), []int{9}
+}
+
+type ColorTemperature int32
+
+const (
+	ColorTemperature_COLOR_TEMPERATURE_WARM    ColorTemperature = 0
+	ColorTemperature_COLOR_TEMPERATURE_NORMAL  ColorTemperature = 1
+	ColorTemperature_COLOR_TEMPERATURE_NEUTRAL ColorTemperature = 2
+	ColorTemperature_COLOR_TEMPERATURE_COOL    ColorTemperature = 3
+)
+
+// Enum value maps for ColorTemperature.
+var (
+	ColorTemperature_name = map[int32]string{
+		0: "COLOR_TEMPERATURE_WARM",
+		1: "COLOR_TEMPERATURE_NORMAL",
+		2: "COLOR_TEMPERATURE_NEUTRAL",
+		3: "COLOR_TEMPERATURE_COOL",
+	}
+	ColorTemperature_value = map[string]int32{
+		"COLOR_TEMPERATURE_WARM":    0,
+		"COLOR_TEMPERATURE_NORMAL":  1,
+		"COLOR_TEMPERATURE_NEUTRAL": 2,
+		"COLOR_TEMPERATURE_COOL":    3,
+	}
+)
+
+func (x ColorTemperature) Enum() *ColorTemperature {
+	p := new(ColorTemperature)
+	*p = x
+	return p
+}
+
+func (x ColorTemperature) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ColorTemperature) Descriptor() protoreflect.EnumDescriptor {
+	return file_viewsonic_proto_enumTypes[10].Descriptor()
+}
+
+func (ColorTemperature) Type() protoreflect.EnumType {
+	return &file_viewsonic_proto_enumTypes[10]
+}
+
+func (x ColorTemperature) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ColorTemperature.Descriptor instead.
+func (ColorTemperature) EnumDescriptor() ([]byte, []int) {
+	return file_viewsonic_proto_rawDescGZIP(), []int{10}
+}
+
+type ColorMode int32
+
+const (
+	ColorMode_COLOR_MODE_BRIGHTEST       ColorMode = 0
+	ColorMode_COLOR_MODE_MOVIE           ColorMode = 1
+	ColorMode_COLOR_MODE_STANDARD        ColorMode = 4
+	ColorMode_COLOR_MODE_SRGB_VIEW_MATCH ColorMode = 5
+	ColorMode_COLOR_MODE_DYNAMIC         ColorMode = 8
+	ColorMode_COLOR_MODE_REC709          ColorMode = 9
+	ColorMode_COLOR_MODE_DICOM_SIM       ColorMode = 10
+	ColorMode_COLOR_MODE_SPORTS          ColorMode = 17
+	ColorMode_COLOR_MODE_GAMING          ColorMode = 18
+	ColorMode_COLOR_MODE_PHOTO           ColorMode = 19
+	ColorMode_COLOR_MODE_PRESENTATION    ColorMode = 20
+	ColorMode_COLOR_MODE_VIVID           ColorMode = 21
+	ColorMode_COLOR_MODE_ISF_DAY         ColorMode = 22
+	ColorMode_COLOR_MODE_ISF_NIGHT       ColorMode = 23
+)
+
+// Enum value maps for ColorMode.
+var (
+	ColorMode_name = map[int32]string{
+		0:  "COLOR_MODE_BRIGHTEST",
+		1:  "COLOR_MODE_MOVIE",
+		4:  "COLOR_MODE_STANDARD",
+		5:  "COLOR_MODE_SRGB_VIEW_MATCH",
+		8:  "COLOR_MODE_DYNAMIC",
+		9:  "COLOR_MODE_REC709",
+		10: "COLOR_MODE_DICOM_SIM",
+		17: "COLOR_MODE_SPORTS",
+		18: "COLOR_MODE_GAMING",
+		19: "COLOR_MODE_PHOTO",
+		20: "COLOR_MODE_PRESENTATION",
+		21: "COLOR_MODE_VIVID",
+		22: "COLOR_MODE_ISF_DAY",
+		23: "COLOR_MODE_ISF_NIGHT",
+	}
+	ColorMode_value = map[string]int32{
+		"COLOR_MODE_BRIGHTEST":       0,
+		"COLOR_MODE_MOVIE":           1,
+		"COLOR_MODE_STANDARD":        4,
+		"COLOR_MODE_SRGB_VIEW_MATCH": 5,
+		"COLOR_MODE_DYNAMIC":         8,
+		"COLOR_MODE_REC709":          9,
+		"COLOR_MODE_DICOM_SIM":       10,
+		"COLOR_MODE_SPORTS":          17,
+		"COLOR_MODE_GAMING":          18,
+		"COLOR_MODE_PHOTO":           19,
+		"COLOR_MODE_PRESENTATION":    20,
+		"COLOR_MODE_VIVID":           21,
+		"COLOR_MODE_ISF_DAY":         22,
+		"COLOR_MODE_ISF_NIGHT":       23,
+	}
+)
+
+func (x ColorMode) Enum() *ColorMode {
+	p := new(ColorMode)
+	*p = x
+	return p
+}
+
+func (x ColorMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ColorMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_viewsonic_proto_enumTypes[11].Descriptor()
+}
+
+func (ColorMode) Type() protoreflect.EnumType {
+	return &file_viewsonic_proto_enumTypes[11]
+}
+
+func (x ColorMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ColorMode.Descriptor instead.
+func (ColorMode) EnumDescriptor() ([]byte, []int) {
+	return file_viewsonic_proto_rawDescGZIP(), []int{11}
+}
+
+type PrimaryColor int32
+
+const (
+	PrimaryColor_PRIMARY_COLOR_R PrimaryColor = 0
+	PrimaryColor_PRIMARY_COLOR_G PrimaryColor = 1
+	PrimaryColor_PRIMARY_COLOR_B PrimaryColor = 2
+	PrimaryColor_PRIMARY_COLOR_C PrimaryColor = 3
+	PrimaryColor_PRIMARY_COLOR_M PrimaryColor = 4
+	PrimaryColor_PRIMARY_COLOR_Y PrimaryColor = 5
+)
+
+// Enum value maps for PrimaryColor.
+var (
+	PrimaryColor_name = map[int32]string{
+		0: "PRIMARY_COLOR_R",
+		1: "PRIMARY_COLOR_G",
+		2: "PRIMARY_COLOR_B",
+		3: "PRIMARY_COLOR_C",
+		4: "PRIMARY_COLOR_M",
+		5: "PRIMARY_COLOR_Y",
+	}
+	PrimaryColor_value = map[string]int32{
+		"PRIMARY_COLOR_R": 0,
+		"PRIMARY_COLOR_G": 1,
+		"PRIMARY_COLOR_B": 2,
+		"PRIMARY_COLOR_C": 3,
+		"PRIMARY_COLOR_M": 4,
+		"PRIMARY_COLOR_Y": 5,
+	}
+)
+
+func (x PrimaryColor) Enum() *PrimaryColor {
+	p := new(PrimaryColor)
+	*p = x
+	return p
+}
+
+func (x PrimaryColor) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PrimaryColor) Descriptor() protoreflect.EnumDescriptor {
+	return file_viewsonic_proto_enumTypes[12].Descriptor()
+}
+
+func (PrimaryColor) Type() protoreflect.EnumType {
+	return &file_viewsonic_proto_enumTypes[12]
+}
+
+func (x PrimaryColor) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PrimaryColor.Descriptor instead.
+func (PrimaryColor) EnumDescriptor() ([]byte, []int) {
+	return file_viewsonic_proto_rawDescGZIP(), []int{12}
+}
+
+type ScreenColor int32
+
+const (
+	ScreenColor_SCREEN_COLOR_OFF        ScreenColor = 0
+	ScreenColor_SCREEN_COLOR_BLACKBOARD ScreenColor = 1
+	ScreenColor_SCREEN_COLOR_GREENBOARD ScreenColor = 2
+	ScreenColor_SCREEN_COLOR_WHITEBOARD ScreenColor = 3
+	ScreenColor_SCREEN_COLOR_BLUEBOARD  ScreenColor = 4
+)
+
+// Enum value maps for ScreenColor.
+var (
+	ScreenColor_name = map[int32]string{
+		0: "SCREEN_COLOR_OFF",
+		1: "SCREEN_COLOR_BLACKBOARD",
+		2: "SCREEN_COLOR_GREENBOARD",
+		3: "SCREEN_COLOR_WHITEBOARD",
+		4: "SCREEN_COLOR_BLUEBOARD",
+	}
+	ScreenColor_value = map[string]int32{
+		"SCREEN_COLOR_OFF":        0,
+		"SCREEN_COLOR_BLACKBOARD": 1,
+		"SCREEN_COLOR_GREENBOARD": 2,
+		"SCREEN_COLOR_WHITEBOARD": 3,
+		"SCREEN_COLOR_BLUEBOARD":  4,
+	}
+)
+
+func (x ScreenColor) Enum() *ScreenColor {
+	p := new(ScreenColor)
+	*p = x
+	return p
+}
+
+func (x ScreenColor) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ScreenColor) Descriptor() protoreflect.EnumDescriptor {
+	return file_viewsonic_proto_enumTypes[13].Descriptor()
+}
+
+func (ScreenColor) Type() protoreflect.EnumType {
+	return &file_viewsonic_proto_enumTypes[13]
+}
+
+func (x ScreenColor) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ScreenColor.Descriptor instead.
+func (ScreenColor) EnumDescriptor() ([]byte, []int) {
+	return file_viewsonic_proto_rawDescGZIP(), []int{13}
+}
+
+type Language int32
+
+const (
+	Language_LANGUAGE_ENGLISH      Language = 0
+	Language_LANGUAGE_FRENCH       Language = 1
+	Language_LANGUAGE_GERMAN       Language = 2
+	Language_LANGUAGE_ITALIAN      Language = 3
+	Language_LANGUAGE_SPANISH      Language = 4
+	Language_LANGUAGE_RUSSIAN      Language = 5
+	Language_LANGUAGE_TRAD_CHINESE Language = 6
+	Language_LANGUAGE_SIMP_CHINESE Language = 7
+	Language_LANGUAGE_JAPANESE     Language = 8
+	Language_LANGUAGE_KOREAN       Language = 9
+	Language_LANGUAGE_SWEDISH      Language = 10
+	Language_LANGUAGE_DUTCH        Language = 11
+	Language_LANGUAGE_TURKISH      Language = 12
+	Language_LANGUAGE_CZECH        Language = 13
+	Language_LANGUAGE_PORTUGUESE   Language = 14
+	Language_LANGUAGE_THAI         Language = 15
+	Language_LANGUAGE_POLISH       Language = 16
+	Language_LANGUAGE_FINNISH      Language = 17
+	Language_LANGUAGE_ARABIC       Language = 18
+	Language_LANGUAGE_INDONESIAN   Language = 19
+	Language_LANGUAGE_HINDI        Language = 20
+	Language_LANGUAGE_VIETNAMESE   Language = 21
+)
+
+// Enum value maps for Language.
+var (
+	Language_name = map[int32]string{
+		0:  "LANGUAGE_ENGLISH",
+		1:  "LANGUAGE_FRENCH",
+		2:  "LANGUAGE_GERMAN",
+		3:  "LANGUAGE_ITALIAN",
+		4:  "LANGUAGE_SPANISH",
+		5:  "LANGUAGE_RUSSIAN",
+		6:  "LANGUAGE_TRAD_CHINESE",
+		7:  "LANGUAGE_SIMP_CHINESE",
+		8:  "LANGUAGE_JAPANESE",
+		9:  "LANGUAGE_KOREAN",
+		10: "LANGUAGE_SWEDISH",
+		11: "LANGUAGE_DUTCH",
+		12: "LANGUAGE_TURKISH",
+		13: "LANGUAGE_CZECH",
+		14: "LANGUAGE_PORTUGUESE",
+		15: "LANGUAGE_THAI",
+		16: "LANGUAGE_POLISH",
+		17: "LANGUAGE_FINNISH",
+		18: "LANGUAGE_ARABIC",
+		19: "LANGUAGE_INDONESIAN",
+		20: "LANGUAGE_HINDI",
+		21: "LANGUAGE_VIETNAMESE",
+	}
+	Language_value = map[string]int32{
+		"LANGUAGE_ENGLISH":      0,
+		"LANGUAGE_FRENCH":       1,
+		"LANGUAGE_GERMAN":       2,
+		"LANGUAGE_ITALIAN":      3,
+		"LANGUAGE_SPANISH":      4,
+		"LANGUAGE_RUSSIAN":      5,
+		"LANGUAGE_TRAD_CHINESE": 6,
+		"LANGUAGE_SIMP_CHINESE": 7,
+		"LANGUAGE_JAPANESE":     8,
+		"LANGUAGE_KOREAN":       9,
+		"LANGUAGE_SWEDISH":      10,
+		"LANGUAGE_DUTCH":        11,
+		"LANGUAGE_TURKISH":      12,
+		"LANGUAGE_CZECH":        13,
+		"LANGUAGE_PORTUGUESE":   14,
+		"LANGUAGE_THAI":         15,
+		"LANGUAGE_POLISH":       16,
+		"LANGUAGE_FINNISH":      17,
+		"LANGUAGE_ARABIC":       18,
+		"LANGUAGE_INDONESIAN":   19,
+		"LANGUAGE_HINDI":        20,
+		"LANGUAGE_VIETNAMESE":   21,
+	}
+)
+
+func (x Language) Enum() *Language {
+	p := new(Language)
+	*p = x
+	return p
+}
+
+func (x Language) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Language) Descriptor() protoreflect.EnumDescriptor {
+	return file_viewsonic_proto_enumTypes[14].Descriptor()
+}
+
+func (Language) Type() protoreflect.EnumType {
+	return &file_viewsonic_proto_enumTypes[14]
+}
+
+func (x Language) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Language.Descriptor instead.
+func (Language) EnumDescriptor() ([]byte, []int) {
+	return file_viewsonic_proto_rawDescGZIP(), []int{14}
+}
+
+type RemoteKey int32
+
+const (
+	RemoteKey_REMOTE_KEY_UNSPECIFIED RemoteKey = 0
+	RemoteKey_REMOTE_KEY_SOURCE      RemoteKey = 4
+	RemoteKey_REMOTE_KEY_AUTO        RemoteKey = 8
+	RemoteKey_REMOTE_KEY_TOP         RemoteKey = 11
+	RemoteKey_REMOTE_KEY_BOTTOM      RemoteKey = 12
+	RemoteKey_REMOTE_KEY_LEFT        RemoteKey = 13
+	RemoteKey_REMOTE_KEY_RIGHT       RemoteKey = 14
+	RemoteKey_REMOTE_KEY_MENU        RemoteKey = 15
+	RemoteKey_REMOTE_KEY_MY_BUTTON   RemoteKey = 17
+	RemoteKey_REMOTE_KEY_EXIT        RemoteKey = 19
+	RemoteKey_REMOTE_KEY_ENTER       RemoteKey = 21
+)
+
+// Enum value maps for RemoteKey.
+var (
+	RemoteKey_name = map[int32]string{
+		0:  "REMOTE_KEY_UNSPECIFIED",
+		4:  "REMOTE_KEY_SOURCE",
+		8:  "REMOTE_KEY_AUTO",
+		11: "REMOTE_KEY_TOP",
+		12: "REMOTE_KEY_BOTTOM",
+		13: "REMOTE_KEY_LEFT",
+		14: "REMOTE_KEY_RIGHT",
+		15: "REMOTE_KEY_MENU",
+		17: "REMOTE_KEY_MY_BUTTON",
+		19: "REMOTE_KEY_EXIT",
+		21: "REMOTE_KEY_ENTER",
+	}
+	RemoteKey_value = map[string]int32{
+		"REMOTE_KEY_UNSPECIFIED": 0,
+		"REMOTE_KEY_SOURCE":      4,
+		"REMOTE_KEY_AUTO":        8,
+		"REMOTE_KEY_TOP":         11,
+		"REMOTE_KEY_BOTTOM":      12,
+		"REMOTE_KEY_LEFT":        13,
+		"REMOTE_KEY_RIGHT":       14,
+		"REMOTE_KEY_MENU":        15,
+		"REMOTE_KEY_MY_BUTTON":   17,
+		"REMOTE_KEY_EXIT":        19,
+		"REMOTE_KEY_ENTER":       21,
+	}
+)
+
+func (x RemoteKey) Enum() *RemoteKey {
+	p := new(RemoteKey)
+	*p = x
+	return p
+}
+
+func (x RemoteKey) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RemoteKey) Descriptor() protoreflect.EnumDescriptor {
+	return file_viewsonic_proto_enumTypes[15].Descriptor()
+}
+
+func (RemoteKey) Type() protoreflect.EnumType {
+	return &file_viewsonic_proto_enumTypes[15]
+}
+
+func (x RemoteKey) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RemoteKey.Descriptor instead.
+func (RemoteKey) EnumDescriptor() ([]byte, []int) {
+	return file_viewsonic_proto_rawDescGZIP(), []int{15}
+}
+
+type LightSourceMode int32
+
+const (
+	LightSourceMode_LIGHT_SOURCE_MODE_NORMAL      LightSourceMode = 0
+	LightSourceMode_LIGHT_SOURCE_MODE_ECO         LightSourceMode = 1
+	LightSourceMode_LIGHT_SOURCE_MODE_DYNAMIC_ECO LightSourceMode = 2
+	LightSourceMode_LIGHT_SOURCE_MODE_SUPER_ECO   LightSourceMode = 3
+)
+
+// Enum value maps for LightSourceMode.
+var (
+	LightSourceMode_name = map[int32]string{
+		0: "LIGHT_SOURCE_MODE_NORMAL",
+		1: "LIGHT_SOURCE_MODE_ECO",
+		2: "LIGHT_SOURCE_MODE_DYNAMIC_ECO",
+		3: "LIGHT_SOURCE_MODE_SUPER_ECO",
+	}
+	LightSourceMode_value = map[string]int32{
+		"LIGHT_SOURCE_MODE_NORMAL":      0,
+		"LIGHT_SOURCE_MODE_ECO":         1,
+		"LIGHT_SOURCE_MODE_DYNAMIC_ECO": 2,
+		"LIGHT_SOURCE_MODE_SUPER_ECO":   3,
+	}
+)
+
+func (x LightSourceMode) Enum() *LightSourceMode {
+	p := new(LightSourceMode)
+	*p = x
+	return p
+}
+
+func (x LightSourceMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (LightSourceMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_viewsonic_proto_enumTypes[16].Descriptor()
+}
+
+func (LightSourceMode) Type() protoreflect.EnumType {
+	return &file_viewsonic_proto_enumTypes[16]
+}
+
+func (x LightSourceMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use LightSourceMode.Descriptor instead.
+func (LightSourceMode) EnumDescriptor() ([]byte, []int) {
+	return file_viewsonic_proto_rawDescGZIP(), []int{16}
+}
+
+type ProjectorRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Projector     string                 `protobuf:"bytes,1,opt,name=projector,proto3" json:"projector,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProjectorRequest) Reset() {
+	*x = ProjectorRequest{}
+	mi := &file_viewsonic_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProjectorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProjectorRequest) ProtoMessage() {}
+
+func (x *ProjectorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_viewsonic_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProjectorRequest.ProtoReflect.Descriptor instead.
+func (*ProjectorRequest) Descriptor() ([]byte, []int) {
+	return file_viewsonic_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ProjectorRequest) GetProjector() string {
+	if x != nil {
+		return x.Projector
+	}
+	return ""
+}
+
+type SetBoolRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Projector     string                 `protobuf:"bytes,1,opt,name=projector,proto3" json:"projector,omitempty"`
+	Value         bool                   `protobuf:"varint,2,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetBoolRequest) Reset() {
+	*x = SetBoolRequest{}
+	mi := &file_viewsonic_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetBoolRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetBoolRequest) ProtoMessage() {}
+
+func (x *SetBoolRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_viewsonic_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetBoolRequest.ProtoReflect.Descriptor instead.
+func (*SetBoolRequest) Descriptor() ([]byte, []int) {
+	return file_viewsonic_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SetBoolRequest) GetProjector() string {
+	if x != nil {
+		return x.Projector
+	}
+	return ""
+}
+
+func (x *SetBoolRequest) GetValue() bool {
+	if x != nil {
+		return x.Value
+	}
+	return false
+}
+
+type BoolResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Value         bool                   `protobuf:"varint,1,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BoolResponse) Reset() {
+	*x = BoolResponse{}
+	mi := &file_viewsonic_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BoolResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BoolResponse) ProtoMessage() {}
+
+func (x *BoolResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_viewsonic_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BoolResponse.ProtoReflect.Descriptor instead.
+func (*BoolResponse) Descriptor() ([]byte, []int) {
+	return file_viewsonic_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *BoolResponse) GetValue() bool {
+	if x != nil {
+		return x.Value
+	}
+	return false
+}
+
+type SetInt32Request struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Projector     string                 `protobuf:"bytes,1,opt,name=projector,proto3" json:"projector,omitempty"`
+	Value         int32                  `protobuf:"varint,2,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetInt32Request) Reset() {
+	*x = SetInt32Request{}
+	mi := &file_viewsonic_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetInt32Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetInt32Request) ProtoMessage() {}
+
+func (x *SetInt32Request) ProtoReflect() protoreflect.Message {
+	mi := &file_viewsonic_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetInt32Request.ProtoReflect.Descriptor instead.
+func (*SetInt32Request) Descriptor() ([]byte, []int) {
+	return file_viewsonic_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *SetInt32Request) GetProjector() string {
+	if x != nil {
+		return x.Projector
+	}
+	return ""
+}
+
+func (x *SetInt32Request) GetValue() int32 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+type Int32Response struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Value         int32                  `protobuf:"varint,1,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Int32Response) Reset() {
+	*x = Int32Response{}
+	mi := &file_viewsonic_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Int32Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Int32Response) ProtoMessage() {}
+
+func (x *Int32Response) ProtoReflect() protoreflect.Message {
+	mi := &file_viewsonic_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Int32Response.ProtoReflect.Descriptor instead.
+func (*Int32Response) Descriptor() ([]byte, []int) {
+	return file_viewsonic_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Int32Response) GetValue() int32 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+type SetPowerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Projector     string                 `protobuf:"bytes,1,opt,name=projector,proto3" json:"projector,omitempty"`
+	State         PowerState             `protobuf:"varint,2,opt,name=state,proto3,enum=viewsonic.v1.PowerState" json:"state,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetPowerRequest) Reset() {
+	*x = SetPowerRequest{}
+	mi := &file_viewsonic_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetPowerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetPowerRequest) ProtoMessage() {}
+
+func (x *SetPowerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_viewsonic_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetPowerRequest.ProtoReflect.Descriptor instead.
+func (*SetPowerRequest) Descriptor() ([]byte, []int) {
+	return file_viewsonic_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *SetPowerRequest) GetProjector() string {
+	if x != nil {
+		return x.Projector
+	}
+	return ""
+}
+
+func (x *SetPowerRequest) GetState() PowerState {
+	if x != nil {
+		return x.State
+	}
+	return PowerState_POWER_STATE_OFF
+}
+
+type PowerResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	State         PowerState             `protobuf:"varint,1,opt,name=state,proto3,enum=viewsonic.v1.PowerState" json:"state,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PowerResponse) Reset() {
+	*x = PowerResponse{}
+	mi := &file_viewsonic_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PowerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PowerResponse) ProtoMessage() {}
+
+func (x *PowerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_viewsonic_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PowerResponse.ProtoReflect.Descriptor instead.
+func (*PowerResponse) Descriptor() ([]byte, []int) {
+	return file_viewsonic_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *PowerResponse) GetState() PowerState {
+	if x != nil {
+		return x.State
+	}
+	return PowerState_POWER_STATE_OFF
+}
+
+type ProjectorStatusResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        ProjectorStatus        `protobuf:"varint,1,opt,name=status,proto3,enum=viewsonic.v1.ProjectorStatus" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProjectorStatusResponse) Reset() {
+	*x = ProjectorStatusResponse{}
+	mi := &file_viewsonic_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProjectorStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProjectorStatusResponse) ProtoMessage() {}
+
+func (x *ProjectorStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_viewsonic_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProjectorStatusResponse.ProtoReflect.Descriptor instead.
+func (*ProjectorStatusResponse) Descriptor() ([]byte, []int) {
+	return file_viewsonic_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ProjectorStatusResponse) GetStatus() ProjectorStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ProjectorStatus_PROJECTOR_STATUS_POWER_OFF
+}
+
+type ErrorCounter struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Category      string                 `protobuf:"bytes,2,opt,name=category,proto3" json:"category,omitempty"`
+	Value         uint32                 `protobuf:"varint,3,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ErrorCounter) Reset() {
+	*x = ErrorCounter{}
+	mi := &file_viewsonic_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ErrorCounter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ErrorCounter) ProtoMessage() {}
+
+func (x *ErrorCounter) ProtoReflect() protoreflect.Message {
+	mi := &file_viewsonic_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ErrorCounter.ProtoReflect.Descriptor instead.
+func (*ErrorCounter) Descriptor() ([]byte, []int) {
+	return file_viewsonic_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ErrorCounter) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ErrorCounter) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *ErrorCounter) GetValue() uint32 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+type ErrorStatusResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// In the order of viewsonic.ErrorStatus.Counters.
+	Counters               []*ErrorCounter `protobuf:"bytes,1,rep,name=counters,proto3" json:"counters,omitempty"`
+	FirstBurnInErrorMinute uint32          `protobuf:"varint,2,opt,name=first_burn_in_error_minute,json=firstBurnInErrorMinute,proto3" json:"first_burn_in_error_minute,omitempty"`
+	LampStatus             LampModeStatus  `protobuf:"varint,3,opt,name=lamp_status,json=lampStatus,proto3,enum=viewsonic.v1.LampModeStatus" json:"lamp_status,omitempty"`
+	// viewsonic.LampModeErrorStatus, 0 = no error.
+	LampErrorStatus uint32 `protobuf:"varint,4,opt,name=lamp_error_status,json=lampErrorStatus,proto3" json:"lamp_error_status,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ErrorStatusResponse) Reset() {
+	*x = ErrorStatusResponse{}
+	mi := &file_viewsonic_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ErrorStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ErrorStatusResponse) ProtoMessage() {}
+
+func (x *ErrorStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_viewsonic_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ErrorStatusResponse.ProtoReflect.Descriptor instead.
+func (*ErrorStatusResponse) Descriptor() ([]byte, []int) {
+	return file_viewsonic_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ErrorStatusResponse) GetCounters() []*ErrorCounter {
+	if x != nil {
+		return x.Counters
+	}
+	return nil
+}
+
+func (x *ErrorStatusResponse) GetFirstBurnInErrorMinute() uint32 {
+	if x != nil {
+		return x.FirstBurnInErrorMinute
+	}
+	return 0
+}
+
+func (x *ErrorStatusResponse) GetLampStatus() LampModeStatus {
+	if x != nil {
+		return x.LampStatus
+	}
+	return LampModeStatus_LAMP_MODE_STATUS_STANDBY
+}
+
+func (x *ErrorStatusResponse) GetLampErrorStatus() uint32 {
+	if x != nil {
+		return x.LampErrorStatus
+	}
+	return 0
+}
+
+type TemperatureResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Temperature1  float32                `protobuf:"fixed32,1,opt,name=temperature1,proto3" json:"temperature1,omitempty"`
+	Temperature2  float32                `protobuf:"fixed32,2,opt,name=temperature2,proto3" json:"temperature2,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TemperatureResponse) Reset() {
+	*x = TemperatureResponse{}
+	mi := &file_viewsonic_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TemperatureResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TemperatureResponse) ProtoMessage() {}
+
+func (x *TemperatureResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_viewsonic_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TemperatureResponse.ProtoReflect.Descriptor instead.
+func (*TemperatureResponse) Descriptor() ([]byte, []int) {
+	return file_viewsonic_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *TemperatureResponse) GetTemperature1() float32 {
+	if x != nil {
+		return x.Temperature1
+	}
+	return 0
+}
+
+func (x *TemperatureResponse) GetTemperature2() float32 {
+	if x != nil {
+		return x.Temperature2
+	}
+	return 0
+}
+
+type UsageTimeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Hours         uint32                 `protobuf:"varint,1,opt,name=hours,proto3" json:"hours,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UsageTimeResponse) Reset() {
+	*x = UsageTimeResponse{}
+	mi := &file_viewsonic_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UsageTimeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UsageTimeResponse) ProtoMessage() {}
+
+func (x *UsageTimeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_viewsonic_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UsageTimeResponse.ProtoReflect.Descriptor instead.
+func (*UsageTimeResponse) Descriptor() ([]byte, []int) {
+	return file_viewsonic_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *UsageTimeResponse) GetHours() uint32 {
+	if x != nil {
+		return x.Hours
+	}
+	return 0
+}
+
+type WatchRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Empty projectors or fields watch everything.
+	Projectors []string `protobuf:"bytes,1,rep,name=projectors,proto3" json:"projectors,omitempty"`
+	// Names of viewsonic.StateField, e.g. "power" or "temp1".
+	Fields        []string `protobuf:"bytes,2,rep,name=fields,proto3" json:"fields,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchRequest) Reset() {
+	*x = WatchRequest{}
+	mi := &file_viewsonic_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchRequest) ProtoMessage() {}
+
+func (x *WatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_viewsonic_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchRequest.ProtoReflect.Descriptor instead.
+func (*WatchRequest) Descriptor() ([]byte, []int) {
+	return file_viewsonic_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *WatchRequest) GetProjectors() []string {
+	if x != nil {
+		return x.Projectors
+	}
+	return nil
+}
+
+func (x *WatchRequest) GetFields() []string {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+type StateChange struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Projector string                 `protobuf:"bytes,1,opt,name=projector,proto3" json:"projector,omitempty"`
+	Field     string                 `protobuf:"bytes,2,opt,name=field,proto3" json:"field,omitempty"`
+	Time      *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=time,proto3" json:"time,omitempty"`
+	// Unset if the function is disabled on the projector.
+	//
+	// Types that are valid to be assigned to Value:
+	//
+	//	*StateChange_BoolValue
+	//	*StateChange_IntValue
+	//	*StateChange_FloatValue
+	//	*StateChange_Status
+	//	*StateChange_Source
+	Value         isStateChange_Value `protobuf_oneof:"value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StateChange) Reset() {
+	*x = StateChange{}
+	mi := &file_viewsonic_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StateChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StateChange) ProtoMessage() {}
+
+func (x *StateChange) ProtoReflect() protoreflect.Message {
+	mi := &file_viewsonic_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StateChange.ProtoReflect.Descriptor instead.
+func (*StateChange) Descriptor() ([]byte, []int) {
+	return file_viewsonic_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *StateChange) GetProjector() string {
+	if x != nil {
+		return x.Projector
+	}
+	return ""
+}
+
+func (x *StateChange) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *StateChange) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+func (x *StateChange) GetValue() isStateChange_Value {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+func (x *StateChange) GetBoolValue() bool {
+	if x != nil {
+		if x, ok := x.Value.(*StateChange_BoolValue); ok {
+			return x.BoolValue
+		}
+	}
+	return false
+}
+
+func (x *StateChange) GetIntValue() int32 {
+	if x != nil {
+		if x, ok := x.Value.(*StateChange_IntValue); ok {
+			return x.IntValue
+		}
+	}
+	return 0
+}
+
+func (x *StateChange) GetFloatValue() float32 {
+	if x != nil {
+		if x, ok := x.Value.(*StateChange_FloatValue); ok {
+			return x.FloatValue
+		}
+	}
+	return 0
+}
+
+func (x *StateChange) GetStatus() ProjectorStatus {
+	if x != nil {
+		if x, ok := x.Value.(*StateChange_Status); ok {
+			return x.Status
+		}
+	}
+	return ProjectorStatus_PROJECTOR_STATUS_POWER_OFF
+}
+
+func (x *StateChange) GetSource() SourceInput {
+	if x != nil {
+		if x, ok := x.Value.(*StateChange_Source); ok {
+			return x.Source
+		}
+	}
+	return SourceInput_SOURCE_INPUT_D_SUB1
+}
+
+type isStateChange_Value interface {
+	isStateChange_Value()
+}
+
+type StateChange_BoolValue struct {
+	BoolValue bool `protobuf:"varint,4,opt,name=bool_value,json=boolValue,proto3,oneof"`
+}
+
+type StateChange_IntValue struct {
+	IntValue int32 `protobuf:"varint,5,opt,name=int_value,json=intValue,proto3,oneof"`
+}
+
+type StateChange_FloatValue struct {
+	FloatValue float32 `protobuf:"fixed32,6,opt,name=float_value,json=floatValue,proto3,oneof"`
+}
+
+type StateChange_Status struct {
+	Status ProjectorStatus `protobuf:"varint,7,opt,name=status,proto3,enum=viewsonic.v1.ProjectorStatus,oneof"`
+}
+
+type StateChange_Source struct {
+	Source SourceInput `protobuf:"varint,8,opt,name=source,proto3,enum=viewsonic.v1.SourceInput,oneof"`
+}
+
+func (*StateChange_BoolValue) isStateChange_Value() {}
+
+func (*StateChange_IntValue) isStateChange_Value() {}
+
+func (*StateChange_FloatValue) isStateChange_Value() {}
+
+func (*StateChange_Status) isStateChange_Value() {}
+
+func (*StateChange_Source) isStateChange_Value() {}
+
+type SetSourceInputRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Projector     string                 `protobuf:"bytes,1,opt,name=projector,proto3" json:"projector,omitempty"`
+	Input         SourceInput            `protobuf:"varint,2,opt,name=input,proto3,enum=viewsonic.v1.SourceInput" json:"input,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetSourceInputRequest) Reset() {
+	*x = SetSourceInputRequest{}
+	mi := &file_viewsonic_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetSourceInputRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetSourceInputRequest) ProtoMessage() {}
+
+func (x *SetSourceInputRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_viewsonic_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetSourceInputRequest.ProtoReflect.Descriptor instead.
+func (*SetSourceInputRequest) Descriptor() ([]byte, []int) {
+	return file_viewsonic_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *SetSourceInputRequest) GetProjector() string {
+	if x != nil {
+		return x.Projector
+	}
+	return ""
+}
+
+func (x *SetSourceInputRequest) GetInput() SourceInput {
+	if x != nil {
+		return x.Input
+	}
+	return SourceInput_SOURCE_INPUT_D_SUB1
+}
+
+type SourceInputResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Input         SourceInput            `protobuf:"varint,1,opt,name=input,proto3,enum=viewsonic.v1.SourceInput" json:"input,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SourceInputResponse) Reset() {
+	*x = SourceInputResponse{}
+	mi := &file_viewsonic_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SourceInputResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SourceInputResponse) ProtoMessage() {}
+
+func (x *SourceInputResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_viewsonic_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SourceInputResponse.ProtoReflect.Descriptor instead.
+func (*SourceInputResponse) Descriptor() ([]byte, []int) {
+	return file_viewsonic_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *SourceInputResponse) GetInput() SourceInput {
+	if x != nil {
+		return x.Input
+	}
+	return SourceInput_SOURCE_INPUT_D_SUB1
+}
+
+type SetHdmiFormatRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Projector     string                 `protobuf:"bytes,1,opt,name=projector,proto3" json:"projector,omitempty"`
+	Format        HdmiFormat             `protobuf:"varint,2,opt,name=format,proto3,enum=viewsonic.v1.HdmiFormat" json:"format,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetHdmiFormatRequest) Reset() {
+	*x = SetHdmiFormatRequest{}
+	mi := &file_viewsonic_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetHdmiFormatRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetHdmiFormatRequest) ProtoMessage() {}
+
+func (x *SetHdmiFormatRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_viewsonic_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetHdmiFormatRequest.ProtoReflect.Descriptor instead.
+func (*SetHdmiFormatRequest) Descriptor() ([]byte, []int) {
+	return file_viewsonic_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *SetHdmiFormatRequest) GetProjector() string {
+	if x != nil {
+		return x.Projector
+	}
+	return ""
+}
+
+func (x *SetHdmiFormatRequest) GetFormat() HdmiFormat {
+	if x != nil {
+		return x.Format
+	}
+	return HdmiFormat_HDMI_FORMAT_RGB
+}
+
+type HdmiFormatResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Format        HdmiFormat             `protobuf:"varint,1,opt,name=format,proto3,enum=viewsonic.v1.HdmiFormat" json:"format,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HdmiFormatResponse) Reset() {
+	*x = HdmiFormatResponse{}
+	mi := &file_viewsonic_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HdmiFormatResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HdmiFormatResponse) ProtoMessage() {}
+
+func (x *HdmiFormatResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_viewsonic_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HdmiFormatResponse.ProtoReflect.Descriptor instead.
+func (*HdmiFormatResponse) Descriptor() ([]byte, []int) {
+	return file_viewsonic_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *HdmiFormatResponse) GetFormat() HdmiFormat {
+	if x != nil {
+		return x.Format
+	}
+	return HdmiFormat_HDMI_FORMAT_RGB
+}
+
+type SetHdmiRangeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Projector     string                 `protobuf:"bytes,1,opt,name=projector,proto3" json:"projector,omitempty"`
+	Range         HdmiRange              `protobuf:"varint,2,opt,name=range,proto3,enum=viewsonic.v1.HdmiRange" json:"range,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetHdmiRangeRequest) Reset() {
+	*x = SetHdmiRangeRequest{}
+	mi := &file_viewsonic_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetHdmiRangeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetHdmiRangeRequest) ProtoMessage() {}
+
+func (x *SetHdmiRangeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_viewsonic_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetHdmiRangeRequest.ProtoReflect.Descriptor instead.
+func (*SetHdmiRangeRequest) Descriptor() ([]byte, []int) {
+	return file_viewsonic_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *SetHdmiRangeRequest) GetProjector() string {
+	if x != nil {
+		return x.Projector
+	}
+	return ""
+}
+
+func (x *SetHdmiRangeRequest) GetRange() HdmiRange {
+	if x != nil {
+		return x.Range
+	}
+	return HdmiRange_HDMI_RANGE_ENHANCED
+}
+
+type HdmiRangeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Range         HdmiRange              `protobuf:"varint,1,opt,name=range,proto3,enum=viewsonic.v1.HdmiRange" json:"range,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HdmiRangeResponse) Reset() {
+	*x = HdmiRangeResponse{}
+	mi := &file_viewsonic_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HdmiRangeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HdmiRangeResponse) ProtoMessage() {}
+
+func (x *HdmiRangeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_viewsonic_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HdmiRangeResponse.ProtoReflect.Descriptor instead.
+func (*HdmiRangeResponse) Descriptor() ([]byte, []int) {
+	return file_viewsonic_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *HdmiRangeResponse) GetRange() HdmiRange {
+	if x != nil {
+		return x.Range
+	}
+	return HdmiRange_HDMI_RANGE_ENHANCED
+}
+
+type SetSplashScreenRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Projector     string                 `protobuf:"bytes,1,opt,name=projector,proto3" json:"projector,omitempty"`
+	Screen        SplashScreen           `protobuf:"varint,2,opt,name=screen,proto3,enum=viewsonic.v1.SplashScreen" json:"screen,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetSplashScreenRequest) Reset() {
+	*x = SetSplashScreenRequest{}
+	mi := &file_viewsonic_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetSplashScreenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetSplashScreenRequest) ProtoMessage() {}
+
+func (x *SetSplashScreenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_viewsonic_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetSplashScreenRequest.ProtoReflect.Descriptor instead.
+func (*SetSplashScreenRequest) Descriptor() ([]byte, []int) {
+	return file_viewsonic_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *SetSplashScreenRequest) GetProjector() string {
+	if x != nil {
+		return x.Projector
+	}
+	return ""
+}
+
+func (x *SetSplashScreenRequest) GetScreen() SplashScreen {
+	if x != nil {
+		return x.Screen
+	}
+	return SplashScreen_SPLASH_SCREEN_BLACK
+}
+
+type SplashScreenResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Screen        SplashScreen           `protobuf:"varint,1,opt,name=screen,proto3,enum=viewsonic.v1.SplashScreen" json:"screen,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SplashScreenResponse) Reset() {
+	*x = SplashScreenResponse{}
+	mi := &file_viewsonic_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SplashScreenResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SplashScreenResponse) ProtoMessage() {}
+
+func (x *SplashScreenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_viewsonic_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SplashScreenResponse.ProtoReflect.Descriptor instead.
+func (*SplashScreenResponse) Descriptor() ([]byte, []int) {
+	return file_viewsonic_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *SplashScreenResponse) GetScreen() SplashScreen {
+	if x != nil {
+		return x.Screen
+	}
+	return SplashScreen_SPLASH_SCREEN_BLACK
+}
+
+type SetProjectorPositionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Projector     string                 `protobuf:"bytes,1,opt,name=projector,proto3" json:"projector,omitempty"`
+	Position      ProjectorPosition      `protobuf:"varint,2,opt,name=position,proto3,enum=viewsonic.v1.ProjectorPosition" json:"position,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetProjectorPositionRequest) Reset() {
+	*x = SetProjectorPositionRequest{}
+	mi := &file_viewsonic_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetProjectorPositionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetProjectorPositionRequest) ProtoMessage() {}
+
+func (x *SetProjectorPositionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_viewsonic_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetProjectorPositionRequest.ProtoReflect.Descriptor instead.
+func (*SetProjectorPositionRequest) Descriptor() ([]byte, []int) {
+	return file_viewsonic_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *SetProjectorPositionRequest) GetProjector() string {
+	if x != nil {
+		return x.Projector
+	}
+	return ""
+}
+
+func (x *SetProjectorPositionRequest) GetPosition() ProjectorPosition {
+	if x != nil {
+		return x.Position
+	}
+	return ProjectorPosition_PROJECTOR_POSITION_FRONT_TABLE
+}
+
+type ProjectorPositionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Position      ProjectorPosition      `protobuf:"varint,1,opt,name=position,proto3,enum=viewsonic.v1.ProjectorPosition" json:"position,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProjectorPositionResponse) Reset() {
+	*x = ProjectorPositionResponse{}
+	mi := &file_viewsonic_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProjectorPositionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProjectorPositionResponse) ProtoMessage() {}
+
+func (x *ProjectorPositionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_viewsonic_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProjectorPositionResponse.ProtoReflect.Descriptor instead.
+func (*ProjectorPositionResponse) Descriptor() ([]byte, []int) {
+	return file_viewsonic_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ProjectorPositionResponse) GetPosition() ProjectorPosition {
+	if x != nil {
+		return x.Position
+	}
+	return ProjectorPosition_PROJECTOR_POSITION_FRONT_TABLE
+}
+
+type SetAspectRatioRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Projector     string                 `protobuf:"bytes,1,opt,name=projector,proto3" json:"projector,omitempty"`
+	Ratio         AspectRatio            `protobuf:"varint,2,opt,name=ratio,proto3,enum=viewsonic.v1.AspectRatio" json:"ratio,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetAspectRatioRequest) Reset() {
+	*x = SetAspectRatioRequest{}
+	mi := &file_viewsonic_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetAspectRatioRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetAspectRatioRequest) ProtoMessage() {}
+
+func (x *SetAspectRatioRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_viewsonic_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetAspectRatioRequest.ProtoReflect.Descriptor instead.
+func (*SetAspectRatioRequest) Descriptor() ([]byte, []int) {
+	return file_viewsonic_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *SetAspectRatioRequest) GetProjector() string {
+	if x != nil {
+		return x.Projector
+	}
+	return ""
+}
+
+func (x *SetAspectRatioRequest) GetRatio() AspectRatio {
+	if x != nil {
+		return x.Ratio
+	}
+	return AspectRatio_ASPECT_RATIO_AUTO
+}
+
+type AspectRatioResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ratio         AspectRatio            `protobuf:"varint,1,opt,name=ratio,proto3,enum=viewsonic.v1.AspectRatio" json:"ratio,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AspectRatioResponse) Reset() {
+	*x = AspectRatioResponse{}
+	mi := &file_viewsonic_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AspectRatioResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AspectRatioResponse) ProtoMessage() {}
+
+func (x *AspectRatioResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_viewsonic_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AspectRatioResponse.ProtoReflect.Descriptor instead.
+func (*AspectRatioResponse) Descriptor() ([]byte, []int) {
+	return file_viewsonic_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *AspectRatioResponse) GetRatio() AspectRatio {
+	if x != nil {
+		return x.Ratio
+	}
+	return AspectRatio_ASPECT_RATIO_AUTO
+}
+
+type SetThreeDSyncModeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Projector     string                 `protobuf:"bytes,1,opt,name=projector,proto3" json:"projector,omitempty"`
+	Mode          ThreeDSyncMode         `protobuf:"varint,2,opt,name=mode,proto3,enum=viewsonic.v1.ThreeDSyncMode" json:"mode,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetThreeDSyncModeRequest) Reset() {
+	*x = SetThreeDSyncModeRequest{}
+	mi := &file_viewsonic_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetThreeDSyncModeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetThreeDSyncModeRequest) ProtoMessage() {}
+
+func (x *SetThreeDSyncModeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_viewsonic_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetThreeDSyncModeRequest.ProtoReflect.Descriptor instead.
+func (*SetThreeDSyncModeRequest) Descriptor() ([]byte, []int) {
+	return file_viewsonic_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *SetThreeDSyncModeRequest) GetProjector() string {
+	if x != nil {
+		return x.Projector
+	}
+	return ""
+}
+
+func (x *SetThreeDSyncModeRequest) GetMode() ThreeDSyncMode {
+	if x != nil {
+		return x.Mode
+	}
+	return ThreeDSyncMode_THREE_D_SYNC_MODE_OFF
+}
+
+type ThreeDSyncModeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Mode          ThreeDSyncMode         `protobuf:"varint,1,opt,name=mode,proto3,enum=viewsonic.v1.ThreeDSyncMode" json:"mode,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ThreeDSyncModeResponse) Reset() {
+	*x = ThreeDSyncModeResponse{}
+	mi := &file_viewsonic_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ThreeDSyncModeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ThreeDSyncModeResponse) ProtoMessage() {}
+
+func (x *ThreeDSyncModeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_viewsonic_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ThreeDSyncModeResponse.ProtoReflect.Descriptor instead.
+func (*ThreeDSyncModeResponse) Descriptor() ([]byte, []int) {
+	return file_viewsonic_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ThreeDSyncModeResponse) GetMode() ThreeDSyncMode {
+	if x != nil {
+		return x.Mode
+	}
+	return ThreeDSyncMode_THREE_D_SYNC_MODE_OFF
+}
+
+type SetColorTemperatureRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Projector     string                 `protobuf:"bytes,1,opt,name=projector,proto3" json:"projector,omitempty"`
+	Temperature   ColorTemperature       `protobuf:"varint,2,opt,name=temperature,proto3,enum=viewsonic.v1.ColorTemperature" json:"temperature,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetColorTemperatureRequest) Reset() {
+	*x = SetColorTemperatureRequest{}
+	mi := &file_viewsonic_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetColorTemperatureRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetColorTemperatureRequest) ProtoMessage() {}
+
+func (x *SetColorTemperatureRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_viewsonic_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetColorTemperatureRequest.ProtoReflect.Descriptor instead.
+func (*SetColorTemperatureRequest) Descriptor() ([]byte, []int) {
+	return file_viewsonic_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *SetColorTemperatureRequest) GetProjector() string {
+	if x != nil {
+		return x.Projector
+	}
+	return ""
+}
+
+func (x *SetColorTemperatureRequest) GetTemperature() ColorTemperature {
+	if x != nil {
+		return x.Temperature
+	}
+	return ColorTemperature_COLOR_TEMPERATURE_WARM
+}
+
+type ColorTemperatureResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Temperature   ColorTemperature       `protobuf:"varint,1,opt,name=temperature,proto3,enum=viewsonic.v1.ColorTemperature" json:"temperature,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ColorTemperatureResponse) Reset() {
+	*x = ColorTemperatureResponse{}
+	mi := &file_viewsonic_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ColorTemperatureResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ColorTemperatureResponse) ProtoMessage() {}
+
+func (x *ColorTemperatureResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_viewsonic_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ColorTemperatureResponse.ProtoReflect.Descriptor instead.
+func (*ColorTemperatureResponse) Descriptor() ([]byte, []int) {
+	return file_viewsonic_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ColorTemperatureResponse) GetTemperature() ColorTemperature {
+	if x != nil {
+		return x.Temperature
+	}
+	return ColorTemperature_COLOR_TEMPERATURE_WARM
+}
+
+type SetColorModeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Projector     string                 `protobuf:"bytes,1,opt,name=projector,proto3" json:"projector,omitempty"`
+	Mode          ColorMode              `protobuf:"varint,2,opt,name=mode,proto3,enum=viewsonic.v1.ColorMode" json:"mode,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetColorModeRequest) Reset() {
+	*x = SetColorModeRequest{}
+	mi := &file_viewsonic_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetColorModeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetColorModeRequest) ProtoMessage() {}
+
+func (x *SetColorModeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_viewsonic_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetColorModeRequest.ProtoReflect.Descriptor instead.
+func (*SetColorModeRequest) Descriptor() ([]byte, []int) {
+	return file_viewsonic_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *SetColorModeRequest) GetProjector() string {
+	if x != nil {
+		return x.Projector
+	}
+	return ""
+}
+
+func (x *SetColorModeRequest) GetMode() ColorMode {
+	if x != nil {
+		return x.Mode
+	}
+	return ColorMode_COLOR_MODE_BRIGHTEST
+}
+
+type ColorModeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Mode          ColorMode              `protobuf:"varint,1,opt,name=mode,proto3,enum=viewsonic.v1.ColorMode" json:"mode,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ColorModeResponse) Reset() {
+	*x = ColorModeResponse{}
+	mi := &file_viewsonic_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ColorModeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ColorModeResponse) ProtoMessage() {}
+
+func (x *ColorModeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_viewsonic_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ColorModeResponse.ProtoReflect.Descriptor instead.
+func (*ColorModeResponse) Descriptor() ([]byte, []int) {
+	return file_viewsonic_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ColorModeResponse) GetMode() ColorMode {
+	if x != nil {
+		return x.Mode
+	}
+	return ColorMode_COLOR_MODE_BRIGHTEST
+}
+
+type SelectPrimaryColorRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Projector     string                 `protobuf:"bytes,1,opt,name=projector,proto3" json:"projector,omitempty"`
+	Color         PrimaryColor           `protobuf:"varint,2,opt,name=color,proto3,enum=viewsonic.v1.PrimaryColor" json:"color,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SelectPrimaryColorRequest) Reset() {
+	*x = SelectPrimaryColorRequest{}
+	mi := &file_viewsonic_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SelectPrimaryColorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SelectPrimaryColorRequest) ProtoMessage() {}
+
+func (x *SelectPrimaryColorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_viewsonic_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SelectPrimaryColorRequest.ProtoReflect.Descriptor instead.
+func (*SelectPrimaryColorRequest) Descriptor() ([]byte, []int) {
+	return file_viewsonic_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *SelectPrimaryColorRequest) GetProjector() string {
+	if x != nil {
+		return x.Projector
+	}
+	return ""
+}
+
+func (x *SelectPrimaryColorRequest) GetColor() PrimaryColor {
+	if x != nil {
+		return x.Color
+	}
+	return PrimaryColor_PRIMARY_COLOR_R
+}
+
+type PrimaryColorResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Color         PrimaryColor           `protobuf:"varint,1,opt,name=color,proto3,enum=viewsonic.v1.PrimaryColor" json:"color,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PrimaryColorResponse) Reset() {
+	*x = PrimaryColorResponse{}
+	mi := &file_viewsonic_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PrimaryColorResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PrimaryColorResponse) ProtoMessage() {}
+
+func (x *PrimaryColorResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_viewsonic_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PrimaryColorResponse.ProtoReflect.Descriptor instead.
+func (*PrimaryColorResponse) Descriptor() ([]byte, []int) {
+	return file_viewsonic_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *PrimaryColorResponse) GetColor() PrimaryColor {
+	if x != nil {
+		return x.Color
+	}
+	return PrimaryColor_PRIMARY_COLOR_R
+}
+
+type SetScreenColorRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Projector     string                 `protobuf:"bytes,1,opt,name=projector,proto3" json:"projector,omitempty"`
+	Color         ScreenColor            `protobuf:"varint,2,opt,name=color,proto3,enum=viewsonic.v1.ScreenColor" json:"color,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetScreenColorRequest) Reset() {
+	*x = SetScreenColorRequest{}
+	mi := &file_viewsonic_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetScreenColorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetScreenColorRequest) ProtoMessage() {}
+
+func (x *SetScreenColorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_viewsonic_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetScreenColorRequest.ProtoReflect.Descriptor instead.
+func (*SetScreenColorRequest) Descriptor() ([]byte, []int) {
+	return file_viewsonic_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *SetScreenColorRequest) GetProjector() string {
+	if x != nil {
+		return x.Projector
+	}
+	return ""
+}
+
+func (x *SetScreenColorRequest) GetColor() ScreenColor {
+	if x != nil {
+		return x.Color
+	}
+	return ScreenColor_SCREEN_COLOR_OFF
+}
+
+type ScreenColorResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Color         ScreenColor            `protobuf:"varint,1,opt,name=color,proto3,enum=viewsonic.v1.ScreenColor" json:"color,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScreenColorResponse) Reset() {
+	*x = ScreenColorResponse{}
+	mi := &file_viewsonic_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScreenColorResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScreenColorResponse) ProtoMessage() {}
+
+func (x *ScreenColorResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_viewsonic_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScreenColorResponse.ProtoReflect.Descriptor instead.
+func (*ScreenColorResponse) Descriptor() ([]byte, []int) {
+	return file_viewsonic_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ScreenColorResponse) GetColor() ScreenColor {
+	if x != nil {
+		return x.Color
+	}
+	return ScreenColor_SCREEN_COLOR_OFF
+}
+
+type SetLanguageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Projector     string                 `protobuf:"bytes,1,opt,name=projector,proto3" json:"projector,omitempty"`
+	Language      Language               `protobuf:"varint,2,opt,name=language,proto3,enum=viewsonic.v1.Language" json:"language,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetLanguageRequest) Reset() {
+	*x = SetLanguageRequest{}
+	mi := &file_viewsonic_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetLanguageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetLanguageRequest) ProtoMessage() {}
+
+func (x *SetLanguageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_viewsonic_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetLanguageRequest.ProtoReflect.Descriptor instead.
+func (*SetLanguageRequest) Descriptor() ([]byte, []int) {
+	return file_viewsonic_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *SetLanguageRequest) GetProjector() string {
+	if x != nil {
+		return x.Projector
+	}
+	return ""
+}
+
+func (x *SetLanguageRequest) GetLanguage() Language {
+	if x != nil {
+		return x.Language
+	}
+	return Language_LANGUAGE_ENGLISH
+}
+
+type LanguageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Language      Language               `protobuf:"varint,1,opt,name=language,proto3,enum=viewsonic.v1.Language" json:"language,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LanguageResponse) Reset() {
+	*x = LanguageResponse{}
+	mi := &file_viewsonic_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LanguageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LanguageResponse) ProtoMessage() {}
+
+func (x *LanguageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_viewsonic_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LanguageResponse.ProtoReflect.Descriptor instead.
+func (*LanguageResponse) Descriptor() ([]byte, []int) {
+	return file_viewsonic_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *LanguageResponse) GetLanguage() Language {
+	if x != nil {
+		return x.Language
+	}
+	return Language_LANGUAGE_ENGLISH
+}
+
+type SendRemoteKeyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Projector     string                 `protobuf:"bytes,1,opt,name=projector,proto3" json:"projector,omitempty"`
+	Key           RemoteKey              `protobuf:"varint,2,opt,name=key,proto3,enum=viewsonic.v1.RemoteKey" json:"key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendRemoteKeyRequest) Reset() {
+	*x = SendRemoteKeyRequest{}
+	mi := &file_viewsonic_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendRemoteKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendRemoteKeyRequest) ProtoMessage() {}
+
+func (x *SendRemoteKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_viewsonic_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendRemoteKeyRequest.ProtoReflect.Descriptor instead.
+func (*SendRemoteKeyRequest) Descriptor() ([]byte, []int) {
+	return file_viewsonic_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *SendRemoteKeyRequest) GetProjector() string {
+	if x != nil {
+		return x.Projector
+	}
+	return ""
+}
+
+func (x *SendRemoteKeyRequest) GetKey() RemoteKey {
+	if x != nil {
+		return x.Key
+	}
+	return RemoteKey_REMOTE_KEY_UNSPECIFIED
+}
+
+type SetLightSourceModeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Projector     string                 `protobuf:"bytes,1,opt,name=projector,proto3" json:"projector,omitempty"`
+	Mode          LightSourceMode        `protobuf:"varint,2,opt,name=mode,proto3,enum=viewsonic.v1.LightSourceMode" json:"mode,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetLightSourceModeRequest) Reset() {
+	*x = SetLightSourceModeRequest{}
+	mi := &file_viewsonic_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetLightSourceModeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetLightSourceModeRequest) ProtoMessage() {}
+
+func (x *SetLightSourceModeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_viewsonic_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetLightSourceModeRequest.ProtoReflect.Descriptor instead.
+func (*SetLightSourceModeRequest) Descriptor() ([]byte, []int) {
+	return file_viewsonic_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *SetLightSourceModeRequest) GetProjector() string {
+	if x != nil {
+		return x.Projector
+	}
+	return ""
+}
+
+func (x *SetLightSourceModeRequest) GetMode() LightSourceMode {
+	if x != nil {
+		return x.Mode
+	}
+	return LightSourceMode_LIGHT_SOURCE_MODE_NORMAL
+}
+
+type LightSourceModeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Mode          LightSourceMode        `protobuf:"varint,1,opt,name=mode,proto3,enum=viewsonic.v1.LightSourceMode" json:"mode,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LightSourceModeResponse) Reset() {
+	*x = LightSourceModeResponse{}
+	mi := &file_viewsonic_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LightSourceModeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LightSourceModeResponse) ProtoMessage() {}
+
+func (x *LightSourceModeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_viewsonic_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LightSourceModeResponse.ProtoReflect.Descriptor instead.
+func (*LightSourceModeResponse) Descriptor() ([]byte, []int) {
+	return file_viewsonic_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *LightSourceModeResponse) GetMode() LightSourceMode {
+	if x != nil {
+		return x.Mode
+	}
+	return LightSourceMode_LIGHT_SOURCE_MODE_NORMAL
+}
+
+var File_viewsonic_proto protoreflect.FileDescriptor
+
+const file_viewsonic_proto_rawDesc = "" +
+	"\n" +
+	"\x0fviewsonic.proto\x12\fviewsonic.v1\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"0\n" +
+	"\x10ProjectorRequest\x12\x1c\n" +
+	"\tprojector\x18\x01 \x01(\tR\tprojector\"D\n" +
+	"\x0eSetBoolRequest\x12\x1c\n" +
+	"\tprojector\x18\x01 \x01(\tR\tprojector\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\bR\x05value\"$\n" +
+	"\fBoolResponse\x12\x14\n" +
+	"\x05value\x18\x01 \x01(\bR\x05value\"E\n" +
+	"\x0fSetInt32Request\x12\x1c\n" +
+	"\tprojector\x18\x01 \x01(\tR\tprojector\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x05R\x05value\"%\n" +
+	"\rInt32Response\x12\x14\n" +
+	"\x05value\x18\x01 \x01(\x05R\x05value\"_\n" +
+	"\x0fSetPowerRequest\x12\x1c\n" +
+	"\tprojector\x18\x01 \x01(\tR\tprojector\x12.\n" +
+	"\x05state\x18\x02 \x01(\x0e2\x18.viewsonic.v1.PowerStateR\x05state\"?\n" +
+	"\rPowerResponse\x12.\n" +
+	"\x05state\x18\x01 \x01(\x0e2\x18.viewsonic.v1.PowerStateR\x05state\"P\n" +
+	"\x17ProjectorStatusResponse\x125\n" +
+	"\x06status\x18\x01 \x01(\x0e2\x1d.viewsonic.v1.ProjectorStatusR\x06status\"T\n" +
+	"\fErrorCounter\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1a\n" +
+	"\bcategory\x18\x02 \x01(\tR\bcategory\x12\x14\n" +
+	"\x05value\x18\x03 \x01(\rR\x05value\"\xf4\x01\n" +
+	"\x13ErrorStatusResponse\x126\n" +
+	"\bcounters\x18\x01 \x03(\v2\x1a.viewsonic.v1.ErrorCounterR\bcounters\x12:\n" +
+	"\x1afirst_burn_in_error_minute\x18\x02 \x01(\rR\x16firstBurnInErrorMinute\x12=\n" +
+	"\vlamp_status\x18\x03 \x01(\x0e2\x1c.viewsonic.v1.LampModeStatusR\n" +
+	"lampStatus\x12*\n" +
+	"\x11lamp_error_status\x18\x04 \x01(\rR\x0flampErrorStatus\"]\n" +
+	"\x13TemperatureResponse\x12\"\n" +
+	"\ftemperature1\x18\x01 \x01(\x02R\ftemperature1\x12\"\n" +
+	"\ftemperature2\x18\x02 \x01(\x02R\ftemperature2\")\n" +
+	"\x11UsageTimeResponse\x12\x14\n" +
+	"\x05hours\x18\x01 \x01(\rR\x05hours\"F\n" +
+	"\fWatchRequest\x12\x1e\n" +
+	"\n" +
+	"projectors\x18\x01 \x03(\tR\n" +
+	"projectors\x12\x16\n" +
+	"\x06fields\x18\x02 \x03(\tR\x06fields\"\xcb\x02\n" +
+	"\vStateChange\x12\x1c\n" +
+	"\tprojector\x18\x01 \x01(\tR\tprojector\x12\x14\n" +
+	"\x05field\x18\x02 \x01(\tR\x05field\x12.\n" +
+	"\x04time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x04time\x12\x1f\n" +
+	"\n" +
+	"bool_value\x18\x04 \x01(\bH\x00R\tboolValue\x12\x1d\n" +
+	"\tint_value\x18\x05 \x01(\x05H\x00R\bintValue\x12!\n" +
+	"\vfloat_value\x18\x06 \x01(\x02H\x00R\n" +
+	"floatValue\x127\n" +
+	"\x06status\x18\a \x01(\x0e2\x1d.viewsonic.v1.ProjectorStatusH\x00R\x06status\x123\n" +
+	"\x06source\x18\b \x01(\x0e2\x19.viewsonic.v1.SourceInputH\x00R\x06sourceB\a\n" +
+	"\x05value\"f\n" +
+	"\x15SetSourceInputRequest\x12\x1c\n" +
+	"\tprojector\x18\x01 \x01(\tR\tprojector\x12/\n" +
+	"\x05input\x18\x02 \x01(\x0e2\x19.viewsonic.v1.SourceInputR\x05input\"F\n" +
+	"\x13SourceInputResponse\x12/\n" +
+	"\x05input\x18\x01 \x01(\x0e2\x19.viewsonic.v1.SourceInputR\x05input\"f\n" +
+	"\x14SetHdmiFormatRequest\x12\x1c\n" +
+	"\tprojector\x18\x01 \x01(\tR\tprojector\x120\n" +
+	"\x06format\x18\x02 \x01(\x0e2\x18.viewsonic.v1.HdmiFormatR\x06format\"F\n" +
+	"\x12HdmiFormatResponse\x120\n" +
+	"\x06format\x18\x01 \x01(\x0e2\x18.viewsonic.v1.HdmiFormatR\x06format\"b\n" +
+	"\x13SetHdmiRangeRequest\x12\x1c\n" +
+	"\tprojector\x18\x01 \x01(\tR\tprojector\x12-\n" +
+	"\x05range\x18\x02 \x01(\x0e2\x17.viewsonic.v1.HdmiRangeR\x05range\"B\n" +
+	"\x11HdmiRangeResponse\x12-\n" +
+	"\x05range\x18\x01 \x01(\x0e2\x17.viewsonic.v1.HdmiRangeR\x05range\"j\n" +
+	"\x16SetSplashScreenRequest\x12\x1c\n" +
+	"\tprojector\x18\x01 \x01(\tR\tprojector\x122\n" +
+	"\x06screen\x18\x02 \x01(\x0e2\x1a.viewsonic.v1.SplashScreenR\x06screen\"J\n" +
+	"\x14SplashScreenResponse\x122\n" +
+	"\x06screen\x18\x01 \x01(\x0e2\x1a.viewsonic.v1.SplashScreenR\x06screen\"x\n" +
+	"\x1bSetProjectorPositionRequest\x12\x1c\n" +
+	"\tprojector\x18\x01 \x01(\tR\tprojector\x12;\n" +
+	"\bposition\x18\x02 \x01(\x0e2\x1f.viewsonic.v1.ProjectorPositionR\bposition\"X\n" +
+	"\x19ProjectorPositionResponse\x12;\n" +
+	"\bposition\x18\x01 \x01(\x0e2\x1f.viewsonic.v1.ProjectorPositionR\bposition\"f\n" +
+	"\x15SetAspectRatioRequest\x12\x1c\n" +
+	"\tprojector\x18\x01 \x01(\tR\tprojector\x12/\n" +
+	"\x05ratio\x18\x02 \x01(\x0e2\x19.viewsonic.v1.AspectRatioR\x05ratio\"F\n" +
+	"\x13AspectRatioResponse\x12/\n" +
+	"\x05ratio\x18\x01 \x01(\x0e2\x19.viewsonic.v1.AspectRatioR\x05ratio\"j\n" +
+	"\x18SetThreeDSyncModeRequest\x12\x1c\n" +
+	"\tprojector\x18\x01 \x01(\tR\tprojector\x120\n" +
+	"\x04mode\x18\x02 \x01(\x0e2\x1c.viewsonic.v1.ThreeDSyncModeR\x04mode\"J\n" +
+	"\x16ThreeDSyncModeResponse\x120\n" +
+	"\x04mode\x18\x01 \x01(\x0e2\x1c.viewsonic.v1.ThreeDSyncModeR\x04mode\"|\n" +
+	"\x1aSetColorTemperatureRequest\x12\x1c\n" +
+	"\tprojector\x18\x01 \x01(\tR\tprojector\x12@\n" +
+	"\vtemperature\x18\x02 \x01(\x0e2\x1e.viewsonic.v1.ColorTemperatureR\vtemperature\"\\\n" +
+	"\x18ColorTemperatureResponse\x12@\n" +
+	"\vtemperature\x18\x01 \x01(\x0e2\x1e.viewsonic.v1.ColorTemperatureR\vtemperature\"`\n" +
+	"\x13SetColorModeRequest\x12\x1c\n" +
+	"\tprojector\x18\x01 \x01(\tR\tprojector\x12+\n" +
+	"\x04mode\x18\x02 \x01(\x0e2\x17.viewsonic.v1.ColorModeR\x04mode\"@\n" +
+	"\x11ColorModeResponse\x12+\n" +
+	"\x04mode\x18\x01 \x01(\x0e2\x17.viewsonic.v1.ColorModeR\x04mode\"k\n" +
+	"\x19SelectPrimaryColorRequest\x12\x1c\n" +
+	"\tprojector\x18\x01 \x01(\tR\tprojector\x120\n" +
+	"\x05color\x18\x02 \x01(\x0e2\x1a.viewsonic.v1.PrimaryColorR\x05color\"H\n" +
+	"\x14PrimaryColorResponse\x120\n" +
+	"\x05color\x18\x01 \x01(\x0e2\x1a.viewsonic.v1.PrimaryColorR\x05color\"f\n" +
+	"\x15SetScreenColorRequest\x12\x1c\n" +
+	"\tprojector\x18\x01 \x01(\tR\tprojector\x12/\n" +
+	"\x05color\x18\x02 \x01(\x0e2\x19.viewsonic.v1.ScreenColorR\x05color\"F\n" +
+	"\x13ScreenColorResponse\x12/\n" +
+	"\x05color\x18\x01 \x01(\x0e2\x19.viewsonic.v1.ScreenColorR\x05color\"f\n" +
+	"\x12SetLanguageRequest\x12\x1c\n" +
+	"\tprojector\x18\x01 \x01(\tR\tprojector\x122\n" +
+	"\blanguage\x18\x02 \x01(\x0e2\x16.viewsonic.v1.LanguageR\blanguage\"F\n" +
+	"\x10LanguageResponse\x122\n" +
+	"\blanguage\x18\x01 \x01(\x0e2\x16.viewsonic.v1.LanguageR\blanguage\"_\n" +
+	"\x14SendRemoteKeyRequest\x12\x1c\n" +
+	"\tprojector\x18\x01 \x01(\tR\tprojector\x12)\n" +
+	"\x03key\x18\x02 \x01(\x0e2\x17.viewsonic.v1.RemoteKeyR\x03key\"l\n" +
+	"\x19SetLightSourceModeRequest\x12\x1c\n" +
+	"\tprojector\x18\x01 \x01(\tR\tprojector\x121\n" +
+	"\x04mode\x18\x02 \x01(\x0e2\x1d.viewsonic.v1.LightSourceModeR\x04mode\"L\n" +
+	"\x17LightSourceModeResponse\x121\n" +
+	"\x04mode\x18\x01 \x01(\x0e2\x1d.viewsonic.v1.LightSourceModeR\x04mode*5\n" +
+	"\n" +
+	"PowerState\x12\x13\n" +
+	"\x0fPOWER_STATE_OFF\x10\x00\x12\x12\n" +
+	"\x0ePOWER_STATE_ON\x10\x01*\x8e\x01\n" +
+	"\x0fProjectorStatus\x12\x1e\n" +
+	"\x1aPROJECTOR_STATUS_POWER_OFF\x10\x00\x12\x1c\n" +
+	"\x18PROJECTOR_STATUS_WARM_UP\x10\x01\x12\x1d\n" +
+	"\x19PROJECTOR_STATUS_POWER_ON\x10\x02\x12\x1e\n" +
+	"\x1aPROJECTOR_STATUS_COOL_DOWN\x10\x03*\x96\x02\n" +
+	"\x0eLampModeStatus\x12\x1c\n" +
+	"\x18LAMP_MODE_STATUS_STANDBY\x10\x00\x12\x1d\n" +
+	"\x19LAMP_MODE_STATUS_IGNITION\x10\x01\x12 \n" +
+	"\x1cLAMP_MODE_STATUS_LAMP_RUN_UP\x10\x04\x12\x1e\n" +
+	"\x1aLAMP_MODE_STATUS_COOL_DOWN\x10\x05\x12*\n" +
+	"&LAMP_MODE_STATUS_NORMAL_LAMP_OPERATION\x10\x06\x121\n" +
+	"-LAMP_MODE_STATUS_SHUTDOWN_UNRECOVERABLE_ERROR\x10\b\x12&\n" +
+	"\"LAMP_MODE_STATUS_PRE_HEATING_PHASE\x10\t*\x93\x03\n" +
+	"\vSourceInput\x12\x17\n" +
+	"\x13SOURCE_INPUT_D_SUB1\x10\x00\x12\x16\n" +
+	"\x12SOURCE_INPUT_HDMI1\x10\x03\x12\x1a\n" +
+	"\x16SOURCE_INPUT_COMPOSITE\x10\x05\x12\x18\n" +
+	"\x14SOURCE_INPUT_S_VIDEO\x10\x06\x12\x16\n" +
+	"\x12SOURCE_INPUT_HDMI2\x10\a\x12\x17\n" +
+	"\x13SOURCE_INPUT_D_SUB2\x10\b\x12\x16\n" +
+	"\x12SOURCE_INPUT_HDMI3\x10\t\x12\x14\n" +
+	"\x10SOURCE_INPUT_DVI\x10\n" +
+	"\x12\x1a\n" +
+	"\x16SOURCE_INPUT_COMPONENT\x10\v\x12\x18\n" +
+	"\x14SOURCE_INPUT_HDBASET\x10\f\x12\x1a\n" +
+	"\x16SOURCE_INPUT_HDMI_MHL4\x10\x0e\x12\x16\n" +
+	"\x12SOURCE_INPUT_USB_C\x10\x0f\x12\x1b\n" +
+	"\x17SOURCE_INPUT_USB_READER\x10\x1a\x12\x19\n" +
+	"\x15SOURCE_INPUT_LAN_WIFI\x10\x1b\x12\x1c\n" +
+	"\x18SOURCE_INPUT_USB_DISPLAY\x10\x1c*L\n" +
+	"\n" +
+	"HdmiFormat\x12\x13\n" +
+	"\x0fHDMI_FORMAT_RGB\x10\x00\x12\x13\n" +
+	"\x0fHDMI_FORMAT_YUV\x10\x01\x12\x14\n" +
+	"\x10HDMI_FORMAT_AUTO\x10\x02*P\n" +
+	"\tHdmiRange\x12\x17\n" +
+	"\x13HDMI_RANGE_ENHANCED\x10\x00\x12\x15\n" +
+	"\x11HDMI_RANGE_NORMAL\x10\x01\x12\x13\n" +
+	"\x0fHDMI_RANGE_AUTO\x10\x02*\x8e\x01\n" +
+	"\fSplashScreen\x12\x17\n" +
+	"\x13SPLASH_SCREEN_BLACK\x10\x00\x12\x16\n" +
+	"\x12SPLASH_SCREEN_BLUE\x10\x01\x12\x1b\n" +
+	"\x17SPLASH_SCREEN_VIEWSONIC\x10\x02\x12\x19\n" +
+	"\x15SPLASH_SCREEN_CAPTURE\x10\x03\x12\x15\n" +
+	"\x11SPLASH_SCREEN_OFF\x10\x04*\xa5\x01\n" +
+	"\x11ProjectorPosition\x12\"\n" +
+	"\x1ePROJECTOR_POSITION_FRONT_TABLE\x10\x00\x12!\n" +
+	"\x1dPROJECTOR_POSITION_REAR_TABLE\x10\x01\x12#\n" +
+	"\x1fPROJECTOR_POSITION_REAR_CEILING\x10\x02\x12$\n" +
+	" PROJECTOR_POSITION_FRONT_CEILING\x10\x03*\xf5\x01\n" +
+	"\vAspectRatio\x12\x15\n" +
+	"\x11ASPECT_RATIO_AUTO\x10\x00\x12\x17\n" +
+	"\x13ASPECT_RATIO_4_TO_3\x10\x02\x12\x18\n" +
+	"\x14ASPECT_RATIO_16_TO_9\x10\x03\x12\x19\n" +
+	"\x15ASPECT_RATIO_16_TO_10\x10\x04\x12\x1b\n" +
+	"\x17ASPECT_RATIO_ANAMORPHIC\x10\x05\x12\x15\n" +
+	"\x11ASPECT_RATIO_WIDE\x10\x06\x12\x19\n" +
+	"\x15ASPECT_RATIO_235_TO_1\x10\a\x12\x19\n" +
+	"\x15ASPECT_RATIO_PANORAMA\x10\b\x12\x17\n" +
+	"\x13ASPECT_RATIO_NATIVE\x10\t*\xda\x01\n" +
+	"\x0eThreeDSyncMode\x12\x19\n" +
+	"\x15THREE_D_SYNC_MODE_OFF\x10\x00\x12\x1a\n" +
+	"\x16THREE_D_SYNC_MODE_AUTO\x10\x01\x12&\n" +
+	"\"THREE_D_SYNC_MODE_FRAME_SEQUENTIAL\x10\x02\x12#\n" +
+	"\x1fTHREE_D_SYNC_MODE_FRAME_PACKING\x10\x03\x12 \n" +
+	"\x1cTHREE_D_SYNC_MODE_TOP_BOTTOM\x10\x04\x12\"\n" +
+	"\x1eTHREE_D_SYNC_MODE_SIDE_BY_SIDE\x10\x05*\x87\x01\n" +
+	"\x10ColorTemperature\x12\x1a\n" +
+	"\x16COLOR_TEMPERATURE_WARM\x10\x00\x12\x1c\n" +
+	"\x18COLOR_TEMPERATURE_NORMAL\x10\x01\x12\x1d\n" +
+	"\x19COLOR_TEMPERATURE_NEUTRAL\x10\x02\x12\x1a\n" +
+	"\x16COLOR_TEMPERATURE_COOL\x10\x03*\xe6\x02\n" +
+	"\tColorMode\x12\x18\n" +
+	"\x14COLOR_MODE_BRIGHTEST\x10\x00\x12\x14\n" +
+	"\x10COLOR_MODE_MOVIE\x10\x01\x12\x17\n" +
+	"\x13COLOR_MODE_STANDARD\x10\x04\x12\x1e\n" +
+	"\x1aCOLOR_MODE_SRGB_VIEW_MATCH\x10\x05\x12\x16\n" +
+	"\x12COLOR_MODE_DYNAMIC\x10\b\x12\x15\n" +
+	"\x11COLOR_MODE_REC709\x10\t\x12\x18\n" +
+	"\x14COLOR_MODE_DICOM_SIM\x10\n" +
+	"\x12\x15\n" +
+	"\x11COLOR_MODE_SPORTS\x10\x11\x12\x15\n" +
+	"\x11COLOR_MODE_GAMING\x10\x12\x12\x14\n" +
+	"\x10COLOR_MODE_PHOTO\x10\x13\x12\x1b\n" +
+	"\x17COLOR_MODE_PRESENTATION\x10\x14\x12\x14\n" +
+	"\x10COLOR_MODE_VIVID\x10\x15\x12\x16\n" +
+	"\x12COLOR_MODE_ISF_DAY\x10\x16\x12\x18\n" +
+	"\x14COLOR_MODE_ISF_NIGHT\x10\x17*\x8c\x01\n" +
+	"\fPrimaryColor\x12\x13\n" +
+	"\x0fPRIMARY_COLOR_R\x10\x00\x12\x13\n" +
+	"\x0fPRIMARY_COLOR_G\x10\x01\x12\x13\n" +
+	"\x0fPRIMARY_COLOR_B\x10\x02\x12\x13\n" +
+	"\x0fPRIMARY_COLOR_C\x10\x03\x12\x13\n" +
+	"\x0fPRIMARY_COLOR_M\x10\x04\x12\x13\n" +
+	"\x0fPRIMARY_COLOR_Y\x10\x05*\x96\x01\n" +
+	"\vScreenColor\x12\x14\n" +
+	"\x10SCREEN_COLOR_OFF\x10\x00\x12\x1b\n" +
+	"\x17SCREEN_COLOR_BLACKBOARD\x10\x01\x12\x1b\n" +
+	"\x17SCREEN_COLOR_GREENBOARD\x10\x02\x12\x1b\n" +
+	"\x17SCREEN_COLOR_WHITEBOARD\x10\x03\x12\x1a\n" +
+	"\x16SCREEN_COLOR_BLUEBOARD\x10\x04*\xf4\x03\n" +
+	"\bLanguage\x12\x14\n" +
+	"\x10LANGUAGE_ENGLISH\x10\x00\x12\x13\n" +
+	"\x0fLANGUAGE_FRENCH\x10\x01\x12\x13\n" +
+	"\x0fLANGUAGE_GERMAN\x10\x02\x12\x14\n" +
+	"\x10LANGUAGE_ITALIAN\x10\x03\x12\x14\n" +
+	"\x10LANGUAGE_SPANISH\x10\x04\x12\x14\n" +
+	"\x10LANGUAGE_RUSSIAN\x10\x05\x12\x19\n" +
+	"\x15LANGUAGE_TRAD_CHINESE\x10\x06\x12\x19\n" +
+	"\x15LANGUAGE_SIMP_CHINESE\x10\a\x12\x15\n" +
+	"\x11LANGUAGE_JAPANESE\x10\b\x12\x13\n" +
+	"\x0fLANGUAGE_KOREAN\x10\t\x12\x14\n" +
+	"\x10LANGUAGE_SWEDISH\x10\n" +
+	"\x12\x12\n" +
+	"\x0eLANGUAGE_DUTCH\x10\v\x12\x14\n" +
+	"\x10LANGUAGE_TURKISH\x10\f\x12\x12\n" +
+	"\x0eLANGUAGE_CZECH\x10\r\x12\x17\n" +
+	"\x13LANGUAGE_PORTUGUESE\x10\x0e\x12\x11\n" +
+	"\rLANGUAGE_THAI\x10\x0f\x12\x13\n" +
+	"\x0fLANGUAGE_POLISH\x10\x10\x12\x14\n" +
+	"\x10LANGUAGE_FINNISH\x10\x11\x12\x13\n" +
+	"\x0fLANGUAGE_ARABIC\x10\x12\x12\x17\n" +
+	"\x13LANGUAGE_INDONESIAN\x10\x13\x12\x12\n" +
+	"\x0eLANGUAGE_HINDI\x10\x14\x12\x17\n" +
+	"\x13LANGUAGE_VIETNAMESE\x10\x15*\x83\x02\n" +
+	"\tRemoteKey\x12\x1a\n" +
+	"\x16REMOTE_KEY_UNSPECIFIED\x10\x00\x12\x15\n" +
+	"\x11REMOTE_KEY_SOURCE\x10\x04\x12\x13\n" +
+	"\x0fREMOTE_KEY_AUTO\x10\b\x12\x12\n" +
+	"\x0eREMOTE_KEY_TOP\x10\v\x12\x15\n" +
+	"\x11REMOTE_KEY_BOTTOM\x10\f\x12\x13\n" +
+	"\x0fREMOTE_KEY_LEFT\x10\r\x12\x14\n" +
+	"\x10REMOTE_KEY_RIGHT\x10\x0e\x12\x13\n" +
+	"\x0fREMOTE_KEY_MENU\x10\x0f\x12\x18\n" +
+	"\x14REMOTE_KEY_MY_BUTTON\x10\x11\x12\x13\n" +
+	"\x0fREMOTE_KEY_EXIT\x10\x13\x12\x14\n" +
+	"\x10REMOTE_KEY_ENTER\x10\x15*\x8e\x01\n" +
+	"\x0fLightSourceMode\x12\x1c\n" +
+	"\x18LIGHT_SOURCE_MODE_NORMAL\x10\x00\x12\x19\n" +
+	"\x15LIGHT_SOURCE_MODE_ECO\x10\x01\x12!\n" +
+	"\x1dLIGHT_SOURCE_MODE_DYNAMIC_ECO\x10\x02\x12\x1f\n" +
+	"\x1bLIGHT_SOURCE_MODE_SUPER_ECO\x10\x032\xad\x02\n" +
+	"\x05Power\x12A\n" +
+	"\bSetPower\x12\x1d.viewsonic.v1.SetPowerRequest\x1a\x16.google.protobuf.Empty\x12G\n" +
+	"\bGetPower\x12\x1e.viewsonic.v1.ProjectorRequest\x1a\x1b.viewsonic.v1.PowerResponse\x12H\n" +
+	"\x10SetQuickPowerOff\x12\x1c.viewsonic.v1.SetBoolRequest\x1a\x16.google.protobuf.Empty\x12N\n" +
+	"\x10GetQuickPowerOff\x12\x1e.viewsonic.v1.ProjectorRequest\x1a\x1a.viewsonic.v1.BoolResponse2\xb6\x03\n" +
+	"\x06Status\x12[\n" +
+	"\x12GetProjectorStatus\x12\x1e.viewsonic.v1.ProjectorRequest\x1a%.viewsonic.v1.ProjectorStatusResponse\x12S\n" +
+	"\x0eGetErrorStatus\x12\x1e.viewsonic.v1.ProjectorRequest\x1a!.viewsonic.v1.ErrorStatusResponse\x12\\\n" +
+	"\x17GetOperatingTemperature\x12\x1e.viewsonic.v1.ProjectorRequest\x1a!.viewsonic.v1.TemperatureResponse\x12Z\n" +
+	"\x17GetLightSourceUsageTime\x12\x1e.viewsonic.v1.ProjectorRequest\x1a\x1f.viewsonic.v1.UsageTimeResponse\x12@\n" +
+	"\x05Watch\x12\x1a.viewsonic.v1.WatchRequest\x1a\x19.viewsonic.v1.StateChange0\x012\xd4\f\n" +
+	"\x06Source\x12M\n" +
+	"\x0eSetSourceInput\x12#.viewsonic.v1.SetSourceInputRequest\x1a\x16.google.protobuf.Empty\x12S\n" +
+	"\x0eGetSourceInput\x12\x1e.viewsonic.v1.ProjectorRequest\x1a!.viewsonic.v1.SourceInputResponse\x12J\n" +
+	"\x12SetQuickAutoSearch\x12\x1c.viewsonic.v1.SetBoolRequest\x1a\x16.google.protobuf.Empty\x12P\n" +
+	"\x12GetQuickAutoSearch\x12\x1e.viewsonic.v1.ProjectorRequest\x1a\x1a.viewsonic.v1.BoolResponse\x12K\n" +
+	"\rSetHdmiFormat\x12\".viewsonic.v1.SetHdmiFormatRequest\x1a\x16.google.protobuf.Empty\x12Q\n" +
+	"\rGetHdmiFormat\x12\x1e.viewsonic.v1.ProjectorRequest\x1a .viewsonic.v1.HdmiFormatResponse\x12I\n" +
+	"\fSetHdmiRange\x12!.viewsonic.v1.SetHdmiRangeRequest\x1a\x16.google.protobuf.Empty\x12O\n" +
+	"\fGetHdmiRange\x12\x1e.viewsonic.v1.ProjectorRequest\x1a\x1f.viewsonic.v1.HdmiRangeResponse\x12>\n" +
+	"\x06SetCEC\x12\x1c.viewsonic.v1.SetBoolRequest\x1a\x16.google.protobuf.Empty\x12D\n" +
+	"\x06GetCEC\x12\x1e.viewsonic.v1.ProjectorRequest\x1a\x1a.viewsonic.v1.BoolResponse\x12O\n" +
+	"\x17ShiftHorizontalPosition\x12\x1c.viewsonic.v1.SetBoolRequest\x1a\x16.google.protobuf.Empty\x12T\n" +
+	"\x15GetHorizontalPosition\x12\x1e.viewsonic.v1.ProjectorRequest\x1a\x1b.viewsonic.v1.Int32Response\x12M\n" +
+	"\x15ShiftVerticalPosition\x12\x1c.viewsonic.v1.SetBoolRequest\x1a\x16.google.protobuf.Empty\x12R\n" +
+	"\x13GetVerticalPosition\x12\x1e.viewsonic.v1.ProjectorRequest\x1a\x1b.viewsonic.v1.Int32Response\x12R\n" +
+	"\x18IncreaseKeystoneVertical\x12\x1e.viewsonic.v1.ProjectorRequest\x1a\x16.google.protobuf.Empty\x12R\n" +
+	"\x18DecreaseKeystoneVertical\x12\x1e.viewsonic.v1.ProjectorRequest\x1a\x16.google.protobuf.Empty\x12R\n" +
+	"\x13GetKeystoneVertical\x12\x1e.viewsonic.v1.ProjectorRequest\x1a\x1b.viewsonic.v1.Int32Response\x12T\n" +
+	"\x1aIncreaseKeystoneHorizontal\x12\x1e.viewsonic.v1.ProjectorRequest\x1a\x16.google.protobuf.Empty\x12T\n" +
+	"\x1aDecreaseKeystoneHorizontal\x12\x1e.viewsonic.v1.ProjectorRequest\x1a\x16.google.protobuf.Empty\x12T\n" +
+	"\x15GetKeystoneHorizontal\x12\x1e.viewsonic.v1.ProjectorRequest\x1a\x1b.viewsonic.v1.Int32Response2\xe7\x0e\n" +
+	"\x05Image\x12O\n" +
+	"\x0fSetSplashScreen\x12$.viewsonic.v1.SetSplashScreenRequest\x1a\x16.google.protobuf.Empty\x12U\n" +
+	"\x0fGetSplashScreen\x12\x1e.viewsonic.v1.ProjectorRequest\x1a\".viewsonic.v1.SplashScreenResponse\x12Y\n" +
+	"\x14SetProjectorPosition\x12).viewsonic.v1.SetProjectorPositionRequest\x1a\x16.google.protobuf.Empty\x12_\n" +
+	"\x14GetProjectorPosition\x12\x1e.viewsonic.v1.ProjectorRequest\x1a'.viewsonic.v1.ProjectorPositionResponse\x12J\n" +
+	"\x10IncreaseContrast\x12\x1e.viewsonic.v1.ProjectorRequest\x1a\x16.google.protobuf.Empty\x12J\n" +
+	"\x10DecreaseContrast\x12\x1e.viewsonic.v1.ProjectorRequest\x1a\x16.google.protobuf.Empty\x12J\n" +
+	"\vGetContrast\x12\x1e.viewsonic.v1.ProjectorRequest\x1a\x1b.viewsonic.v1.Int32Response\x12L\n" +
+	"\x12IncreaseBrightness\x12\x1e.viewsonic.v1.ProjectorRequest\x1a\x16.google.protobuf.Empty\x12L\n" +
+	"\x12DecreaseBrightness\x12\x1e.viewsonic.v1.ProjectorRequest\x1a\x16.google.protobuf.Empty\x12L\n" +
+	"\rGetBrightness\x12\x1e.viewsonic.v1.ProjectorRequest\x1a\x1b.viewsonic.v1.Int32Response\x12M\n" +
+	"\x0eSetAspectRatio\x12#.viewsonic.v1.SetAspectRatioRequest\x1a\x16.google.protobuf.Empty\x12S\n" +
+	"\x0eGetAspectRatio\x12\x1e.viewsonic.v1.ProjectorRequest\x1a!.viewsonic.v1.AspectRatioResponse\x12J\n" +
+	"\x10CycleAspectRatio\x12\x1e.viewsonic.v1.ProjectorRequest\x1a\x16.google.protobuf.Empty\x12D\n" +
+	"\n" +
+	"AutoAdjust\x12\x1e.viewsonic.v1.ProjectorRequest\x1a\x16.google.protobuf.Empty\x12@\n" +
+	"\bSetBlank\x12\x1c.viewsonic.v1.SetBoolRequest\x1a\x16.google.protobuf.Empty\x12F\n" +
+	"\bGetBlank\x12\x1e.viewsonic.v1.ProjectorRequest\x1a\x1a.viewsonic.v1.BoolResponse\x12A\n" +
+	"\tSetFreeze\x12\x1c.viewsonic.v1.SetBoolRequest\x1a\x16.google.protobuf.Empty\x12G\n" +
+	"\tGetFreeze\x12\x1e.viewsonic.v1.ProjectorRequest\x1a\x1a.viewsonic.v1.BoolResponse\x12D\n" +
+	"\vSetOverScan\x12\x1d.viewsonic.v1.SetInt32Request\x1a\x16.google.protobuf.Empty\x12J\n" +
+	"\vGetOverScan\x12\x1e.viewsonic.v1.ProjectorRequest\x1a\x1b.viewsonic.v1.Int32Response\x12S\n" +
+	"\x11SetThreeDSyncMode\x12&.viewsonic.v1.SetThreeDSyncModeRequest\x1a\x16.google.protobuf.Empty\x12Y\n" +
+	"\x11GetThreeDSyncMode\x12\x1e.viewsonic.v1.ProjectorRequest\x1a$.viewsonic.v1.ThreeDSyncModeResponse\x12K\n" +
+	"\x13SetThreeDSyncInvert\x12\x1c.viewsonic.v1.SetBoolRequest\x1a\x16.google.protobuf.Empty\x12Q\n" +
+	"\x13GetThreeDSyncInvert\x12\x1e.viewsonic.v1.ProjectorRequest\x1a\x1a.viewsonic.v1.BoolResponse2\xf0\x0e\n" +
+	"\x05Color\x12W\n" +
+	"\x13SetColorTemperature\x12(.viewsonic.v1.SetColorTemperatureRequest\x1a\x16.google.protobuf.Empty\x12]\n" +
+	"\x13GetColorTemperature\x12\x1e.viewsonic.v1.ProjectorRequest\x1a&.viewsonic.v1.ColorTemperatureResponse\x12I\n" +
+	"\fSetColorMode\x12!.viewsonic.v1.SetColorModeRequest\x1a\x16.google.protobuf.Empty\x12O\n" +
+	"\fGetColorMode\x12\x1e.viewsonic.v1.ProjectorRequest\x1a\x1f.viewsonic.v1.ColorModeResponse\x12H\n" +
+	"\x0eCycleColorMode\x12\x1e.viewsonic.v1.ProjectorRequest\x1a\x16.google.protobuf.Empty\x12U\n" +
+	"\x12SelectPrimaryColor\x12'.viewsonic.v1.SelectPrimaryColorRequest\x1a\x16.google.protobuf.Empty\x12]\n" +
+	"\x17GetSelectedPrimaryColor\x12\x1e.viewsonic.v1.ProjectorRequest\x1a\".viewsonic.v1.PrimaryColorResponse\x12E\n" +
+	"\vIncreaseHue\x12\x1e.viewsonic.v1.ProjectorRequest\x1a\x16.google.protobuf.Empty\x12E\n" +
+	"\vDecreaseHue\x12\x1e.viewsonic.v1.ProjectorRequest\x1a\x16.google.protobuf.Empty\x12E\n" +
+	"\x06GetHue\x12\x1e.viewsonic.v1.ProjectorRequest\x1a\x1b.viewsonic.v1.Int32Response\x12L\n" +
+	"\x12IncreaseSaturation\x12\x1e.viewsonic.v1.ProjectorRequest\x1a\x16.google.protobuf.Empty\x12L\n" +
+	"\x12DecreaseSaturation\x12\x1e.viewsonic.v1.ProjectorRequest\x1a\x16.google.protobuf.Empty\x12L\n" +
+	"\rGetSaturation\x12\x1e.viewsonic.v1.ProjectorRequest\x1a\x1b.viewsonic.v1.Int32Response\x12K\n" +
+	"\x11IncreaseSharpness\x12\x1e.viewsonic.v1.ProjectorRequest\x1a\x16.google.protobuf.Empty\x12K\n" +
+	"\x11DecreaseSharpness\x12\x1e.viewsonic.v1.ProjectorRequest\x1a\x16.google.protobuf.Empty\x12K\n" +
+	"\fGetSharpness\x12\x1e.viewsonic.v1.ProjectorRequest\x1a\x1b.viewsonic.v1.Int32Response\x12F\n" +
+	"\fIncreaseGain\x12\x1e.viewsonic.v1.ProjectorRequest\x1a\x16.google.protobuf.Empty\x12F\n" +
+	"\fDecreaseGain\x12\x1e.viewsonic.v1.ProjectorRequest\x1a\x16.google.protobuf.Empty\x12F\n" +
+	"\aGetGain\x12\x1e.viewsonic.v1.ProjectorRequest\x1a\x1b.viewsonic.v1.Int32Response\x12J\n" +
+	"\x11SetBrilliantColor\x12\x1d.viewsonic.v1.SetInt32Request\x1a\x16.google.protobuf.Empty\x12P\n" +
+	"\x11GetBrilliantColor\x12\x1e.viewsonic.v1.ProjectorRequest\x1a\x1b.viewsonic.v1.Int32Response\x12M\n" +
+	"\x0eSetScreenColor\x12#.viewsonic.v1.SetScreenColorRequest\x1a\x16.google.protobuf.Empty\x12S\n" +
+	"\x0eGetScreenColor\x12\x1e.viewsonic.v1.ProjectorRequest\x1a!.viewsonic.v1.ScreenColorResponse\x12S\n" +
+	"\x19ResetCurrentColorSettings\x12\x1e.viewsonic.v1.ProjectorRequest\x1a\x16.google.protobuf.Empty2\xfb\x03\n" +
+	"\x05Audio\x12?\n" +
+	"\aSetMute\x12\x1c.viewsonic.v1.SetBoolRequest\x1a\x16.google.protobuf.Empty\x12E\n" +
+	"\aGetMute\x12\x1e.viewsonic.v1.ProjectorRequest\x1a\x1a.viewsonic.v1.BoolResponse\x12H\n" +
+	"\x0eIncreaseVolume\x12\x1e.viewsonic.v1.ProjectorRequest\x1a\x16.google.protobuf.Empty\x12H\n" +
+	"\x0eDecreaseVolume\x12\x1e.viewsonic.v1.ProjectorRequest\x1a\x16.google.protobuf.Empty\x12B\n" +
+	"\tSetVolume\x12\x1d.viewsonic.v1.SetInt32Request\x1a\x16.google.protobuf.Empty\x12H\n" +
+	"\tGetVolume\x12\x1e.viewsonic.v1.ProjectorRequest\x1a\x1b.viewsonic.v1.Int32Response\x12H\n" +
+	"\x0eCycleAudioMode\x12\x1e.viewsonic.v1.ProjectorRequest\x1a\x16.google.protobuf.Empty2\xf2\b\n" +
+	"\rMiscellaneous\x12K\n" +
+	"\x13SetHighAltitudeMode\x12\x1c.viewsonic.v1.SetBoolRequest\x1a\x16.google.protobuf.Empty\x12Q\n" +
+	"\x13GetHighAltitudeMode\x12\x1e.viewsonic.v1.ProjectorRequest\x1a\x1a.viewsonic.v1.BoolResponse\x12I\n" +
+	"\x11SetMessageDisplay\x12\x1c.viewsonic.v1.SetBoolRequest\x1a\x16.google.protobuf.Empty\x12O\n" +
+	"\x11GetMessageDisplay\x12\x1e.viewsonic.v1.ProjectorRequest\x1a\x1a.viewsonic.v1.BoolResponse\x12G\n" +
+	"\vSetLanguage\x12 .viewsonic.v1.SetLanguageRequest\x1a\x16.google.protobuf.Empty\x12M\n" +
+	"\vGetLanguage\x12\x1e.viewsonic.v1.ProjectorRequest\x1a\x1e.viewsonic.v1.LanguageResponse\x12M\n" +
+	"\x14SetRemoteControlCode\x12\x1d.viewsonic.v1.SetInt32Request\x1a\x16.google.protobuf.Empty\x12S\n" +
+	"\x14GetRemoteControlCode\x12\x1e.viewsonic.v1.ProjectorRequest\x1a\x1b.viewsonic.v1.Int32Response\x12K\n" +
+	"\rSendRemoteKey\x12\".viewsonic.v1.SendRemoteKeyRequest\x1a\x16.google.protobuf.Empty\x12S\n" +
+	"\x19ResetLightSourceUsageTime\x12\x1e.viewsonic.v1.ProjectorRequest\x1a\x16.google.protobuf.Empty\x12U\n" +
+	"\x12SetLightSourceMode\x12'.viewsonic.v1.SetLightSourceModeRequest\x1a\x16.google.protobuf.Empty\x12[\n" +
+	"\x12GetLightSourceMode\x12\x1e.viewsonic.v1.ProjectorRequest\x1a%.viewsonic.v1.LightSourceModeResponse\x12G\n" +
+	"\rCycleLampMode\x12\x1e.viewsonic.v1.ProjectorRequest\x1a\x16.google.protobuf.Empty\x12J\n" +
+	"\x10ResetAllSettings\x12\x1e.viewsonic.v1.ProjectorRequest\x1a\x16.google.protobuf.EmptyB*Z(github.com/m-baertschi/viewsonic/grpcapib\x06proto3"
+
+var (
+	file_viewsonic_proto_rawDescOnce sync.Once
+	file_viewsonic_proto_rawDescData []byte
+)
+
+func file_viewsonic_proto_rawDescGZIP() []byte {
+	file_viewsonic_proto_rawDescOnce.Do(func() {
+		file_viewsonic_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_viewsonic_proto_rawDesc), len(file_viewsonic_proto_rawDesc)))
+	})
+	return file_viewsonic_proto_rawDescData
+}
+
+var file_viewsonic_proto_enumTypes = make([]protoimpl.EnumInfo, 17)
+var file_viewsonic_proto_msgTypes = make([]protoimpl.MessageInfo, 41)
+var file_viewsonic_proto_goTypes = []any{
+	(PowerState)(0),                     // 0: viewsonic.v1.PowerState
+	(ProjectorStatus)(0),                // 1: viewsonic.v1.ProjectorStatus
+	(LampModeStatus)(0),                 // 2: viewsonic.v1.LampModeStatus
+	(SourceInput)(0),                    // 3: viewsonic.v1.SourceInput
+	(HdmiFormat)(0),                     // 4: viewsonic.v1.HdmiFormat
+	(HdmiRange)(0),                      // 5: viewsonic.v1.HdmiRange
+	(SplashScreen)(0),                   // 6: viewsonic.v1.SplashScreen
+	(ProjectorPosition)(0),              // 7: viewsonic.v1.ProjectorPosition
+	(AspectRatio)(0),                    // 8: viewsonic.v1.AspectRatio
+	(ThreeDSyncMode)(0),                 // 9: viewsonic.v1.ThreeDSyncMode
+	(ColorTemperature)(0),               // 10: viewsonic.v1.ColorTemperature
+	(ColorMode)(0),                      // 11: viewsonic.v1.ColorMode
+	(PrimaryColor)(0),                   // 12: viewsonic.v1.PrimaryColor
+	(ScreenColor)(0),                    // 13: viewsonic.v1.ScreenColor
+	(Language)(0),                       // 14: viewsonic.v1.Language
+	(RemoteKey)(0),                      // 15: viewsonic.v1.RemoteKey
+	(LightSourceMode)(0),                // 16: viewsonic.v1.LightSourceMode
+	(*ProjectorRequest)(nil),            // 17: viewsonic.v1.ProjectorRequest
+	(*SetBoolRequest)(nil),              // 18: viewsonic.v1.SetBoolRequest
+	(*BoolResponse)(nil),                // 19: viewsonic.v1.BoolResponse
+	(*SetInt32Request)(nil),             // 20: viewsonic.v1.SetInt32Request
+	(*Int32Response)(nil),               // 21: viewsonic.v1.Int32Response
+	(*SetPowerRequest)(nil),             // 22: viewsonic.v1.SetPowerRequest
+	(*PowerResponse)(nil),               // 23: viewsonic.v1.PowerResponse
+	(*ProjectorStatusResponse)(nil),     // 24: viewsonic.v1.ProjectorStatusResponse
+	(*ErrorCounter)(nil),                // 25: viewsonic.v1.ErrorCounter
+	(*ErrorStatusResponse)(nil),         // 26: viewsonic.v1.ErrorStatusResponse
+	(*TemperatureResponse)(nil),         // 27: viewsonic.v1.TemperatureResponse
+	(*UsageTimeResponse)(nil),           // 28: viewsonic.v1.UsageTimeResponse
+	(*WatchRequest)(nil),                // 29: viewsonic.v1.WatchRequest
+	(*StateChange)(nil),                 // 30: viewsonic.v1.StateChange
+	(*SetSourceInputRequest)(nil),       // 31: viewsonic.v1.SetSourceInputRequest
+	(*SourceInputResponse)(nil),         // 32: viewsonic.v1.SourceInputResponse
+	(*SetHdmiFormatRequest)(nil),        // 33: viewsonic.v1.SetHdmiFormatRequest
+	(*HdmiFormatResponse)(nil),          // 34: viewsonic.v1.HdmiFormatResponse
+	(*SetHdmiRangeRequest)(nil),         // 35: viewsonic.v1.SetHdmiRangeRequest
+	(*HdmiRangeResponse)(nil),           // 36: viewsonic.v1.HdmiRangeResponse
+	(*SetSplashScreenRequest)(nil),      // 37: viewsonic.v1.SetSplashScreenRequest
+	(*SplashScreenResponse)(nil),        // 38: viewsonic.v1.SplashScreenResponse
+	(*SetProjectorPositionRequest)(nil), // 39: viewsonic.v1.SetProjectorPositionRequest
+	(*ProjectorPositionResponse)(nil),   // 40: viewsonic.v1.ProjectorPositionResponse
+	(*SetAspectRatioRequest)(nil),       // 41: viewsonic.v1.SetAspectRatioRequest
+	(*AspectRatioResponse)(nil),         // 42: viewsonic.v1.AspectRatioResponse
+	(*SetThreeDSyncModeRequest)(nil),    // 43: viewsonic.v1.SetThreeDSyncModeRequest
+	(*ThreeDSyncModeResponse)(nil),      // 44: viewsonic.v1.ThreeDSyncModeResponse
+	(*SetColorTemperatureRequest)(nil),  // 45: viewsonic.v1.SetColorTemperatureRequest
+	(*ColorTemperatureResponse)(nil),    // 46: viewsonic.v1.ColorTemperatureResponse
+	(*SetColorModeRequest)(nil),         // 47: viewsonic.v1.SetColorModeRequest
+	(*ColorModeResponse)(nil),           // 48: viewsonic.v1.ColorModeResponse
+	(*SelectPrimaryColorRequest)(nil),   // 49: viewsonic.v1.SelectPrimaryColorRequest
+	(*PrimaryColorResponse)(nil),        // 50: viewsonic.v1.PrimaryColorResponse
+	(*SetScreenColorRequest)(nil),       // 51: viewsonic.v1.SetScreenColorRequest
+	(*ScreenColorResponse)(nil),         // 52: viewsonic.v1.ScreenColorResponse
+	(*SetLanguageRequest)(nil),          // 53: viewsonic.v1.SetLanguageRequest
+	(*LanguageResponse)(nil),            // 54: viewsonic.v1.LanguageResponse
+	(*SendRemoteKeyRequest)(nil),        // 55: viewsonic.v1.SendRemoteKeyRequest
+	(*SetLightSourceModeRequest)(nil),   // 56: viewsonic.v1.SetLightSourceModeRequest
+	(*LightSourceModeResponse)(nil),     // 57: viewsonic.v1.LightSourceModeResponse
+	(*timestamppb.Timestamp)(nil),       // 58: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),               // 59: google.protobuf.Empty
+}
+var file_viewsonic_proto_depIdxs = []int32{
+	0,   // 0: viewsonic.v1.SetPowerRequest.state:type_name -> viewsonic.v1.PowerState
+	0,   // 1: viewsonic.v1.PowerResponse.state:type_name -> viewsonic.v1.PowerState
+	1,   // 2: viewsonic.v1.ProjectorStatusResponse.status:type_name -> viewsonic.v1.ProjectorStatus
+	25,  // 3: viewsonic.v1.ErrorStatusResponse.counters:type_name -> viewsonic.v1.ErrorCounter
+	2,   // 4: viewsonic.v1.ErrorStatusResponse.lamp_status:type_name -> viewsonic.v1.LampModeStatus
+	58,  // 5: viewsonic.v1.StateChange.time:type_name -> google.protobuf.Timestamp
+	1,   // 6: viewsonic.v1.StateChange.status:type_name -> viewsonic.v1.ProjectorStatus
+	3,   // 7: viewsonic.v1.StateChange.source:type_name -> viewsonic.v1.SourceInput
+	3,   // 8: viewsonic.v1.SetSourceInputRequest.input:type_name -> viewsonic.v1.SourceInput
+	3,   // 9: viewsonic.v1.SourceInputResponse.input:type_name -> viewsonic.v1.SourceInput
+	4,   // 10: viewsonic.v1.SetHdmiFormatRequest.format:type_name -> viewsonic.v1.HdmiFormat
+	4,   // 11: viewsonic.v1.HdmiFormatResponse.format:type_name -> viewsonic.v1.HdmiFormat
+	5,   // 12: viewsonic.v1.SetHdmiRangeRequest.range:type_name -> viewsonic.v1.HdmiRange
+	5,   // 13: viewsonic.v1.HdmiRangeResponse.range:type_name -> viewsonic.v1.HdmiRange
+	6,   // 14: viewsonic.v1.SetSplashScreenRequest.screen:type_name -> viewsonic.v1.SplashScreen
+	6,   // 15: viewsonic.v1.SplashScreenResponse.screen:type_name -> viewsonic.v1.SplashScreen
+	7,   // 16: viewsonic.v1.SetProjectorPositionRequest.position:type_name -> viewsonic.v1.ProjectorPosition
+	7,   // 17: viewsonic.v1.ProjectorPositionResponse.position:type_name -> viewsonic.v1.ProjectorPosition
+	8,   // 18: viewsonic.v1.SetAspectRatioRequest.ratio:type_name -> viewsonic.v1.AspectRatio
+	8,   // 19: viewsonic.v1.AspectRatioResponse.ratio:type_name -> viewsonic.v1.AspectRatio
+	9,   // 20: viewsonic.v1.SetThreeDSyncModeRequest.mode:type_name -> viewsonic.v1.ThreeDSyncMode
+	9,   // 21: viewsonic.v1.ThreeDSyncModeResponse.mode:type_name -> viewsonic.v1.ThreeDSyncMode
+	10,  // 22: viewsonic.v1.SetColorTemperatureRequest.temperature:type_name -> viewsonic.v1.ColorTemperature
+	10,  // 23: viewsonic.v1.ColorTemperatureResponse.temperature:type_name -> viewsonic.v1.ColorTemperature
+	11,  // 24: viewsonic.v1.SetColorModeRequest.mode:type_name -> viewsonic.v1.ColorMode
+	11,  // 25: viewsonic.v1.ColorModeResponse.mode:type_name -> viewsonic.v1.ColorMode
+	12,  // 26: viewsonic.v1.SelectPrimaryColorRequest.color:type_name -> viewsonic.v1.PrimaryColor
+	12,  // 27: viewsonic.v1.PrimaryColorResponse.color:type_name -> viewsonic.v1.PrimaryColor
+	13,  // 28: viewsonic.v1.SetScreenColorRequest.color:type_name -> viewsonic.v1.ScreenColor
+	13,  // 29: viewsonic.v1.ScreenColorResponse.color:type_name -> viewsonic.v1.ScreenColor
+	14,  // 30: viewsonic.v1.SetLanguageRequest.language:type_name -> viewsonic.v1.Language
+	14,  // 31: viewsonic.v1.LanguageResponse.language:type_name -> viewsonic.v1.Language
+	15,  // 32: viewsonic.v1.SendRemoteKeyRequest.key:type_name -> viewsonic.v1.RemoteKey
+	16,  // 33: viewsonic.v1.SetLightSourceModeRequest.mode:type_name -> viewsonic.v1.LightSourceMode
+	16,  // 34: viewsonic.v1.LightSourceModeResponse.mode:type_name -> viewsonic.v1.LightSourceMode
+	22,  // 35: viewsonic.v1.Power.SetPower:input_type -> viewsonic.v1.SetPowerRequest
+	17,  // 36: viewsonic.v1.Power.GetPower:input_type -> viewsonic.v1.ProjectorRequest
+	18,  // 37: viewsonic.v1.Power.SetQuickPowerOff:input_type -> viewsonic.v1.SetBoolRequest
+	17,  // 38: viewsonic.v1.Power.GetQuickPowerOff:input_type -> viewsonic.v1.ProjectorRequest
+	17,  // 39: viewsonic.v1.Status.GetProjectorStatus:input_type -> viewsonic.v1.ProjectorRequest
+	17,  // 40: viewsonic.v1.Status.GetErrorStatus:input_type -> viewsonic.v1.ProjectorRequest
+	17,  // 41: viewsonic.v1.Status.GetOperatingTemperature:input_type -> viewsonic.v1.ProjectorRequest
+	17,  // 42: viewsonic.v1.Status.GetLightSourceUsageTime:input_type -> viewsonic.v1.ProjectorRequest
+	29,  // 43: viewsonic.v1.Status.Watch:input_type -> viewsonic.v1.WatchRequest
+	31,  // 44: viewsonic.v1.Source.SetSourceInput:input_type -> viewsonic.v1.SetSourceInputRequest
+	17,  // 45: viewsonic.v1.Source.GetSourceInput:input_type -> viewsonic.v1.ProjectorRequest
+	18,  // 46: viewsonic.v1.Source.SetQuickAutoSearch:input_type -> viewsonic.v1.SetBoolRequest
+	17,  // 47: viewsonic.v1.Source.GetQuickAutoSearch:input_type -> viewsonic.v1.ProjectorRequest
+	33,  // 48: viewsonic.v1.Source.SetHdmiFormat:input_type -> viewsonic.v1.SetHdmiFormatRequest
+	17,  // 49: viewsonic.v1.Source.GetHdmiFormat:input_type -> viewsonic.v1.ProjectorRequest
+	35,  // 50: viewsonic.v1.Source.SetHdmiRange:input_type -> viewsonic.v1.SetHdmiRangeRequest
+	17,  // 51: viewsonic.v1.Source.GetHdmiRange:input_type -> viewsonic.v1.ProjectorRequest
+	18,  // 52: viewsonic.v1.Source.SetCEC:input_type -> viewsonic.v1.SetBoolRequest
+	17,  // 53: viewsonic.v1.Source.GetCEC:input_type -> viewsonic.v1.ProjectorRequest
+	18,  // 54: viewsonic.v1.Source.ShiftHorizontalPosition:input_type -> viewsonic.v1.SetBoolRequest
+	17,  // 55: viewsonic.v1.Source.GetHorizontalPosition:input_type -> viewsonic.v1.ProjectorRequest
+	18,  // 56: viewsonic.v1.Source.ShiftVerticalPosition:input_type -> viewsonic.v1.SetBoolRequest
+	17,  // 57: viewsonic.v1.Source.GetVerticalPosition:input_type -> viewsonic.v1.ProjectorRequest
+	17,  // 58: viewsonic.v1.Source.IncreaseKeystoneVertical:input_type -> viewsonic.v1.ProjectorRequest
+	17,  // 59: viewsonic.v1.Source.DecreaseKeystoneVertical:input_type -> viewsonic.v1.ProjectorRequest
+	17,  // 60: viewsonic.v1.Source.GetKeystoneVertical:input_type -> viewsonic.v1.ProjectorRequest
+	17,  // 61: viewsonic.v1.Source.IncreaseKeystoneHorizontal:input_type -> viewsonic.v1.ProjectorRequest
+	17,  // 62: viewsonic.v1.Source.DecreaseKeystoneHorizontal:input_type -> viewsonic.v1.ProjectorRequest
+	17,  // 63: viewsonic.v1.Source.GetKeystoneHorizontal:input_type -> viewsonic.v1.ProjectorRequest
+	37,  // 64: viewsonic.v1.Image.SetSplashScreen:input_type -> viewsonic.v1.SetSplashScreenRequest
+	17,  // 65: viewsonic.v1.Image.GetSplashScreen:input_type -> viewsonic.v1.ProjectorRequest
+	39,  // 66: viewsonic.v1.Image.SetProjectorPosition:input_type -> viewsonic.v1.SetProjectorPositionRequest
+	17,  // 67: viewsonic.v1.Image.GetProjectorPosition:input_type -> viewsonic.v1.ProjectorRequest
+	17,  // 68: viewsonic.v1.Image.IncreaseContrast:input_type -> viewsonic.v1.ProjectorRequest
+	17,  // 69: viewsonic.v1.Image.DecreaseContrast:input_type -> viewsonic.v1.ProjectorRequest
+	17,  // 70: viewsonic.v1.Image.GetContrast:input_type -> viewsonic.v1.ProjectorRequest
+	17,  // 71: viewsonic.v1.Image.IncreaseBrightness:input_type -> viewsonic.v1.ProjectorRequest
+	17,  // 72: viewsonic.v1.Image.DecreaseBrightness:input_type -> viewsonic.v1.ProjectorRequest
+	17,  // 73: viewsonic.v1.Image.GetBrightness:input_type -> viewsonic.v1.ProjectorRequest
+	41,  // 74: viewsonic.v1.Image.SetAspectRatio:input_type -> viewsonic.v1.SetAspectRatioRequest
+	17,  // 75: viewsonic.v1.Image.GetAspectRatio:input_type -> viewsonic.v1.ProjectorRequest
+	17,  // 76: viewsonic.v1.Image.CycleAspectRatio:input_type -> viewsonic.v1.ProjectorRequest
+	17,  // 77: viewsonic.v1.Image.AutoAdjust:input_type -> viewsonic.v1.ProjectorRequest
+	18,  // 78: viewsonic.v1.Image.SetBlank:input_type -> viewsonic.v1.SetBoolRequest
+	17,  // 79: viewsonic.v1.Image.GetBlank:input_type -> viewsonic.v1.ProjectorRequest
+	18,  // 80: viewsonic.v1.Image.SetFreeze:input_type -> viewsonic.v1.SetBoolRequest
+	17,  // 81: viewsonic.v1.Image.GetFreeze:input_type -> viewsonic.v1.ProjectorRequest
+	20,  // 82: viewsonic.v1.Image.SetOverScan:input_type -> viewsonic.v1.SetInt32Request
+	17,  // 83: viewsonic.v1.Image.GetOverScan:input_type -> viewsonic.v1.ProjectorRequest
+	43,  // 84: viewsonic.v1.Image.SetThreeDSyncMode:input_type -> viewsonic.v1.SetThreeDSyncModeRequest
+	17,  // 85: viewsonic.v1.Image.GetThreeDSyncMode:input_type -> viewsonic.v1.ProjectorRequest
+	18,  // 86: viewsonic.v1.Image.SetThreeDSyncInvert:input_type -> viewsonic.v1.SetBoolRequest
+	17,  // 87: viewsonic.v1.Image.GetThreeDSyncInvert:input_type -> viewsonic.v1.ProjectorRequest
+	45,  // 88: viewsonic.v1.Color.SetColorTemperature:input_type -> viewsonic.v1.SetColorTemperatureRequest
+	17,  // 89: viewsonic.v1.Color.GetColorTemperature:input_type -> viewsonic.v1.ProjectorRequest
+	47,  // 90: viewsonic.v1.Color.SetColorMode:input_type -> viewsonic.v1.SetColorModeRequest
+	17,  // 91: viewsonic.v1.Color.GetColorMode:input_type -> viewsonic.v1.ProjectorRequest
+	17,  // 92: viewsonic.v1.Color.CycleColorMode:input_type -> viewsonic.v1.ProjectorRequest
+	49,  // 93: viewsonic.v1.Color.SelectPrimaryColor:input_type -> viewsonic.v1.SelectPrimaryColorRequest
+	17,  // 94: viewsonic.v1.Color.GetSelectedPrimaryColor:input_type -> viewsonic.v1.ProjectorRequest
+	17,  // 95: viewsonic.v1.Color.IncreaseHue:input_type -> viewsonic.v1.ProjectorRequest
+	17,  // 96: viewsonic.v1.Color.DecreaseHue:input_type -> viewsonic.v1.ProjectorRequest
+	17,  // 97: viewsonic.v1.Color.GetHue:input_type -> viewsonic.v1.ProjectorRequest
+	17,  // 98: viewsonic.v1.Color.IncreaseSaturation:input_type -> viewsonic.v1.ProjectorRequest
+	17,  // 99: viewsonic.v1.Color.DecreaseSaturation:input_type -> viewsonic.v1.ProjectorRequest
+	17,  // 100: viewsonic.v1.Color.GetSaturation:input_type -> viewsonic.v1.ProjectorRequest
+	17,  // 101: viewsonic.v1.Color.IncreaseSharpness:input_type -> viewsonic.v1.ProjectorRequest
+	17,  // 102: viewsonic.v1.Color.DecreaseSharpness:input_type -> viewsonic.v1.ProjectorRequest
+	17,  // 103: viewsonic.v1.Color.GetSharpness:input_type -> viewsonic.v1.ProjectorRequest
+	17,  // 104: viewsonic.v1.Color.IncreaseGain:input_type -> viewsonic.v1.ProjectorRequest
+	17,  // 105: viewsonic.v1.Color.DecreaseGain:input_type -> viewsonic.v1.ProjectorRequest
+	17,  // 106: viewsonic.v1.Color.GetGain:input_type -> viewsonic.v1.ProjectorRequest
+	20,  // 107: viewsonic.v1.Color.SetBrilliantColor:input_type -> viewsonic.v1.SetInt32Request
+	17,  // 108: viewsonic.v1.Color.GetBrilliantColor:input_type -> viewsonic.v1.ProjectorRequest
+	51,  // 109: viewsonic.v1.Color.SetScreenColor:input_type -> viewsonic.v1.SetScreenColorRequest
+	17,  // 110: viewsonic.v1.Color.GetScreenColor:input_type -> viewsonic.v1.ProjectorRequest
+	17,  // 111: viewsonic.v1.Color.ResetCurrentColorSettings:input_type -> viewsonic.v1.ProjectorRequest
+	18,  // 112: viewsonic.v1.Audio.SetMute:input_type -> viewsonic.v1.SetBoolRequest
+	17,  // 113: viewsonic.v1.Audio.GetMute:input_type -> viewsonic.v1.ProjectorRequest
+	17,  // 114: viewsonic.v1.Audio.IncreaseVolume:input_type -> viewsonic.v1.ProjectorRequest
+	17,  // 115: viewsonic.v1.Audio.DecreaseVolume:input_type -> viewsonic.v1.ProjectorRequest
+	20,  // 116: viewsonic.v1.Audio.SetVolume:input_type -> viewsonic.v1.SetInt32Request
+	17,  // 117: viewsonic.v1.Audio.GetVolume:input_type -> viewsonic.v1.ProjectorRequest
+	17,  // 118: viewsonic.v1.Audio.CycleAudioMode:input_type -> viewsonic.v1.ProjectorRequest
+	18,  // 119: viewsonic.v1.Miscellaneous.SetHighAltitudeMode:input_type -> viewsonic.v1.SetBoolRequest
+	17,  // 120: viewsonic.v1.Miscellaneous.GetHighAltitudeMode:input_type -> viewsonic.v1.ProjectorRequest
+	18,  // 121: viewsonic.v1.Miscellaneous.SetMessageDisplay:input_type -> viewsonic.v1.SetBoolRequest
+	17,  // 122: viewsonic.v1.Miscellaneous.GetMessageDisplay:input_type -> viewsonic.v1.ProjectorRequest
+	53,  // 123: viewsonic.v1.Miscellaneous.SetLanguage:input_type -> viewsonic.v1.SetLanguageRequest
+	17,  // 124: viewsonic.v1.Miscellaneous.GetLanguage:input_type -> viewsonic.v1.ProjectorRequest
+	20,  // 125: viewsonic.v1.Miscellaneous.SetRemoteControlCode:input_type -> viewsonic.v1.SetInt32Request
+	17,  // 126: viewsonic.v1.Miscellaneous.GetRemoteControlCode:input_type -> viewsonic.v1.ProjectorRequest
+	55,  // 127: viewsonic.v1.Miscellaneous.SendRemoteKey:input_type -> viewsonic.v1.SendRemoteKeyRequest
+	17,  // 128: viewsonic.v1.Miscellaneous.ResetLightSourceUsageTime:input_type -> viewsonic.v1.ProjectorRequest
+	56,  // 129: viewsonic.v1.Miscellaneous.SetLightSourceMode:input_type -> viewsonic.v1.SetLightSourceModeRequest
+	17,  // 130: viewsonic.v1.Miscellaneous.GetLightSourceMode:input_type -> viewsonic.v1.ProjectorRequest
+	17,  // 131: viewsonic.v1.Miscellaneous.CycleLampMode:input_type -> viewsonic.v1.ProjectorRequest
+	17,  // 132: viewsonic.v1.Miscellaneous.ResetAllSettings:input_type -> viewsonic.v1.ProjectorRequest
+	59,  // 133: viewsonic.v1.Power.SetPower:output_type -> google.protobuf.Empty
+	23,  // 134: viewsonic.v1.Power.GetPower:output_type -> viewsonic.v1.PowerResponse
+	59,  // 135: viewsonic.v1.Power.SetQuickPowerOff:output_type -> google.protobuf.Empty
+	19,  // 136: viewsonic.v1.Power.GetQuickPowerOff:output_type -> viewsonic.v1.BoolResponse
+	24,  // 137: viewsonic.v1.Status.GetProjectorStatus:output_type -> viewsonic.v1.ProjectorStatusResponse
+	26,  // 138: viewsonic.v1.Status.GetErrorStatus:output_type -> viewsonic.v1.ErrorStatusResponse
+	27,  // 139: viewsonic.v1.Status.GetOperatingTemperature:output_type -> viewsonic.v1.TemperatureResponse
+	28,  // 140: viewsonic.v1.Status.GetLightSourceUsageTime:output_type -> viewsonic.v1.UsageTimeResponse
+	30,  // 141: viewsonic.v1.Status.Watch:output_type -> viewsonic.v1.StateChange
+	59,  // 142: viewsonic.v1.Source.SetSourceInput:output_type -> google.protobuf.Empty
+	32,  // 143: viewsonic.v1.Source.GetSourceInput:output_type -> viewsonic.v1.SourceInputResponse
+	59,  // 144: viewsonic.v1.Source.SetQuickAutoSearch:output_type -> google.protobuf.Empty
+	19,  // 145: viewsonic.v1.Source.GetQuickAutoSearch:output_type -> viewsonic.v1.BoolResponse
+	59,  // 146: viewsonic.v1.Source.SetHdmiFormat:output_type -> google.protobuf.Empty
+	34,  // 147: viewsonic.v1.Source.GetHdmiFormat:output_type -> viewsonic.v1.HdmiFormatResponse
+	59,  // 148: viewsonic.v1.Source.SetHdmiRange:output_type -> google.protobuf.Empty
+	36,  // 149: viewsonic.v1.Source.GetHdmiRange:output_type -> viewsonic.v1.HdmiRangeResponse
+	59,  // 150: viewsonic.v1.Source.SetCEC:output_type -> google.protobuf.Empty
+	19,  // 151: viewsonic.v1.Source.GetCEC:output_type -> viewsonic.v1.BoolResponse
+	59,  // 152: viewsonic.v1.Source.ShiftHorizontalPosition:output_type -> google.protobuf.Empty
+	21,  // 153: viewsonic.v1.Source.GetHorizontalPosition:output_type -> viewsonic.v1.Int32Response
+	59,  // 154: viewsonic.v1.Source.ShiftVerticalPosition:output_type -> google.protobuf.Empty
+	21,  // 155: viewsonic.v1.Source.GetVerticalPosition:output_type -> viewsonic.v1.Int32Response
+	59,  // 156: viewsonic.v1.Source.IncreaseKeystoneVertical:output_type -> google.protobuf.Empty
+	59,  // 157: viewsonic.v1.Source.DecreaseKeystoneVertical:output_type -> google.protobuf.Empty
+	21,  // 158: viewsonic.v1.Source.GetKeystoneVertical:output_type -> viewsonic.v1.Int32Response
+	59,  // 159: viewsonic.v1.Source.IncreaseKeystoneHorizontal:output_type -> google.protobuf.Empty
+	59,  // 160: viewsonic.v1.Source.DecreaseKeystoneHorizontal:output_type -> google.protobuf.Empty
+	21,  // 161: viewsonic.v1.Source.GetKeystoneHorizontal:output_type -> viewsonic.v1.Int32Response
+	59,  // 162: viewsonic.v1.Image.SetSplashScreen:output_type -> google.protobuf.Empty
+	38,  // 163: viewsonic.v1.Image.GetSplashScreen:output_type -> viewsonic.v1.SplashScreenResponse
+	59,  // 164: viewsonic.v1.Image.SetProjectorPosition:output_type -> google.protobuf.Empty
+	40,  // 165: viewsonic.v1.Image.GetProjectorPosition:output_type -> viewsonic.v1.ProjectorPositionResponse
+	59,  // 166: viewsonic.v1.Image.IncreaseContrast:output_type -> google.protobuf.Empty
+	59,  // 167: viewsonic.v1.Image.DecreaseContrast:output_type -> google.protobuf.Empty
+	21,  // 168: viewsonic.v1.Image.GetContrast:output_type -> viewsonic.v1.Int32Response
+	59,  // 169: viewsonic.v1.Image.IncreaseBrightness:output_type -> google.protobuf.Empty
+	59,  // 170: viewsonic.v1.Image.DecreaseBrightness:output_type -> google.protobuf.Empty
+	21,  // 171: viewsonic.v1.Image.GetBrightness:output_type -> viewsonic.v1.Int32Response
+	59,  // 172: viewsonic.v1.Image.SetAspectRatio:output_type -> google.protobuf.Empty
+	42,  // 173: viewsonic.v1.Image.GetAspectRatio:output_type -> viewsonic.v1.AspectRatioResponse
+	59,  // 174: viewsonic.v1.Image.CycleAspectRatio:output_type -> google.protobuf.Empty
+	59,  // 175: viewsonic.v1.Image.AutoAdjust:output_type -> google.protobuf.Empty
+	59,  // 176: viewsonic.v1.Image.SetBlank:output_type -> google.protobuf.Empty
+	19,  // 177: viewsonic.v1.Image.GetBlank:output_type -> viewsonic.v1.BoolResponse
+	59,  // 178: viewsonic.v1.Image.SetFreeze:output_type -> google.protobuf.Empty
+	19,  // 179: viewsonic.v1.Image.GetFreeze:output_type -> viewsonic.v1.BoolResponse
+	59,  // 180: viewsonic.v1.Image.SetOverScan:output_type -> google.protobuf.Empty
+	21,  // 181: viewsonic.v1.Image.GetOverScan:output_type -> viewsonic.v1.Int32Response
+	59,  // 182: viewsonic.v1.Image.SetThreeDSyncMode:output_type -> google.protobuf.Empty
+	44,  // 183: viewsonic.v1.Image.GetThreeDSyncMode:output_type -> viewsonic.v1.ThreeDSyncModeResponse
+	59,  // 184: viewsonic.v1.Image.SetThreeDSyncInvert:output_type -> google.protobuf.Empty
+	19,  // 185: viewsonic.v1.Image.GetThreeDSyncInvert:output_type -> viewsonic.v1.BoolResponse
+	59,  // 186: viewsonic.v1.Color.SetColorTemperature:output_type -> google.protobuf.Empty
+	46,  // 187: viewsonic.v1.Color.GetColorTemperature:output_type -> viewsonic.v1.ColorTemperatureResponse
+	59,  // 188: viewsonic.v1.Color.SetColorMode:output_type -> google.protobuf.Empty
+	48,  // 189: viewsonic.v1.Color.GetColorMode:output_type -> viewsonic.v1.ColorModeResponse
+	59,  // 190: viewsonic.v1.Color.CycleColorMode:output_type -> google.protobuf.Empty
+	59,  // 191: viewsonic.v1.Color.SelectPrimaryColor:output_type -> google.protobuf.Empty
+	50,  // 192: viewsonic.v1.Color.GetSelectedPrimaryColor:output_type -> viewsonic.v1.PrimaryColorResponse
+	59,  // 193: viewsonic.v1.Color.IncreaseHue:output_type -> google.protobuf.Empty
+	59,  // 194: viewsonic.v1.Color.DecreaseHue:output_type -> google.protobuf.Empty
+	21,  // 195: viewsonic.v1.Color.GetHue:output_type -> viewsonic.v1.Int32Response
+	59,  // 196: viewsonic.v1.Color.IncreaseSaturation:output_type -> google.protobuf.Empty
+	59,  // 197: viewsonic.v1.Color.DecreaseSaturation:output_type -> google.protobuf.Empty
+	21,  // 198: viewsonic.v1.Color.GetSaturation:output_type -> viewsonic.v1.Int32Response
+	59,  // 199: viewsonic.v1.Color.IncreaseSharpness:output_type -> google.protobuf.Empty
+	59,  // 200: viewsonic.v1.Color.DecreaseSharpness:output_type -> google.protobuf.Empty
+	21,  // 201: viewsonic.v1.Color.GetSharpness:output_type -> viewsonic.v1.Int32Response
+	59,  // 202: viewsonic.v1.Color.IncreaseGain:output_type -> google.protobuf.Empty
+	59,  // 203: viewsonic.v1.Color.DecreaseGain:output_type -> google.protobuf.Empty
+	21,  // 204: viewsonic.v1.Color.GetGain:output_type -> viewsonic.v1.Int32Response
+	59,  // 205: viewsonic.v1.Color.SetBrilliantColor:output_type -> google.protobuf.Empty
+	21,  // 206: viewsonic.v1.Color.GetBrilliantColor:output_type -> viewsonic.v1.Int32Response
+	59,  // 207: viewsonic.v1.Color.SetScreenColor:output_type -> google.protobuf.Empty
+	52,  // 208: viewsonic.v1.Color.GetScreenColor:output_type -> viewsonic.v1.ScreenColorResponse
+	59,  // 209: viewsonic.v1.Color.ResetCurrentColorSettings:output_type -> google.protobuf.Empty
+	59,  // 210: viewsonic.v1.Audio.SetMute:output_type -> google.protobuf.Empty
+	19,  // 211: viewsonic.v1.Audio.GetMute:output_type -> viewsonic.v1.BoolResponse
+	59,  // 212: viewsonic.v1.Audio.IncreaseVolume:output_type -> google.protobuf.Empty
+	59,  // 213: viewsonic.v1.Audio.DecreaseVolume:output_type -> google.protobuf.Empty
+	59,  // 214: viewsonic.v1.Audio.SetVolume:output_type -> google.protobuf.Empty
+	21,  // 215: viewsonic.v1.Audio.GetVolume:output_type -> viewsonic.v1.Int32Response
+	59,  // 216: viewsonic.v1.Audio.CycleAudioMode:output_type -> google.protobuf.Empty
+	59,  // 217: viewsonic.v1.Miscellaneous.SetHighAltitudeMode:output_type -> google.protobuf.Empty
+	19,  // 218: viewsonic.v1.Miscellaneous.GetHighAltitudeMode:output_type -> viewsonic.v1.BoolResponse
+	59,  // 219: viewsonic.v1.Miscellaneous.SetMessageDisplay:output_type -> google.protobuf.Empty
+	19,  // 220: viewsonic.v1.Miscellaneous.GetMessageDisplay:output_type -> viewsonic.v1.BoolResponse
+	59,  // 221: viewsonic.v1.Miscellaneous.SetLanguage:output_type -> google.protobuf.Empty
+	54,  // 222: viewsonic.v1.Miscellaneous.GetLanguage:output_type -> viewsonic.v1.LanguageResponse
+	59,  // 223: viewsonic.v1.Miscellaneous.SetRemoteControlCode:output_type -> google.protobuf.Empty
+	21,  // 224: viewsonic.v1.Miscellaneous.GetRemoteControlCode:output_type -> viewsonic.v1.Int32Response
+	59,  // 225: viewsonic.v1.Miscellaneous.SendRemoteKey:output_type -> google.protobuf.Empty
+	59,  // 226: viewsonic.v1.Miscellaneous.ResetLightSourceUsageTime:output_type -> google.protobuf.Empty
+	59,  // 227: viewsonic.v1.Miscellaneous.SetLightSourceMode:output_type -> google.protobuf.Empty
+	57,  // 228: viewsonic.v1.Miscellaneous.GetLightSourceMode:output_type -> viewsonic.v1.LightSourceModeResponse
+	59,  // 229: viewsonic.v1.Miscellaneous.CycleLampMode:output_type -> google.protobuf.Empty
+	59,  // 230: viewsonic.v1.Miscellaneous.ResetAllSettings:output_type -> google.protobuf.Empty
+	133, // [133:231] is the sub-list for method output_type
+	35,  // [35:133] is the sub-list for method input_type
+	35,  // [35:35] is the sub-list for extension type_name
+	35,  // [35:35] is the sub-list for extension extendee
+	0,   // [0:35] is the sub-list for field type_name
+}
+
+func init() { file_viewsonic_proto_init() }
+func file_viewsonic_proto_init() {
+	if File_viewsonic_proto != nil {
+		return
+	}
+	file_viewsonic_proto_msgTypes[13].OneofWrappers = []any{
+		(*StateChange_BoolValue)(nil),
+		(*StateChange_IntValue)(nil),
+		(*StateChange_FloatValue)(nil),
+		(*StateChange_Status)(nil),
+		(*StateChange_Source)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_viewsonic_proto_rawDesc), len(file_viewsonic_proto_rawDesc)),
+			NumEnums:      17,
+			NumMessages:   41,
+			NumExtensions: 0,
+			NumServices:   7,
+		},
+		GoTypes:           file_viewsonic_proto_goTypes,
+		DependencyIndexes: file_viewsonic_proto_depIdxs,
+		EnumInfos:         file_viewsonic_proto_enumTypes,
+		MessageInfos:      file_viewsonic_proto_msgTypes,
+	}.Build()
+	File_viewsonic_proto = out.File
+	file_viewsonic_proto_goTypes = nil
+	file_viewsonic_proto_depIdxs = nil
+}
@@ -0,0 +1,4207 @@
+// gRPC API mirroring the viewsonic library. Enum values equal the RS-232 values of the
+// matching Go types, every request names the projector configured on the server.
+
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.6.1
+// - protoc             (unknown)
+// source: viewsonic.proto
+
+package grpcapi
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	Power_SetPower_FullMethodName         = "/viewsonic.v1.Power/SetPower"
+	Power_GetPower_FullMethodName         = "/viewsonic.v1.Power/GetPower"
+	Power_SetQuickPowerOff_FullMethodName = "/viewsonic.v1.Power/SetQuickPowerOff"
+	Power_GetQuickPowerOff_FullMethodName = "/viewsonic.v1.Power/GetQuickPowerOff"
+)
+
+// PowerClient is the client API for Power service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type PowerClient interface {
+	SetPower(ctx context.Context, in *SetPowerRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetPower(ctx context.Context, in *ProjectorRequest, opts ...grpc.CallOption) (*PowerResponse, error)
+	SetQuickPowerOff(ctx context.Context, in *SetBoolRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetQuickPowerOff(ctx context.Context, in *ProjectorRequest, opts ...grpc.CallOption) (*BoolResponse, error)
+}
+
+type powerClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPowerClient(cc grpc.ClientConnInterface) PowerClient {
+	return &powerClient{cc}
+}
+
+func (c *powerClient) SetPower(ctx context.Context, in *SetPowerRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Power_SetPower_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *powerClient) GetPower(ctx context.Context, in *ProjectorRequest, opts ...grpc.CallOption) (*PowerResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PowerResponse)
+	err := c.cc.Invoke(ctx, Power_GetPower_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *powerClient) SetQuickPowerOff(ctx context.Context, in *SetBoolRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Power_SetQuickPowerOff_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *powerClient) GetQuickPowerOff(ctx context.Context, in *ProjectorRequest, opts ...grpc.CallOption) (*BoolResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BoolResponse)
+	err := c.cc.Invoke(ctx, Power_GetQuickPowerOff_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PowerServer is the server API for Power service.
+// All implementations must embed UnimplementedPowerServer
+// for forward compatibility.
+type PowerServer interface {
+	SetPower(context.Context, *SetPowerRequest) (*emptypb.Empty, error)
+	GetPower(context.Context, *ProjectorRequest) (*PowerResponse, error)
+	SetQuickPowerOff(context.Context, *SetBoolRequest) (*emptypb.Empty, error)
+	GetQuickPowerOff(context.Context, *ProjectorRequest) (*BoolResponse, error)
+	mustEmbedUnimplementedPowerServer()
+}
+
+// UnimplementedPowerServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedPowerServer struct{}
+
+func (UnimplementedPowerServer) SetPower(context.Context, *SetPowerRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetPower not implemented")
+}
+func (UnimplementedPowerServer) GetPower(context.Context, *ProjectorRequest) (*PowerResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetPower not implemented")
+}
+func (UnimplementedPowerServer) SetQuickPowerOff(context.Context, *SetBoolRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetQuickPowerOff not implemented")
+}
+func (UnimplementedPowerServer) GetQuickPowerOff(context.Context, *ProjectorRequest) (*BoolResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetQuickPowerOff not implemented")
+}
+func (UnimplementedPowerServer) mustEmbedUnimplementedPowerServer() {}
+func (UnimplementedPowerServer) testEmbeddedByValue()               {}
+
+// UnsafePowerServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PowerServer will
+// result in compilation errors.
+type UnsafePowerServer interface {
+	mustEmbedUnimplementedPowerServer()
+}
+
+func RegisterPowerServer(s grpc.ServiceRegistrar, srv PowerServer) {
+	// If the following call panics, it indicates UnimplementedPowerServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Power_ServiceDesc, srv)
+}
+
+func _Power_SetPower_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetPowerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PowerServer).SetPower(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Power_SetPower_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PowerServer).SetPower(ctx, req.(*SetPowerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Power_GetPower_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProjectorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PowerServer).GetPower(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Power_GetPower_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PowerServer).GetPower(ctx, req.(*ProjectorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Power_SetQuickPowerOff_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetBoolRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PowerServer).SetQuickPowerOff(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Power_SetQuickPowerOff_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PowerServer).SetQuickPowerOff(ctx, req.(*SetBoolRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Power_GetQuickPowerOff_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProjectorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PowerServer).GetQuickPowerOff(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Power_GetQuickPowerOff_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PowerServer).GetQuickPowerOff(ctx, req.(*ProjectorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Power_ServiceDesc is the grpc.ServiceDesc for Power service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Power_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "viewsonic.v1.Power",
+	HandlerType: (*PowerServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SetPower",
+			Handler:    _Power_SetPower_Handler,
+		},
+		{
+			MethodName: "GetPower",
+			Handler:    _Power_GetPower_Handler,
+		},
+		{
+			MethodName: "SetQuickPowerOff",
+			Handler:    _Power_SetQuickPowerOff_Handler,
+		},
+		{
+			MethodName: "GetQuickPowerOff",
+			Handler:    _Power_GetQuickPowerOff_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "viewsonic.proto",
+}
+
+const (
+	Status_GetProjectorStatus_FullMethodName      = "/viewsonic.v1.Status/GetProjectorStatus"
+	Status_GetErrorStatus_FullMethodName          = "/viewsonic.v1.Status/GetErrorStatus"
+	Status_GetOperatingTemperature_FullMethodName = "/viewsonic.v1.Status/GetOperatingTemperature"
+	Status_GetLightSourceUsageTime_FullMethodName = "/viewsonic.v1.Status/GetLightSourceUsageTime"
+	Status_Watch_FullMethodName                   = "/viewsonic.v1.Status/Watch"
+)
+
+// StatusClient is the client API for Status service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type StatusClient interface {
+	GetProjectorStatus(ctx context.Context, in *ProjectorRequest, opts ...grpc.CallOption) (*ProjectorStatusResponse, error)
+	GetErrorStatus(ctx context.Context, in *ProjectorRequest, opts ...grpc.CallOption) (*ErrorStatusResponse, error)
+	GetOperatingTemperature(ctx context.Context, in *ProjectorRequest, opts ...grpc.CallOption) (*TemperatureResponse, error)
+	GetLightSourceUsageTime(ctx context.Context, in *ProjectorRequest, opts ...grpc.CallOption) (*UsageTimeResponse, error)
+	// Watch sends the current state of the watched fields, followed by every change.
+	Watch(ctx context.Context, in *WatchRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[StateChange], error)
+}
+
+type statusClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewStatusClient(cc grpc.ClientConnInterface) StatusClient {
+	return &statusClient{cc}
+}
+
+func (c *statusClient) GetProjectorStatus(ctx context.Context, in *ProjectorRequest, opts ...grpc.CallOption) (*ProjectorStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProjectorStatusResponse)
+	err := c.cc.Invoke(ctx, Status_GetProjectorStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *statusClient) GetErrorStatus(ctx context.Context, in *ProjectorRequest, opts ...grpc.CallOption) (*ErrorStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ErrorStatusResponse)
+	err := c.cc.Invoke(ctx, Status_GetErrorStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *statusClient) GetOperatingTemperature(ctx context.Context, in *ProjectorRequest, opts ...grpc.CallOption) (*TemperatureResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TemperatureResponse)
+	err := c.cc.Invoke(ctx, Status_GetOperatingTemperature_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *statusClient) GetLightSourceUsageTime(ctx context.Context, in *ProjectorRequest, opts ...grpc.CallOption) (*UsageTimeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UsageTimeResponse)
+	err := c.cc.Invoke(ctx, Status_GetLightSourceUsageTime_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *statusClient) Watch(ctx context.Context, in *WatchRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[StateChange], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Status_ServiceDesc.Streams[0], Status_Watch_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchRequest, StateChange]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Status_WatchClient = grpc.ServerStreamingClient[StateChange]
+
+// StatusServer is the server API for Status service.
+// All implementations must embed UnimplementedStatusServer
+// for forward compatibility.
+type StatusServer interface {
+	GetProjectorStatus(context.Context, *ProjectorRequest) (*ProjectorStatusResponse, error)
+	GetErrorStatus(context.Context, *ProjectorRequest) (*ErrorStatusResponse, error)
+	GetOperatingTemperature(context.Context, *ProjectorRequest) (*TemperatureResponse, error)
+	GetLightSourceUsageTime(context.Context, *ProjectorRequest) (*UsageTimeResponse, error)
+	// Watch sends the current state of the watched fields, followed by every change.
+	Watch(*WatchRequest, grpc.ServerStreamingServer[StateChange]) error
+	mustEmbedUnimplementedStatusServer()
+}
+
+// UnimplementedStatusServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedStatusServer struct{}
+
+func (UnimplementedStatusServer) GetProjectorStatus(context.Context, *ProjectorRequest) (*ProjectorStatusResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetProjectorStatus not implemented")
+}
+func (UnimplementedStatusServer) GetErrorStatus(context.Context, *ProjectorRequest) (*ErrorStatusResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetErrorStatus not implemented")
+}
+func (UnimplementedStatusServer) GetOperatingTemperature(context.Context, *ProjectorRequest) (*TemperatureResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetOperatingTemperature not implemented")
+}
+func (UnimplementedStatusServer) GetLightSourceUsageTime(context.Context, *ProjectorRequest) (*UsageTimeResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetLightSourceUsageTime not implemented")
+}
+func (UnimplementedStatusServer) Watch(*WatchRequest, grpc.ServerStreamingServer[StateChange]) error {
+	return status.Error(codes.Unimplemented, "method Watch not implemented")
+}
+func (UnimplementedStatusServer) mustEmbedUnimplementedStatusServer() {}
+func (UnimplementedStatusServer) testEmbeddedByValue()                {}
+
+// UnsafeStatusServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to StatusServer will
+// result in compilation errors.
+type UnsafeStatusServer interface {
+	mustEmbedUnimplementedStatusServer()
+}
+
+func RegisterStatusServer(s grpc.ServiceRegistrar, srv StatusServer) {
+	// If the following call panics, it indicates UnimplementedStatusServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Status_ServiceDesc, srv)
+}
+
+func _Status_GetProjectorStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProjectorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StatusServer).GetProjectorStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Status_GetProjectorStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StatusServer).GetProjectorStatus(ctx, req.(*ProjectorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Status_GetErrorStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProjectorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StatusServer).GetErrorStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Status_GetErrorStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StatusServer).GetErrorStatus(ctx, req.(*ProjectorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Status_GetOperatingTemperature_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProjectorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StatusServer).GetOperatingTemperature(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Status_GetOperatingTemperature_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StatusServer).GetOperatingTemperature(ctx, req.(*ProjectorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Status_GetLightSourceUsageTime_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProjectorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StatusServer).GetLightSourceUsageTime(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Status_GetLightSourceUsageTime_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StatusServer).GetLightSourceUsageTime(ctx, req.(*ProjectorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Status_Watch_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(StatusServer).Watch(m, &grpc.GenericServerStream[WatchRequest, StateChange]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Status_WatchServer = grpc.ServerStreamingServer[StateChange]
+
+// Status_ServiceDesc is the grpc.ServiceDesc for Status service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Status_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "viewsonic.v1.Status",
+	HandlerType: (*StatusServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetProjectorStatus",
+			Handler:    _Status_GetProjectorStatus_Handler,
+		},
+		{
+			MethodName: "GetErrorStatus",
+			Handler:    _Status_GetErrorStatus_Handler,
+		},
+		{
+			MethodName: "GetOperatingTemperature",
+			Handler:    _Status_GetOperatingTemperature_Handler,
+		},
+		{
+			MethodName: "GetLightSourceUsageTime",
+			Handler:    _Status_GetLightSourceUsageTime_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Watch",
+			Handler:       _Status_Watch_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "viewsonic.proto",
+}
+
+const (
+	Source_SetSourceInput_FullMethodName             = "/viewsonic.v1.Source/SetSourceInput"
+	Source_GetSourceInput_FullMethodName             = "/viewsonic.v1.Source/GetSourceInput"
+	Source_SetQuickAutoSearch_FullMethodName         = "/viewsonic.v1.Source/SetQuickAutoSearch"
+	Source_GetQuickAutoSearch_FullMethodName         = "/viewsonic.v1.Source/GetQuickAutoSearch"
+	Source_SetHdmiFormat_FullMethodName              = "/viewsonic.v1.Source/SetHdmiFormat"
+	Source_GetHdmiFormat_FullMethodName              = "/viewsonic.v1.Source/GetHdmiFormat"
+	Source_SetHdmiRange_FullMethodName               = "/viewsonic.v1.Source/SetHdmiRange"
+	Source_GetHdmiRange_FullMethodName               = "/viewsonic.v1.Source/GetHdmiRange"
+	Source_SetCEC_FullMethodName                     = "/viewsonic.v1.Source/SetCEC"
+	Source_GetCEC_FullMethodName                     = "/viewsonic.v1.Source/GetCEC"
+	Source_ShiftHorizontalPosition_FullMethodName    = "/viewsonic.v1.Source/ShiftHorizontalPosition"
+	Source_GetHorizontalPosition_FullMethodName      = "/viewsonic.v1.Source/GetHorizontalPosition"
+	Source_ShiftVerticalPosition_FullMethodName      = "/viewsonic.v1.Source/ShiftVerticalPosition"
+	Source_GetVerticalPosition_FullMethodName        = "/viewsonic.v1.Source/GetVerticalPosition"
+	Source_IncreaseKeystoneVertical_FullMethodName   = "/viewsonic.v1.Source/IncreaseKeystoneVertical"
+	Source_DecreaseKeystoneVertical_FullMethodName   = "/viewsonic.v1.Source/DecreaseKeystoneVertical"
+	Source_GetKeystoneVertical_FullMethodName        = "/viewsonic.v1.Source/GetKeystoneVertical"
+	Source_IncreaseKeystoneHorizontal_FullMethodName = "/viewsonic.v1.Source/IncreaseKeystoneHorizontal"
+	Source_DecreaseKeystoneHorizontal_FullMethodName = "/viewsonic.v1.Source/DecreaseKeystoneHorizontal"
+	Source_GetKeystoneHorizontal_FullMethodName      = "/viewsonic.v1.Source/GetKeystoneHorizontal"
+)
+
+// SourceClient is the client API for Source service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type SourceClient interface {
+	SetSourceInput(ctx context.Context, in *SetSourceInputRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetSourceInput(ctx context.Context, in *ProjectorRequest, opts ...grpc.CallOption) (*SourceInputResponse, error)
+	SetQuickAutoSearch(ctx context.Context, in *SetBoolRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetQuickAutoSearch(ctx context.Context, in *ProjectorRequest, opts ...grpc.CallOption) (*BoolResponse, error)
+	SetHdmiFormat(ctx context.Context, in *SetHdmiFormatRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetHdmiFormat(ctx context.Context, in *ProjectorRequest, opts ...grpc.CallOption) (*HdmiFormatResponse, error)
+	SetHdmiRange(ctx context.Context, in *SetHdmiRangeRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetHdmiRange(ctx context.Context, in *ProjectorRequest, opts ...grpc.CallOption) (*HdmiRangeResponse, error)
+	SetCEC(ctx context.Context, in *SetBoolRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetCEC(ctx context.Context, in *ProjectorRequest, opts ...grpc.CallOption) (*BoolResponse, error)
+	// value true shifts right.
+	ShiftHorizontalPosition(ctx context.Context, in *SetBoolRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetHorizontalPosition(ctx context.Context, in *ProjectorRequest, opts ...grpc.CallOption) (*Int32Response, error)
+	// value true shifts up.
+	ShiftVerticalPosition(ctx context.Context, in *SetBoolRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetVerticalPosition(ctx context.Context, in *ProjectorRequest, opts ...grpc.CallOption) (*Int32Response, error)
+	IncreaseKeystoneVertical(ctx context.Context, in *ProjectorRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	DecreaseKeystoneVertical(ctx context.Context, in *ProjectorRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetKeystoneVertical(ctx context.Context, in *ProjectorRequest, opts ...grpc.CallOption) (*Int32Response, error)
+	IncreaseKeystoneHorizontal(ctx context.Context, in *ProjectorRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	DecreaseKeystoneHorizontal(ctx context.Context, in *ProjectorRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetKeystoneHorizontal(ctx context.Context, in *ProjectorRequest, opts ...grpc.CallOption) (*Int32Response, error)
+}
+
+type sourceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewSourceClient(cc grpc.ClientConnInterface) SourceClient {
+	return &sourceClient{cc}
+}
+
+func (c *sourceClient) SetSourceInput(ctx context.Context, in *SetSourceInputRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Source_SetSourceInput_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sourceClient) GetSourceInput(ctx context.Context, in *ProjectorRequest, opts ...grpc.CallOption) (*SourceInputResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SourceInputResponse)
+	err := c.cc.Invoke(ctx, Source_GetSourceInput_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sourceClient) SetQuickAutoSearch(ctx context.Context, in *SetBoolRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Source_SetQuickAutoSearch_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sourceClient) GetQuickAutoSearch(ctx context.Context, in *ProjectorRequest, opts ...grpc.CallOption) (*BoolResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BoolResponse)
+	err := c.cc.Invoke(ctx, Source_GetQuickAutoSearch_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sourceClient) SetHdmiFormat(ctx context.Context, in *SetHdmiFormatRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Source_SetHdmiFormat_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sourceClient) GetHdmiFormat(ctx context.Context, in *ProjectorRequest, opts ...grpc.CallOption) (*HdmiFormatResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(HdmiFormatResponse)
+	err := c.cc.Invoke(ctx, Source_GetHdmiFormat_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sourceClient) SetHdmiRange(ctx context.Context, in *SetHdmiRangeRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Source_SetHdmiRange_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sourceClient) GetHdmiRange(ctx context.Context, in *ProjectorRequest, opts ...grpc.CallOption) (*HdmiRangeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(HdmiRangeResponse)
+	err := c.cc.Invoke(ctx, Source_GetHdmiRange_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sourceClient) SetCEC(ctx context.Context, in *SetBoolRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Source_SetCEC_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sourceClient) GetCEC(ctx context.Context, in *ProjectorRequest, opts ...grpc.CallOption) (*BoolResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BoolResponse)
+	err := c.cc.Invoke(ctx, Source_GetCEC_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sourceClient) ShiftHorizontalPosition(ctx context.Context, in *SetBoolRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Source_ShiftHorizontalPosition_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sourceClient) GetHorizontalPosition(ctx context.Context, in *ProjectorRequest, opts ...grpc.CallOption) (*Int32Response, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Int32Response)
+	err := c.cc.Invoke(ctx, Source_GetHorizontalPosition_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sourceClient) ShiftVerticalPosition(ctx context.Context, in *SetBoolRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Source_ShiftVerticalPosition_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sourceClient) GetVerticalPosition(ctx context.Context, in *ProjectorRequest, opts ...grpc.CallOption) (*Int32Response, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Int32Response)
+	err := c.cc.Invoke(ctx, Source_GetVerticalPosition_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sourceClient) IncreaseKeystoneVertical(ctx context.Context, in *ProjectorRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Source_IncreaseKeystoneVertical_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sourceClient) DecreaseKeystoneVertical(ctx context.Context, in *ProjectorRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Source_DecreaseKeystoneVertical_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sourceClient) GetKeystoneVertical(ctx context.Context, in *ProjectorRequest, opts ...grpc.CallOption) (*Int32Response, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Int32Response)
+	err := c.cc.Invoke(ctx, Source_GetKeystoneVertical_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sourceClient) IncreaseKeystoneHorizontal(ctx context.Context, in *ProjectorRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Source_IncreaseKeystoneHorizontal_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sourceClient) DecreaseKeystoneHorizontal(ctx context.Context, in *ProjectorRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Source_DecreaseKeystoneHorizontal_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sourceClient) GetKeystoneHorizontal(ctx context.Context, in *ProjectorRequest, opts ...grpc.CallOption) (*Int32Response, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Int32Response)
+	err := c.cc.Invoke(ctx, Source_GetKeystoneHorizontal_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SourceServer is the server API for Source service.
+// All implementations must embed UnimplementedSourceServer
+// for forward compatibility.
+type SourceServer interface {
+	SetSourceInput(context.Context, *SetSourceInputRequest) (*emptypb.Empty, error)
+	GetSourceInput(context.Context, *ProjectorRequest) (*SourceInputResponse, error)
+	SetQuickAutoSearch(context.Context, *SetBoolRequest) (*emptypb.Empty, error)
+	GetQuickAutoSearch(context.Context, *ProjectorRequest) (*BoolResponse, error)
+	SetHdmiFormat(context.Context, *SetHdmiFormatRequest) (*emptypb.Empty, error)
+	GetHdmiFormat(context.Context, *ProjectorRequest) (*HdmiFormatResponse, error)
+	SetHdmiRange(context.Context, *SetHdmiRangeRequest) (*emptypb.Empty, error)
+	GetHdmiRange(context.Context, *ProjectorRequest) (*HdmiRangeResponse, error)
+	SetCEC(context.Context, *SetBoolRequest) (*emptypb.Empty, error)
+	GetCEC(context.Context, *ProjectorRequest) (*BoolResponse, error)
+	// value true shifts right.
+	ShiftHorizontalPosition(context.Context, *SetBoolRequest) (*emptypb.Empty, error)
+	GetHorizontalPosition(context.Context, *ProjectorRequest) (*Int32Response, error)
+	// value true shifts up.
+	ShiftVerticalPosition(context.Context, *SetBoolRequest) (*emptypb.Empty, error)
+	GetVerticalPosition(context.Context, *ProjectorRequest) (*Int32Response, error)
+	IncreaseKeystoneVertical(context.Context, *ProjectorRequest) (*emptypb.Empty, error)
+	DecreaseKeystoneVertical(context.Context, *ProjectorRequest) (*emptypb.Empty, error)
+	GetKeystoneVertical(context.Context, *ProjectorRequest) (*Int32Response, error)
+	IncreaseKeystoneHorizontal(context.Context, *ProjectorRequest) (*emptypb.Empty, error)
+	DecreaseKeystoneHorizontal(context.Context, *ProjectorRequest) (*emptypb.Empty, error)
+	GetKeystoneHorizontal(context.Context, *ProjectorRequest) (*Int32Response, error)
+	mustEmbedUnimplementedSourceServer()
+}
+
+// UnimplementedSourceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedSourceServer struct{}
+
+func (UnimplementedSourceServer) SetSourceInput(context.Context, *SetSourceInputRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetSourceInput not implemented")
+}
+func (UnimplementedSourceServer) GetSourceInput(context.Context, *ProjectorRequest) (*SourceInputResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetSourceInput not implemented")
+}
+func (UnimplementedSourceServer) SetQuickAutoSearch(context.Context, *SetBoolRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetQuickAutoSearch not implemented")
+}
+func (UnimplementedSourceServer) GetQuickAutoSearch(context.Context, *ProjectorRequest) (*BoolResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetQuickAutoSearch not implemented")
+}
+func (UnimplementedSourceServer) SetHdmiFormat(context.Context, *SetHdmiFormatRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetHdmiFormat not implemented")
+}
+func (UnimplementedSourceServer) GetHdmiFormat(context.Context, *ProjectorRequest) (*HdmiFormatResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetHdmiFormat not implemented")
+}
+func (UnimplementedSourceServer) SetHdmiRange(context.Context, *SetHdmiRangeRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetHdmiRange not implemented")
+}
+func (UnimplementedSourceServer) GetHdmiRange(context.Context, *ProjectorRequest) (*HdmiRangeResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetHdmiRange not implemented")
+}
+func (UnimplementedSourceServer) SetCEC(context.Context, *SetBoolRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetCEC not implemented")
+}
+func (UnimplementedSourceServer) GetCEC(context.Context, *ProjectorRequest) (*BoolResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetCEC not implemented")
+}
+func (UnimplementedSourceServer) ShiftHorizontalPosition(context.Context, *SetBoolRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method ShiftHorizontalPosition not implemented")
+}
+func (UnimplementedSourceServer) GetHorizontalPosition(context.Context, *ProjectorRequest) (*Int32Response, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetHorizontalPosition not implemented")
+}
+func (UnimplementedSourceServer) ShiftVerticalPosition(context.Context, *SetBoolRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method ShiftVerticalPosition not implemented")
+}
+func (UnimplementedSourceServer) GetVerticalPosition(context.Context, *ProjectorRequest) (*Int32Response, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetVerticalPosition not implemented")
+}
+func (UnimplementedSourceServer) IncreaseKeystoneVertical(context.Context, *ProjectorRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method IncreaseKeystoneVertical not implemented")
+}
+func (UnimplementedSourceServer) DecreaseKeystoneVertical(context.Context, *ProjectorRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method DecreaseKeystoneVertical not implemented")
+}
+func (UnimplementedSourceServer) GetKeystoneVertical(context.Context, *ProjectorRequest) (*Int32Response, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetKeystoneVertical not implemented")
+}
+func (UnimplementedSourceServer) IncreaseKeystoneHorizontal(context.Context, *ProjectorRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method IncreaseKeystoneHorizontal not implemented")
+}
+func (UnimplementedSourceServer) DecreaseKeystoneHorizontal(context.Context, *ProjectorRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method DecreaseKeystoneHorizontal not implemented")
+}
+func (UnimplementedSourceServer) GetKeystoneHorizontal(context.Context, *ProjectorRequest) (*Int32Response, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetKeystoneHorizontal not implemented")
+}
+func (UnimplementedSourceServer) mustEmbedUnimplementedSourceServer() {}
+func (UnimplementedSourceServer) testEmbeddedByValue()                {}
+
+// UnsafeSourceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to SourceServer will
+// result in compilation errors.
+type UnsafeSourceServer interface {
+	mustEmbedUnimplementedSourceServer()
+}
+
+func RegisterSourceServer(s grpc.ServiceRegistrar, srv SourceServer) {
+	// If the following call panics, it indicates UnimplementedSourceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Source_ServiceDesc, srv)
+}
+
+func _Source_SetSourceInput_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetSourceInputRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SourceServer).SetSourceInput(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Source_SetSourceInput_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SourceServer).SetSourceInput(ctx, req.(*SetSourceInputRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Source_GetSourceInput_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProjectorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SourceServer).GetSourceInput(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Source_GetSourceInput_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SourceServer).GetSourceInput(ctx, req.(*ProjectorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Source_SetQuickAutoSearch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetBoolRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SourceServer).SetQuickAutoSearch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Source_SetQuickAutoSearch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SourceServer).SetQuickAutoSearch(ctx, req.(*SetBoolRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Source_GetQuickAutoSearch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProjectorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SourceServer).GetQuickAutoSearch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Source_GetQuickAutoSearch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SourceServer).GetQuickAutoSearch(ctx, req.(*ProjectorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Source_SetHdmiFormat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetHdmiFormatRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SourceServer).SetHdmiFormat(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Source_SetHdmiFormat_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SourceServer).SetHdmiFormat(ctx, req.(*SetHdmiFormatRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Source_GetHdmiFormat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProjectorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SourceServer).GetHdmiFormat(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Source_GetHdmiFormat_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SourceServer).GetHdmiFormat(ctx, req.(*ProjectorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Source_SetHdmiRange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetHdmiRangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SourceServer).SetHdmiRange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Source_SetHdmiRange_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SourceServer).SetHdmiRange(ctx, req.(*SetHdmiRangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Source_GetHdmiRange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProjectorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SourceServer).GetHdmiRange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Source_GetHdmiRange_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SourceServer).GetHdmiRange(ctx, req.(*ProjectorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Source_SetCEC_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetBoolRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SourceServer).SetCEC(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Source_SetCEC_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SourceServer).SetCEC(ctx, req.(*SetBoolRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Source_GetCEC_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProjectorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SourceServer).GetCEC(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Source_GetCEC_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SourceServer).GetCEC(ctx, req.(*ProjectorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Source_ShiftHorizontalPosition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetBoolRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SourceServer).ShiftHorizontalPosition(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Source_ShiftHorizontalPosition_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SourceServer).ShiftHorizontalPosition(ctx, req.(*SetBoolRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Source_GetHorizontalPosition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProjectorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SourceServer).GetHorizontalPosition(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Source_GetHorizontalPosition_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SourceServer).GetHorizontalPosition(ctx, req.(*ProjectorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Source_ShiftVerticalPosition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetBoolRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SourceServer).ShiftVerticalPosition(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Source_ShiftVerticalPosition_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SourceServer).ShiftVerticalPosition(ctx, req.(*SetBoolRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Source_GetVerticalPosition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProjectorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SourceServer).GetVerticalPosition(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Source_GetVerticalPosition_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SourceServer).GetVerticalPosition(ctx, req.(*ProjectorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Source_IncreaseKeystoneVertical_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProjectorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SourceServer).IncreaseKeystoneVertical(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Source_IncreaseKeystoneVertical_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SourceServer).IncreaseKeystoneVertical(ctx, req.(*ProjectorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Source_DecreaseKeystoneVertical_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProjectorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SourceServer).DecreaseKeystoneVertical(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Source_DecreaseKeystoneVertical_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SourceServer).DecreaseKeystoneVertical(ctx, req.(*ProjectorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Source_GetKeystoneVertical_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProjectorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SourceServer).GetKeystoneVertical(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Source_GetKeystoneVertical_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SourceServer).GetKeystoneVertical(ctx, req.(*ProjectorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Source_IncreaseKeystoneHorizontal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProjectorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SourceServer).IncreaseKeystoneHorizontal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Source_IncreaseKeystoneHorizontal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SourceServer).IncreaseKeystoneHorizontal(ctx, req.(*ProjectorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Source_DecreaseKeystoneHorizontal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProjectorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SourceServer).DecreaseKeystoneHorizontal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Source_DecreaseKeystoneHorizontal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SourceServer).DecreaseKeystoneHorizontal(ctx, req.(*ProjectorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Source_GetKeystoneHorizontal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProjectorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SourceServer).GetKeystoneHorizontal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Source_GetKeystoneHorizontal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SourceServer).GetKeystoneHorizontal(ctx, req.(*ProjectorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Source_ServiceDesc is the grpc.ServiceDesc for Source service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Source_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "viewsonic.v1.Source",
+	HandlerType: (*SourceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SetSourceInput",
+			Handler:    _Source_SetSourceInput_Handler,
+		},
+		{
+			MethodName: "GetSourceInput",
+			Handler:    _Source_GetSourceInput_Handler,
+		},
+		{
+			MethodName: "SetQuickAutoSearch",
+			Handler:    _Source_SetQuickAutoSearch_Handler,
+		},
+		{
+			MethodName: "GetQuickAutoSearch",
+			Handler:    _Source_GetQuickAutoSearch_Handler,
+		},
+		{
+			MethodName: "SetHdmiFormat",
+			Handler:    _Source_SetHdmiFormat_Handler,
+		},
+		{
+			MethodName: "GetHdmiFormat",
+			Handler:    _Source_GetHdmiFormat_Handler,
+		},
+		{
+			MethodName: "SetHdmiRange",
+			Handler:    _Source_SetHdmiRange_Handler,
+		},
+		{
+			MethodName: "GetHdmiRange",
+			Handler:    _Source_GetHdmiRange_Handler,
+		},
+		{
+			MethodName: "SetCEC",
+			Handler:    _Source_SetCEC_Handler,
+		},
+		{
+			MethodName: "GetCEC",
+			Handler:    _Source_GetCEC_Handler,
+		},
+		{
+			MethodName: "ShiftHorizontalPosition",
+			Handler:    _Source_ShiftHorizontalPosition_Handler,
+		},
+		{
+			MethodName: "GetHorizontalPosition",
+			Handler:    _Source_GetHorizontalPosition_Handler,
+		},
+		{
+			MethodName: "ShiftVerticalPosition",
+			Handler:    _Source_ShiftVerticalPosition_Handler,
+		},
+		{
+			MethodName: "GetVerticalPosition",
+			Handler:    _Source_GetVerticalPosition_Handler,
+		},
+		{
+			MethodName: "IncreaseKeystoneVertical",
+			Handler:    _Source_IncreaseKeystoneVertical_Handler,
+		},
+		{
+			MethodName: "DecreaseKeystoneVertical",
+			Handler:    _Source_DecreaseKeystoneVertical_Handler,
+		},
+		{
+			MethodName: "GetKeystoneVertical",
+			Handler:    _Source_GetKeystoneVertical_Handler,
+		},
+		{
+			MethodName: "IncreaseKeystoneHorizontal",
+			Handler:    _Source_IncreaseKeystoneHorizontal_Handler,
+		},
+		{
+			MethodName: "DecreaseKeystoneHorizontal",
+			Handler:    _Source_DecreaseKeystoneHorizontal_Handler,
+		},
+		{
+			MethodName: "GetKeystoneHorizontal",
+			Handler:    _Source_GetKeystoneHorizontal_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "viewsonic.proto",
+}
+
+const (
+	Image_SetSplashScreen_FullMethodName      = "/viewsonic.v1.Image/SetSplashScreen"
+	Image_GetSplashScreen_FullMethodName      = "/viewsonic.v1.Image/GetSplashScreen"
+	Image_SetProjectorPosition_FullMethodName = "/viewsonic.v1.Image/SetProjectorPosition"
+	Image_GetProjectorPosition_FullMethodName = "/viewsonic.v1.Image/GetProjectorPosition"
+	Image_IncreaseContrast_FullMethodName     = "/viewsonic.v1.Image/IncreaseContrast"
+	Image_DecreaseContrast_FullMethodName     = "/viewsonic.v1.Image/DecreaseContrast"
+	Image_GetContrast_FullMethodName          = "/viewsonic.v1.Image/GetContrast"
+	Image_IncreaseBrightness_FullMethodName   = "/viewsonic.v1.Image/IncreaseBrightness"
+	Image_DecreaseBrightness_FullMethodName   = "/viewsonic.v1.Image/DecreaseBrightness"
+	Image_GetBrightness_FullMethodName        = "/viewsonic.v1.Image/GetBrightness"
+	Image_SetAspectRatio_FullMethodName       = "/viewsonic.v1.Image/SetAspectRatio"
+	Image_GetAspectRatio_FullMethodName       = "/viewsonic.v1.Image/GetAspectRatio"
+	Image_CycleAspectRatio_FullMethodName     = "/viewsonic.v1.Image/CycleAspectRatio"
+	Image_AutoAdjust_FullMethodName           = "/viewsonic.v1.Image/AutoAdjust"
+	Image_SetBlank_FullMethodName             = "/viewsonic.v1.Image/SetBlank"
+	Image_GetBlank_FullMethodName             = "/viewsonic.v1.Image/GetBlank"
+	Image_SetFreeze_FullMethodName            = "/viewsonic.v1.Image/SetFreeze"
+	Image_GetFreeze_FullMethodName            = "/viewsonic.v1.Image/GetFreeze"
+	Image_SetOverScan_FullMethodName          = "/viewsonic.v1.Image/SetOverScan"
+	Image_GetOverScan_FullMethodName          = "/viewsonic.v1.Image/GetOverScan"
+	Image_SetThreeDSyncMode_FullMethodName    = "/viewsonic.v1.Image/SetThreeDSyncMode"
+	Image_GetThreeDSyncMode_FullMethodName    = "/viewsonic.v1.Image/GetThreeDSyncMode"
+	Image_SetThreeDSyncInvert_FullMethodName  = "/viewsonic.v1.Image/SetThreeDSyncInvert"
+	Image_GetThreeDSyncInvert_FullMethodName  = "/viewsonic.v1.Image/GetThreeDSyncInvert"
+)
+
+// ImageClient is the client API for Image service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ImageClient interface {
+	SetSplashScreen(ctx context.Context, in *SetSplashScreenRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetSplashScreen(ctx context.Context, in *ProjectorRequest, opts ...grpc.CallOption) (*SplashScreenResponse, error)
+	SetProjectorPosition(ctx context.Context, in *SetProjectorPositionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetProjectorPosition(ctx context.Context, in *ProjectorRequest, opts ...grpc.CallOption) (*ProjectorPositionResponse, error)
+	IncreaseContrast(ctx context.Context, in *ProjectorRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	DecreaseContrast(ctx context.Context, in *ProjectorRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetContrast(ctx context.Context, in *ProjectorRequest, opts ...grpc.CallOption) (*Int32Response, error)
+	IncreaseBrightness(ctx context.Context, in *ProjectorRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	DecreaseBrightness(ctx context.Context, in *ProjectorRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetBrightness(ctx context.Context, in *ProjectorRequest, opts ...grpc.CallOption) (*Int32Response, error)
+	SetAspectRatio(ctx context.Context, in *SetAspectRatioRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetAspectRatio(ctx context.Context, in *ProjectorRequest, opts ...grpc.CallOption) (*AspectRatioResponse, error)
+	CycleAspectRatio(ctx context.Context, in *ProjectorRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	AutoAdjust(ctx context.Context, in *ProjectorRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	SetBlank(ctx context.Context, in *SetBoolRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetBlank(ctx context.Context, in *ProjectorRequest, opts ...grpc.CallOption) (*BoolResponse, error)
+	SetFreeze(ctx context.Context, in *SetBoolRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetFreeze(ctx context.Context, in *ProjectorRequest, opts ...grpc.CallOption) (*BoolResponse, error)
+	// Values above 5 are clamped.
+	SetOverScan(ctx context.Context, in *SetInt32Request, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetOverScan(ctx context.Context, in *ProjectorRequest, opts ...grpc.CallOption) (*Int32Response, error)
+	SetThreeDSyncMode(ctx context.Context, in *SetThreeDSyncModeRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetThreeDSyncMode(ctx context.Context, in *ProjectorRequest, opts ...grpc.CallOption) (*ThreeDSyncModeResponse, error)
+	SetThreeDSyncInvert(ctx context.Context, in *SetBoolRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetThreeDSyncInvert(ctx context.Context, in *ProjectorRequest, opts ...grpc.CallOption) (*BoolResponse, error)
+}
+
+type imageClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewImageClient(cc grpc.ClientConnInterface) ImageClient {
+	return &imageClient{cc}
+}
+
+func (c *imageClient) SetSplashScreen(ctx context.Context, in *SetSplashScreenRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Image_SetSplashScreen_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *imageClient) GetSplashScreen(ctx context.Context, in *ProjectorRequest, opts ...grpc.CallOption) (*SplashScreenResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SplashScreenResponse)
+	err := c.cc.Invoke(ctx, Image_GetSplashScreen_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *imageClient) SetProjectorPosition(ctx context.Context, in *SetProjectorPositionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Image_SetProjectorPosition_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *imageClient) GetProjectorPosition(ctx context.Context, in *ProjectorRequest, opts ...grpc.CallOption) (*ProjectorPositionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProjectorPositionResponse)
+	err := c.cc.Invoke(ctx, Image_GetProjectorPosition_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *imageClient) IncreaseContrast(ctx context.Context, in *ProjectorRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Image_IncreaseContrast_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *imageClient) DecreaseContrast(ctx context.Context, in *ProjectorRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Image_DecreaseContrast_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *imageClient) GetContrast(ctx context.Context, in *ProjectorRequest, opts ...grpc.CallOption) (*Int32Response, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Int32Response)
+	err := c.cc.Invoke(ctx, Image_GetContrast_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *imageClient) IncreaseBrightness(ctx context.Context, in *ProjectorRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Image_IncreaseBrightness_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *imageClient) DecreaseBrightness(ctx context.Context, in *ProjectorRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Image_DecreaseBrightness_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *imageClient) GetBrightness(ctx context.Context, in *ProjectorRequest, opts ...grpc.CallOption) (*Int32Response, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Int32Response)
+	err := c.cc.Invoke(ctx, Image_GetBrightness_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *imageClient) SetAspectRatio(ctx context.Context, in *SetAspectRatioRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Image_SetAspectRatio_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *imageClient) GetAspectRatio(ctx context.Context, in *ProjectorRequest, opts ...grpc.CallOption) (*AspectRatioResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AspectRatioResponse)
+	err := c.cc.Invoke(ctx, Image_GetAspectRatio_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *imageClient) CycleAspectRatio(ctx context.Context, in *ProjectorRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Image_CycleAspectRatio_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *imageClient) AutoAdjust(ctx context.Context, in *ProjectorRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Image_AutoAdjust_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *imageClient) SetBlank(ctx context.Context, in *SetBoolRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Image_SetBlank_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *imageClient) GetBlank(ctx context.Context, in *ProjectorRequest, opts ...grpc.CallOption) (*BoolResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BoolResponse)
+	err := c.cc.Invoke(ctx, Image_GetBlank_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *imageClient) SetFreeze(ctx context.Context, in *SetBoolRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Image_SetFreeze_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *imageClient) GetFreeze(ctx context.Context, in *ProjectorRequest, opts ...grpc.CallOption) (*BoolResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BoolResponse)
+	err := c.cc.Invoke(ctx, Image_GetFreeze_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *imageClient) SetOverScan(ctx context.Context, in *SetInt32Request, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Image_SetOverScan_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *imageClient) GetOverScan(ctx context.Context, in *ProjectorRequest, opts ...grpc.CallOption) (*Int32Response, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Int32Response)
+	err := c.cc.Invoke(ctx, Image_GetOverScan_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *imageClient) SetThreeDSyncMode(ctx context.Context, in *SetThreeDSyncModeRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Image_SetThreeDSyncMode_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *imageClient) GetThreeDSyncMode(ctx context.Context, in *ProjectorRequest, opts ...grpc.CallOption) (*ThreeDSyncModeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ThreeDSyncModeResponse)
+	err := c.cc.Invoke(ctx, Image_GetThreeDSyncMode_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *imageClient) SetThreeDSyncInvert(ctx context.Context, in *SetBoolRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Image_SetThreeDSyncInvert_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *imageClient) GetThreeDSyncInvert(ctx context.Context, in *ProjectorRequest, opts ...grpc.CallOption) (*BoolResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BoolResponse)
+	err := c.cc.Invoke(ctx, Image_GetThreeDSyncInvert_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ImageServer is the server API for Image service.
+// All implementations must embed UnimplementedImageServer
+// for forward compatibility.
+type ImageServer interface {
+	SetSplashScreen(context.Context, *SetSplashScreenRequest) (*emptypb.Empty, error)
+	GetSplashScreen(context.Context, *ProjectorRequest) (*SplashScreenResponse, error)
+	SetProjectorPosition(context.Context, *SetProjectorPositionRequest) (*emptypb.Empty, error)
+	GetProjectorPosition(context.Context, *ProjectorRequest) (*ProjectorPositionResponse, error)
+	IncreaseContrast(context.Context, *ProjectorRequest) (*emptypb.Empty, error)
+	DecreaseContrast(context.Context, *ProjectorRequest) (*emptypb.Empty, error)
+	GetContrast(context.Context, *ProjectorRequest) (*Int32Response, error)
+	IncreaseBrightness(context.Context, *ProjectorRequest) (*emptypb.Empty, error)
+	DecreaseBrightness(context.Context, *ProjectorRequest) (*emptypb.Empty, error)
+	GetBrightness(context.Context, *ProjectorRequest) (*Int32Response, error)
+	SetAspectRatio(context.Context, *SetAspectRatioRequest) (*emptypb.Empty, error)
+	GetAspectRatio(context.Context, *ProjectorRequest) (*AspectRatioResponse, error)
+	CycleAspectRatio(context.Context, *ProjectorRequest) (*emptypb.Empty, error)
+	AutoAdjust(context.Context, *ProjectorRequest) (*emptypb.Empty, error)
+	SetBlank(context.Context, *SetBoolRequest) (*emptypb.Empty, error)
+	GetBlank(context.Context, *ProjectorRequest) (*BoolResponse, error)
+	SetFreeze(context.Context, *SetBoolRequest) (*emptypb.Empty, error)
+	GetFreeze(context.Context, *ProjectorRequest) (*BoolResponse, error)
+	// Values above 5 are clamped.
+	SetOverScan(context.Context, *SetInt32Request) (*emptypb.Empty, error)
+	GetOverScan(context.Context, *ProjectorRequest) (*Int32Response, error)
+	SetThreeDSyncMode(context.Context, *SetThreeDSyncModeRequest) (*emptypb.Empty, error)
+	GetThreeDSyncMode(context.Context, *ProjectorRequest) (*ThreeDSyncModeResponse, error)
+	SetThreeDSyncInvert(context.Context, *SetBoolRequest) (*emptypb.Empty, error)
+	GetThreeDSyncInvert(context.Context, *ProjectorRequest) (*BoolResponse, error)
+	mustEmbedUnimplementedImageServer()
+}
+
+// UnimplementedImageServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedImageServer struct{}
+
+func (UnimplementedImageServer) SetSplashScreen(context.Context, *SetSplashScreenRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetSplashScreen not implemented")
+}
+func (UnimplementedImageServer) GetSplashScreen(context.Context, *ProjectorRequest) (*SplashScreenResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetSplashScreen not implemented")
+}
+func (UnimplementedImageServer) SetProjectorPosition(context.Context, *SetProjectorPositionRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetProjectorPosition not implemented")
+}
+func (UnimplementedImageServer) GetProjectorPosition(context.Context, *ProjectorRequest) (*ProjectorPositionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetProjectorPosition not implemented")
+}
+func (UnimplementedImageServer) IncreaseContrast(context.Context, *ProjectorRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method IncreaseContrast not implemented")
+}
+func (UnimplementedImageServer) DecreaseContrast(context.Context, *ProjectorRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method DecreaseContrast not implemented")
+}
+func (UnimplementedImageServer) GetContrast(context.Context, *ProjectorRequest) (*Int32Response, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetContrast not implemented")
+}
+func (UnimplementedImageServer) IncreaseBrightness(context.Context, *ProjectorRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method IncreaseBrightness not implemented")
+}
+func (UnimplementedImageServer) DecreaseBrightness(context.Context, *ProjectorRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method DecreaseBrightness not implemented")
+}
+func (UnimplementedImageServer) GetBrightness(context.Context, *ProjectorRequest) (*Int32Response, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetBrightness not implemented")
+}
+func (UnimplementedImageServer) SetAspectRatio(context.Context, *SetAspectRatioRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetAspectRatio not implemented")
+}
+func (UnimplementedImageServer) GetAspectRatio(context.Context, *ProjectorRequest) (*AspectRatioResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetAspectRatio not implemented")
+}
+func (UnimplementedImageServer) CycleAspectRatio(context.Context, *ProjectorRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method CycleAspectRatio not implemented")
+}
+func (UnimplementedImageServer) AutoAdjust(context.Context, *ProjectorRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method AutoAdjust not implemented")
+}
+func (UnimplementedImageServer) SetBlank(context.Context, *SetBoolRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetBlank not implemented")
+}
+func (UnimplementedImageServer) GetBlank(context.Context, *ProjectorRequest) (*BoolResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetBlank not implemented")
+}
+func (UnimplementedImageServer) SetFreeze(context.Context, *SetBoolRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetFreeze not implemented")
+}
+func (UnimplementedImageServer) GetFreeze(context.Context, *ProjectorRequest) (*BoolResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetFreeze not implemented")
+}
+func (UnimplementedImageServer) SetOverScan(context.Context, *SetInt32Request) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetOverScan not implemented")
+}
+func (UnimplementedImageServer) GetOverScan(context.Context, *ProjectorRequest) (*Int32Response, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetOverScan not implemented")
+}
+func (UnimplementedImageServer) SetThreeDSyncMode(context.Context, *SetThreeDSyncModeRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetThreeDSyncMode not implemented")
+}
+func (UnimplementedImageServer) GetThreeDSyncMode(context.Context, *ProjectorRequest) (*ThreeDSyncModeResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetThreeDSyncMode not implemented")
+}
+func (UnimplementedImageServer) SetThreeDSyncInvert(context.Context, *SetBoolRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetThreeDSyncInvert not implemented")
+}
+func (UnimplementedImageServer) GetThreeDSyncInvert(context.Context, *ProjectorRequest) (*BoolResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetThreeDSyncInvert not implemented")
+}
+func (UnimplementedImageServer) mustEmbedUnimplementedImageServer() {}
+func (UnimplementedImageServer) testEmbeddedByValue()               {}
+
+// UnsafeImageServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ImageServer will
+// result in compilation errors.
+type UnsafeImageServer interface {
+	mustEmbedUnimplementedImageServer()
+}
+
+func RegisterImageServer(s grpc.ServiceRegistrar, srv ImageServer) {
+	// If the following call panics, it indicates UnimplementedImageServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Image_ServiceDesc, srv)
+}
+
+func _Image_SetSplashScreen_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetSplashScreenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ImageServer).SetSplashScreen(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Image_SetSplashScreen_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ImageServer).SetSplashScreen(ctx, req.(*SetSplashScreenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Image_GetSplashScreen_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProjectorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ImageServer).GetSplashScreen(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Image_GetSplashScreen_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ImageServer).GetSplashScreen(ctx, req.(*ProjectorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Image_SetProjectorPosition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetProjectorPositionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ImageServer).SetProjectorPosition(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Image_SetProjectorPosition_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ImageServer).SetProjectorPosition(ctx, req.(*SetProjectorPositionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Image_GetProjectorPosition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProjectorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ImageServer).GetProjectorPosition(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Image_GetProjectorPosition_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ImageServer).GetProjectorPosition(ctx, req.(*ProjectorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Image_IncreaseContrast_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProjectorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ImageServer).IncreaseContrast(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Image_IncreaseContrast_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ImageServer).IncreaseContrast(ctx, req.(*ProjectorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Image_DecreaseContrast_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProjectorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ImageServer).DecreaseContrast(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Image_DecreaseContrast_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ImageServer).DecreaseContrast(ctx, req.(*ProjectorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Image_GetContrast_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProjectorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ImageServer).GetContrast(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Image_GetContrast_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ImageServer).GetContrast(ctx, req.(*ProjectorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Image_IncreaseBrightness_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProjectorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ImageServer).IncreaseBrightness(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Image_IncreaseBrightness_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ImageServer).IncreaseBrightness(ctx, req.(*ProjectorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Image_DecreaseBrightness_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProjectorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ImageServer).DecreaseBrightness(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Image_DecreaseBrightness_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ImageServer).DecreaseBrightness(ctx, req.(*ProjectorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Image_GetBrightness_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProjectorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ImageServer).GetBrightness(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Image_GetBrightness_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ImageServer).GetBrightness(ctx, req.(*ProjectorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Image_SetAspectRatio_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetAspectRatioRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ImageServer).SetAspectRatio(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Image_SetAspectRatio_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ImageServer).SetAspectRatio(ctx, req.(*SetAspectRatioRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Image_GetAspectRatio_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProjectorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ImageServer).GetAspectRatio(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Image_GetAspectRatio_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ImageServer).GetAspectRatio(ctx, req.(*ProjectorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Image_CycleAspectRatio_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProjectorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ImageServer).CycleAspectRatio(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Image_CycleAspectRatio_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ImageServer).CycleAspectRatio(ctx, req.(*ProjectorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Image_AutoAdjust_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProjectorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ImageServer).AutoAdjust(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Image_AutoAdjust_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ImageServer).AutoAdjust(ctx, req.(*ProjectorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Image_SetBlank_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetBoolRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ImageServer).SetBlank(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Image_SetBlank_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ImageServer).SetBlank(ctx, req.(*SetBoolRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Image_GetBlank_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProjectorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ImageServer).GetBlank(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Image_GetBlank_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ImageServer).GetBlank(ctx, req.(*ProjectorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Image_SetFreeze_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetBoolRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ImageServer).SetFreeze(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Image_SetFreeze_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ImageServer).SetFreeze(ctx, req.(*SetBoolRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Image_GetFreeze_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProjectorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ImageServer).GetFreeze(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Image_GetFreeze_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ImageServer).GetFreeze(ctx, req.(*ProjectorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Image_SetOverScan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetInt32Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ImageServer).SetOverScan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Image_SetOverScan_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ImageServer).SetOverScan(ctx, req.(*SetInt32Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Image_GetOverScan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProjectorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ImageServer).GetOverScan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Image_GetOverScan_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ImageServer).GetOverScan(ctx, req.(*ProjectorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Image_SetThreeDSyncMode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetThreeDSyncModeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ImageServer).SetThreeDSyncMode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Image_SetThreeDSyncMode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ImageServer).SetThreeDSyncMode(ctx, req.(*SetThreeDSyncModeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Image_GetThreeDSyncMode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProjectorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ImageServer).GetThreeDSyncMode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Image_GetThreeDSyncMode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ImageServer).GetThreeDSyncMode(ctx, req.(*ProjectorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Image_SetThreeDSyncInvert_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetBoolRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ImageServer).SetThreeDSyncInvert(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Image_SetThreeDSyncInvert_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ImageServer).SetThreeDSyncInvert(ctx, req.(*SetBoolRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Image_GetThreeDSyncInvert_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProjectorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ImageServer).GetThreeDSyncInvert(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Image_GetThreeDSyncInvert_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ImageServer).GetThreeDSyncInvert(ctx, req.(*ProjectorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Image_ServiceDesc is the grpc.ServiceDesc for Image service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Image_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "viewsonic.v1.Image",
+	HandlerType: (*ImageServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SetSplashScreen",
+			Handler:    _Image_SetSplashScreen_Handler,
+		},
+		{
+			MethodName: "GetSplashScreen",
+			Handler:    _Image_GetSplashScreen_Handler,
+		},
+		{
+			MethodName: "SetProjectorPosition",
+			Handler:    _Image_SetProjectorPosition_Handler,
+		},
+		{
+			MethodName: "GetProjectorPosition",
+			Handler:    _Image_GetProjectorPosition_Handler,
+		},
+		{
+			MethodName: "IncreaseContrast",
+			Handler:    _Image_IncreaseContrast_Handler,
+		},
+		{
+			MethodName: "DecreaseContrast",
+			Handler:    _Image_DecreaseContrast_Handler,
+		},
+		{
+			MethodName: "GetContrast",
+			Handler:    _Image_GetContrast_Handler,
+		},
+		{
+			MethodName: "IncreaseBrightness",
+			Handler:    _Image_IncreaseBrightness_Handler,
+		},
+		{
+			MethodName: "DecreaseBrightness",
+			Handler:    _Image_DecreaseBrightness_Handler,
+		},
+		{
+			MethodName: "GetBrightness",
+			Handler:    _Image_GetBrightness_Handler,
+		},
+		{
+			MethodName: "SetAspectRatio",
+			Handler:    _Image_SetAspectRatio_Handler,
+		},
+		{
+			MethodName: "GetAspectRatio",
+			Handler:    _Image_GetAspectRatio_Handler,
+		},
+		{
+			MethodName: "CycleAspectRatio",
+			Handler:    _Image_CycleAspectRatio_Handler,
+		},
+		{
+			MethodName: "AutoAdjust",
+			Handler:    _Image_AutoAdjust_Handler,
+		},
+		{
+			MethodName: "SetBlank",
+			Handler:    _Image_SetBlank_Handler,
+		},
+		{
+			MethodName: "GetBlank",
+			Handler:    _Image_GetBlank_Handler,
+		},
+		{
+			MethodName: "SetFreeze",
+			Handler:    _Image_SetFreeze_Handler,
+		},
+		{
+			MethodName: "GetFreeze",
+			Handler:    _Image_GetFreeze_Handler,
+		},
+		{
+			MethodName: "SetOverScan",
+			Handler:    _Image_SetOverScan_Handler,
+		},
+		{
+			MethodName: "GetOverScan",
+			Handler:    _Image_GetOverScan_Handler,
+		},
+		{
+			MethodName: "SetThreeDSyncMode",
+			Handler:    _Image_SetThreeDSyncMode_Handler,
+		},
+		{
+			MethodName: "GetThreeDSyncMode",
+			Handler:    _Image_GetThreeDSyncMode_Handler,
+		},
+		{
+			MethodName: "SetThreeDSyncInvert",
+			Handler:    _Image_SetThreeDSyncInvert_Handler,
+		},
+		{
+			MethodName: "GetThreeDSyncInvert",
+			Handler:    _Image_GetThreeDSyncInvert_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "viewsonic.proto",
+}
+
+const (
+	Color_SetColorTemperature_FullMethodName       = "/viewsonic.v1.Color/SetColorTemperature"
+	Color_GetColorTemperature_FullMethodName       = "/viewsonic.v1.Color/GetColorTemperature"
+	Color_SetColorMode_FullMethodName              = "/viewsonic.v1.Color/SetColorMode"
+	Color_GetColorMode_FullMethodName              = "/viewsonic.v1.Color/GetColorMode"
+	Color_CycleColorMode_FullMethodName            = "/viewsonic.v1.Color/CycleColorMode"
+	Color_SelectPrimaryColor_FullMethodName        = "/viewsonic.v1.Color/SelectPrimaryColor"
+	Color_GetSelectedPrimaryColor_FullMethodName   = "/viewsonic.v1.Color/GetSelectedPrimaryColor"
+	Color_IncreaseHue_FullMethodName               = "/viewsonic.v1.Color/IncreaseHue"
+	Color_DecreaseHue_FullMethodName               = "/viewsonic.v1.Color/DecreaseHue"
+	Color_GetHue_FullMethodName                    = "/viewsonic.v1.Color/GetHue"
+	Color_IncreaseSaturation_FullMethodName        = "/viewsonic.v1.Color/IncreaseSaturation"
+	Color_DecreaseSaturation_FullMethodName        = "/viewsonic.v1.Color/DecreaseSaturation"
+	Color_GetSaturation_FullMethodName             = "/viewsonic.v1.Color/GetSaturation"
+	Color_IncreaseSharpness_FullMethodName         = "/viewsonic.v1.Color/IncreaseSharpness"
+	Color_DecreaseSharpness_FullMethodName         = "/viewsonic.v1.Color/DecreaseSharpness"
+	Color_GetSharpness_FullMethodName              = "/viewsonic.v1.Color/GetSharpness"
+	Color_IncreaseGain_FullMethodName              = "/viewsonic.v1.Color/IncreaseGain"
+	Color_DecreaseGain_FullMethodName              = "/viewsonic.v1.Color/DecreaseGain"
+	Color_GetGain_FullMethodName                   = "/viewsonic.v1.Color/GetGain"
+	Color_SetBrilliantColor_FullMethodName         = "/viewsonic.v1.Color/SetBrilliantColor"
+	Color_GetBrilliantColor_FullMethodName         = "/viewsonic.v1.Color/GetBrilliantColor"
+	Color_SetScreenColor_FullMethodName            = "/viewsonic.v1.Color/SetScreenColor"
+	Color_GetScreenColor_FullMethodName            = "/viewsonic.v1.Color/GetScreenColor"
+	Color_ResetCurrentColorSettings_FullMethodName = "/viewsonic.v1.Color/ResetCurrentColorSettings"
+)
+
+// ColorClient is the client API for Color service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ColorClient interface {
+	SetColorTemperature(ctx context.Context, in *SetColorTemperatureRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetColorTemperature(ctx context.Context, in *ProjectorRequest, opts ...grpc.CallOption) (*ColorTemperatureResponse, error)
+	SetColorMode(ctx context.Context, in *SetColorModeRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetColorMode(ctx context.Context, in *ProjectorRequest, opts ...grpc.CallOption) (*ColorModeResponse, error)
+	CycleColorMode(ctx context.Context, in *ProjectorRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	SelectPrimaryColor(ctx context.Context, in *SelectPrimaryColorRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetSelectedPrimaryColor(ctx context.Context, in *ProjectorRequest, opts ...grpc.CallOption) (*PrimaryColorResponse, error)
+	IncreaseHue(ctx context.Context, in *ProjectorRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	DecreaseHue(ctx context.Context, in *ProjectorRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetHue(ctx context.Context, in *ProjectorRequest, opts ...grpc.CallOption) (*Int32Response, error)
+	IncreaseSaturation(ctx context.Context, in *ProjectorRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	DecreaseSaturation(ctx context.Context, in *ProjectorRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetSaturation(ctx context.Context, in *ProjectorRequest, opts ...grpc.CallOption) (*Int32Response, error)
+	IncreaseSharpness(ctx context.Context, in *ProjectorRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	DecreaseSharpness(ctx context.Context, in *ProjectorRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetSharpness(ctx context.Context, in *ProjectorRequest, opts ...grpc.CallOption) (*Int32Response, error)
+	IncreaseGain(ctx context.Context, in *ProjectorRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	DecreaseGain(ctx context.Context, in *ProjectorRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetGain(ctx context.Context, in *ProjectorRequest, opts ...grpc.CallOption) (*Int32Response, error)
+	SetBrilliantColor(ctx context.Context, in *SetInt32Request, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetBrilliantColor(ctx context.Context, in *ProjectorRequest, opts ...grpc.CallOption) (*Int32Response, error)
+	SetScreenColor(ctx context.Context, in *SetScreenColorRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetScreenColor(ctx context.Context, in *ProjectorRequest, opts ...grpc.CallOption) (*ScreenColorResponse, error)
+	ResetCurrentColorSettings(ctx context.Context, in *ProjectorRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+}
+
+type colorClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewColorClient(cc grpc.ClientConnInterface) ColorClient {
+	return &colorClient{cc}
+}
+
+func (c *colorClient) SetColorTemperature(ctx context.Context, in *SetColorTemperatureRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Color_SetColorTemperature_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *colorClient) GetColorTemperature(ctx context.Context, in *ProjectorRequest, opts ...grpc.CallOption) (*ColorTemperatureResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ColorTemperatureResponse)
+	err := c.cc.Invoke(ctx, Color_GetColorTemperature_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *colorClient) SetColorMode(ctx context.Context, in *SetColorModeRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Color_SetColorMode_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *colorClient) GetColorMode(ctx context.Context, in *ProjectorRequest, opts ...grpc.CallOption) (*ColorModeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ColorModeResponse)
+	err := c.cc.Invoke(ctx, Color_GetColorMode_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *colorClient) CycleColorMode(ctx context.Context, in *ProjectorRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Color_CycleColorMode_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *colorClient) SelectPrimaryColor(ctx context.Context, in *SelectPrimaryColorRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Color_SelectPrimaryColor_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *colorClient) GetSelectedPrimaryColor(ctx context.Context, in *ProjectorRequest, opts ...grpc.CallOption) (*PrimaryColorResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PrimaryColorResponse)
+	err := c.cc.Invoke(ctx, Color_GetSelectedPrimaryColor_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *colorClient) IncreaseHue(ctx context.Context, in *ProjectorRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Color_IncreaseHue_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *colorClient) DecreaseHue(ctx context.Context, in *ProjectorRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Color_DecreaseHue_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *colorClient) GetHue(ctx context.Context, in *ProjectorRequest, opts ...grpc.CallOption) (*Int32Response, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Int32Response)
+	err := c.cc.Invoke(ctx, Color_GetHue_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *colorClient) IncreaseSaturation(ctx context.Context, in *ProjectorRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Color_IncreaseSaturation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *colorClient) DecreaseSaturation(ctx context.Context, in *ProjectorRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Color_DecreaseSaturation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *colorClient) GetSaturation(ctx context.Context, in *ProjectorRequest, opts ...grpc.CallOption) (*Int32Response, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Int32Response)
+	err := c.cc.Invoke(ctx, Color_GetSaturation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *colorClient) IncreaseSharpness(ctx context.Context, in *ProjectorRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Color_IncreaseSharpness_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *colorClient) DecreaseSharpness(ctx context.Context, in *ProjectorRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Color_DecreaseSharpness_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *colorClient) GetSharpness(ctx context.Context, in *ProjectorRequest, opts ...grpc.CallOption) (*Int32Response, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Int32Response)
+	err := c.cc.Invoke(ctx, Color_GetSharpness_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *colorClient) IncreaseGain(ctx context.Context, in *ProjectorRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Color_IncreaseGain_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *colorClient) DecreaseGain(ctx context.Context, in *ProjectorRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Color_DecreaseGain_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *colorClient) GetGain(ctx context.Context, in *ProjectorRequest, opts ...grpc.CallOption) (*Int32Response, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Int32Response)
+	err := c.cc.Invoke(ctx, Color_GetGain_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *colorClient) SetBrilliantColor(ctx context.Context, in *SetInt32Request, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Color_SetBrilliantColor_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *colorClient) GetBrilliantColor(ctx context.Context, in *ProjectorRequest, opts ...grpc.CallOption) (*Int32Response, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Int32Response)
+	err := c.cc.Invoke(ctx, Color_GetBrilliantColor_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *colorClient) SetScreenColor(ctx context.Context, in *SetScreenColorRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Color_SetScreenColor_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *colorClient) GetScreenColor(ctx context.Context, in *ProjectorRequest, opts ...grpc.CallOption) (*ScreenColorResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ScreenColorResponse)
+	err := c.cc.Invoke(ctx, Color_GetScreenColor_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *colorClient) ResetCurrentColorSettings(ctx context.Context, in *ProjectorRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Color_ResetCurrentColorSettings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ColorServer is the server API for Color service.
+// All implementations must embed UnimplementedColorServer
+// for forward compatibility.
+type ColorServer interface {
+	SetColorTemperature(context.Context, *SetColorTemperatureRequest) (*emptypb.Empty, error)
+	GetColorTemperature(context.Context, *ProjectorRequest) (*ColorTemperatureResponse, error)
+	SetColorMode(context.Context, *SetColorModeRequest) (*emptypb.Empty, error)
+	GetColorMode(context.Context, *ProjectorRequest) (*ColorModeResponse, error)
+	CycleColorMode(context.Context, *ProjectorRequest) (*emptypb.Empty, error)
+	SelectPrimaryColor(context.Context, *SelectPrimaryColorRequest) (*emptypb.Empty, error)
+	GetSelectedPrimaryColor(context.Context, *ProjectorRequest) (*PrimaryColorResponse, error)
+	IncreaseHue(context.Context, *ProjectorRequest) (*emptypb.Empty, error)
+	DecreaseHue(context.Context, *ProjectorRequest) (*emptypb.Empty, error)
+	GetHue(context.Context, *ProjectorRequest) (*Int32Response, error)
+	IncreaseSaturation(context.Context, *ProjectorRequest) (*emptypb.Empty, error)
+	DecreaseSaturation(context.Context, *ProjectorRequest) (*emptypb.Empty, error)
+	GetSaturation(context.Context, *ProjectorRequest) (*Int32Response, error)
+	IncreaseSharpness(context.Context, *ProjectorRequest) (*emptypb.Empty, error)
+	DecreaseSharpness(context.Context, *ProjectorRequest) (*emptypb.Empty, error)
+	GetSharpness(context.Context, *ProjectorRequest) (*Int32Response, error)
+	IncreaseGain(context.Context, *ProjectorRequest) (*emptypb.Empty, error)
+	DecreaseGain(context.Context, *ProjectorRequest) (*emptypb.Empty, error)
+	GetGain(context.Context, *ProjectorRequest) (*Int32Response, error)
+	SetBrilliantColor(context.Context, *SetInt32Request) (*emptypb.Empty, error)
+	GetBrilliantColor(context.Context, *ProjectorRequest) (*Int32Response, error)
+	SetScreenColor(context.Context, *SetScreenColorRequest) (*emptypb.Empty, error)
+	GetScreenColor(context.Context, *ProjectorRequest) (*ScreenColorResponse, error)
+	ResetCurrentColorSettings(context.Context, *ProjectorRequest) (*emptypb.Empty, error)
+	mustEmbedUnimplementedColorServer()
+}
+
+// UnimplementedColorServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedColorServer struct{}
+
+func (UnimplementedColorServer) SetColorTemperature(context.Context, *SetColorTemperatureRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetColorTemperature not implemented")
+}
+func (UnimplementedColorServer) GetColorTemperature(context.Context, *ProjectorRequest) (*ColorTemperatureResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetColorTemperature not implemented")
+}
+func (UnimplementedColorServer) SetColorMode(context.Context, *SetColorModeRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetColorMode not implemented")
+}
+func (UnimplementedColorServer) GetColorMode(context.Context, *ProjectorRequest) (*ColorModeResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetColorMode not implemented")
+}
+func (UnimplementedColorServer) CycleColorMode(context.Context, *ProjectorRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method CycleColorMode not implemented")
+}
+func (UnimplementedColorServer) SelectPrimaryColor(context.Context, *SelectPrimaryColorRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method SelectPrimaryColor not implemented")
+}
+func (UnimplementedColorServer) GetSelectedPrimaryColor(context.Context, *ProjectorRequest) (*PrimaryColorResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetSelectedPrimaryColor not implemented")
+}
+func (UnimplementedColorServer) IncreaseHue(context.Context, *ProjectorRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method IncreaseHue not implemented")
+}
+func (UnimplementedColorServer) DecreaseHue(context.Context, *ProjectorRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method DecreaseHue not implemented")
+}
+func (UnimplementedColorServer) GetHue(context.Context, *ProjectorRequest) (*Int32Response, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetHue not implemented")
+}
+func (UnimplementedColorServer) IncreaseSaturation(context.Context, *ProjectorRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method IncreaseSaturation not implemented")
+}
+func (UnimplementedColorServer) DecreaseSaturation(context.Context, *ProjectorRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method DecreaseSaturation not implemented")
+}
+func (UnimplementedColorServer) GetSaturation(context.Context, *ProjectorRequest) (*Int32Response, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetSaturation not implemented")
+}
+func (UnimplementedColorServer) IncreaseSharpness(context.Context, *ProjectorRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method IncreaseSharpness not implemented")
+}
+func (UnimplementedColorServer) DecreaseSharpness(context.Context, *ProjectorRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method DecreaseSharpness not implemented")
+}
+func (UnimplementedColorServer) GetSharpness(context.Context, *ProjectorRequest) (*Int32Response, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetSharpness not implemented")
+}
+func (UnimplementedColorServer) IncreaseGain(context.Context, *ProjectorRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method IncreaseGain not implemented")
+}
+func (UnimplementedColorServer) DecreaseGain(context.Context, *ProjectorRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method DecreaseGain not implemented")
+}
+func (UnimplementedColorServer) GetGain(context.Context, *ProjectorRequest) (*Int32Response, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetGain not implemented")
+}
+func (UnimplementedColorServer) SetBrilliantColor(context.Context, *SetInt32Request) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetBrilliantColor not implemented")
+}
+func (UnimplementedColorServer) GetBrilliantColor(context.Context, *ProjectorRequest) (*Int32Response, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetBrilliantColor not implemented")
+}
+func (UnimplementedColorServer) SetScreenColor(context.Context, *SetScreenColorRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetScreenColor not implemented")
+}
+func (UnimplementedColorServer) GetScreenColor(context.Context, *ProjectorRequest) (*ScreenColorResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetScreenColor not implemented")
+}
+func (UnimplementedColorServer) ResetCurrentColorSettings(context.Context, *ProjectorRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method ResetCurrentColorSettings not implemented")
+}
+func (UnimplementedColorServer) mustEmbedUnimplementedColorServer() {}
+func (UnimplementedColorServer) testEmbeddedByValue()               {}
+
+// UnsafeColorServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ColorServer will
+// result in compilation errors.
+type UnsafeColorServer interface {
+	mustEmbedUnimplementedColorServer()
+}
+
+func RegisterColorServer(s grpc.ServiceRegistrar, srv ColorServer) {
+	// If the following call panics, it indicates UnimplementedColorServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Color_ServiceDesc, srv)
+}
+
+func _Color_SetColorTemperature_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetColorTemperatureRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ColorServer).SetColorTemperature(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Color_SetColorTemperature_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ColorServer).SetColorTemperature(ctx, req.(*SetColorTemperatureRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Color_GetColorTemperature_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProjectorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ColorServer).GetColorTemperature(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Color_GetColorTemperature_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ColorServer).GetColorTemperature(ctx, req.(*ProjectorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Color_SetColorMode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetColorModeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ColorServer).SetColorMode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Color_SetColorMode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ColorServer).SetColorMode(ctx, req.(*SetColorModeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Color_GetColorMode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProjectorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ColorServer).GetColorMode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Color_GetColorMode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ColorServer).GetColorMode(ctx, req.(*ProjectorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Color_CycleColorMode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProjectorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ColorServer).CycleColorMode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Color_CycleColorMode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ColorServer).CycleColorMode(ctx, req.(*ProjectorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Color_SelectPrimaryColor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SelectPrimaryColorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ColorServer).SelectPrimaryColor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Color_SelectPrimaryColor_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ColorServer).SelectPrimaryColor(ctx, req.(*SelectPrimaryColorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Color_GetSelectedPrimaryColor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProjectorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ColorServer).GetSelectedPrimaryColor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Color_GetSelectedPrimaryColor_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ColorServer).GetSelectedPrimaryColor(ctx, req.(*ProjectorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Color_IncreaseHue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProjectorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ColorServer).IncreaseHue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Color_IncreaseHue_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ColorServer).IncreaseHue(ctx, req.(*ProjectorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Color_DecreaseHue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProjectorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ColorServer).DecreaseHue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Color_DecreaseHue_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ColorServer).DecreaseHue(ctx, req.(*ProjectorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Color_GetHue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProjectorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ColorServer).GetHue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Color_GetHue_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ColorServer).GetHue(ctx, req.(*ProjectorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Color_IncreaseSaturation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProjectorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ColorServer).IncreaseSaturation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Color_IncreaseSaturation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ColorServer).IncreaseSaturation(ctx, req.(*ProjectorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Color_DecreaseSaturation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProjectorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ColorServer).DecreaseSaturation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Color_DecreaseSaturation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ColorServer).DecreaseSaturation(ctx, req.(*ProjectorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Color_GetSaturation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProjectorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ColorServer).GetSaturation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Color_GetSaturation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ColorServer).GetSaturation(ctx, req.(*ProjectorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Color_IncreaseSharpness_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProjectorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ColorServer).IncreaseSharpness(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Color_IncreaseSharpness_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ColorServer).IncreaseSharpness(ctx, req.(*ProjectorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Color_DecreaseSharpness_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProjectorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ColorServer).DecreaseSharpness(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Color_DecreaseSharpness_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ColorServer).DecreaseSharpness(ctx, req.(*ProjectorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Color_GetSharpness_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProjectorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ColorServer).GetSharpness(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Color_GetSharpness_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ColorServer).GetSharpness(ctx, req.(*ProjectorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Color_IncreaseGain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProjectorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ColorServer).IncreaseGain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Color_IncreaseGain_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ColorServer).IncreaseGain(ctx, req.(*ProjectorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Color_DecreaseGain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProjectorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ColorServer).DecreaseGain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Color_DecreaseGain_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ColorServer).DecreaseGain(ctx, req.(*ProjectorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Color_GetGain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProjectorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ColorServer).GetGain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Color_GetGain_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ColorServer).GetGain(ctx, req.(*ProjectorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Color_SetBrilliantColor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetInt32Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ColorServer).SetBrilliantColor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Color_SetBrilliantColor_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ColorServer).SetBrilliantColor(ctx, req.(*SetInt32Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Color_GetBrilliantColor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProjectorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ColorServer).GetBrilliantColor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Color_GetBrilliantColor_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ColorServer).GetBrilliantColor(ctx, req.(*ProjectorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Color_SetScreenColor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetScreenColorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ColorServer).SetScreenColor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Color_SetScreenColor_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ColorServer).SetScreenColor(ctx, req.(*SetScreenColorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Color_GetScreenColor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProjectorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ColorServer).GetScreenColor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Color_GetScreenColor_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ColorServer).GetScreenColor(ctx, req.(*ProjectorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Color_ResetCurrentColorSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProjectorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ColorServer).ResetCurrentColorSettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Color_ResetCurrentColorSettings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ColorServer).ResetCurrentColorSettings(ctx, req.(*ProjectorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Color_ServiceDesc is the grpc.ServiceDesc for Color service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Color_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "viewsonic.v1.Color",
+	HandlerType: (*ColorServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SetColorTemperature",
+			Handler:    _Color_SetColorTemperature_Handler,
+		},
+		{
+			MethodName: "GetColorTemperature",
+			Handler:    _Color_GetColorTemperature_Handler,
+		},
+		{
+			MethodName: "SetColorMode",
+			Handler:    _Color_SetColorMode_Handler,
+		},
+		{
+			MethodName: "GetColorMode",
+			Handler:    _Color_GetColorMode_Handler,
+		},
+		{
+			MethodName: "CycleColorMode",
+			Handler:    _Color_CycleColorMode_Handler,
+		},
+		{
+			MethodName: "SelectPrimaryColor",
+			Handler:    _Color_SelectPrimaryColor_Handler,
+		},
+		{
+			MethodName: "GetSelectedPrimaryColor",
+			Handler:    _Color_GetSelectedPrimaryColor_Handler,
+		},
+		{
+			MethodName: "IncreaseHue",
+			Handler:    _Color_IncreaseHue_Handler,
+		},
+		{
+			MethodName: "DecreaseHue",
+			Handler:    _Color_DecreaseHue_Handler,
+		},
+		{
+			MethodName: "GetHue",
+			Handler:    _Color_GetHue_Handler,
+		},
+		{
+			MethodName: "IncreaseSaturation",
+			Handler:    _Color_IncreaseSaturation_Handler,
+		},
+		{
+			MethodName: "DecreaseSaturation",
+			Handler:    _Color_DecreaseSaturation_Handler,
+		},
+		{
+			MethodName: "GetSaturation",
+			Handler:    _Color_GetSaturation_Handler,
+		},
+		{
+			MethodName: "IncreaseSharpness",
+			Handler:    _Color_IncreaseSharpness_Handler,
+		},
+		{
+			MethodName: "DecreaseSharpness",
+			Handler:    _Color_DecreaseSharpness_Handler,
+		},
+		{
+			MethodName: "GetSharpness",
+			Handler:    _Color_GetSharpness_Handler,
+		},
+		{
+			MethodName: "IncreaseGain",
+			Handler:    _Color_IncreaseGain_Handler,
+		},
+		{
+			MethodName: "DecreaseGain",
+			Handler:    _Color_DecreaseGain_Handler,
+		},
+		{
+			MethodName: "GetGain",
+			Handler:    _Color_GetGain_Handler,
+		},
+		{
+			MethodName: "SetBrilliantColor",
+			Handler:    _Color_SetBrilliantColor_Handler,
+		},
+		{
+			MethodName: "GetBrilliantColor",
+			Handler:    _Color_GetBrilliantColor_Handler,
+		},
+		{
+			MethodName: "SetScreenColor",
+			Handler:    _Color_SetScreenColor_Handler,
+		},
+		{
+			MethodName: "GetScreenColor",
+			Handler:    _Color_GetScreenColor_Handler,
+		},
+		{
+			MethodName: "ResetCurrentColorSettings",
+			Handler:    _Color_ResetCurrentColorSettings_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "viewsonic.proto",
+}
+
+const (
+	Audio_SetMute_FullMethodName        = "/viewsonic.v1.Audio/SetMute"
+	Audio_GetMute_FullMethodName        = "/viewsonic.v1.Audio/GetMute"
+	Audio_IncreaseVolume_FullMethodName = "/viewsonic.v1.Audio/IncreaseVolume"
+	Audio_DecreaseVolume_FullMethodName = "/viewsonic.v1.Audio/DecreaseVolume"
+	Audio_SetVolume_FullMethodName      = "/viewsonic.v1.Audio/SetVolume"
+	Audio_GetVolume_FullMethodName      = "/viewsonic.v1.Audio/GetVolume"
+	Audio_CycleAudioMode_FullMethodName = "/viewsonic.v1.Audio/CycleAudioMode"
+)
+
+// AudioClient is the client API for Audio service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type AudioClient interface {
+	SetMute(ctx context.Context, in *SetBoolRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetMute(ctx context.Context, in *ProjectorRequest, opts ...grpc.CallOption) (*BoolResponse, error)
+	IncreaseVolume(ctx context.Context, in *ProjectorRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	DecreaseVolume(ctx context.Context, in *ProjectorRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	SetVolume(ctx context.Context, in *SetInt32Request, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetVolume(ctx context.Context, in *ProjectorRequest, opts ...grpc.CallOption) (*Int32Response, error)
+	CycleAudioMode(ctx context.Context, in *ProjectorRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+}
+
+type audioClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAudioClient(cc grpc.ClientConnInterface) AudioClient {
+	return &audioClient{cc}
+}
+
+func (c *audioClient) SetMute(ctx context.Context, in *SetBoolRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Audio_SetMute_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *audioClient) GetMute(ctx context.Context, in *ProjectorRequest, opts ...grpc.CallOption) (*BoolResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BoolResponse)
+	err := c.cc.Invoke(ctx, Audio_GetMute_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *audioClient) IncreaseVolume(ctx context.Context, in *ProjectorRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Audio_IncreaseVolume_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *audioClient) DecreaseVolume(ctx context.Context, in *ProjectorRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Audio_DecreaseVolume_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *audioClient) SetVolume(ctx context.Context, in *SetInt32Request, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Audio_SetVolume_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *audioClient) GetVolume(ctx context.Context, in *ProjectorRequest, opts ...grpc.CallOption) (*Int32Response, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Int32Response)
+	err := c.cc.Invoke(ctx, Audio_GetVolume_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *audioClient) CycleAudioMode(ctx context.Context, in *ProjectorRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Audio_CycleAudioMode_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AudioServer is the server API for Audio service.
+// All implementations must embed UnimplementedAudioServer
+// for forward compatibility.
+type AudioServer interface {
+	SetMute(context.Context, *SetBoolRequest) (*emptypb.Empty, error)
+	GetMute(context.Context, *ProjectorRequest) (*BoolResponse, error)
+	IncreaseVolume(context.Context, *ProjectorRequest) (*emptypb.Empty, error)
+	DecreaseVolume(context.Context, *ProjectorRequest) (*emptypb.Empty, error)
+	SetVolume(context.Context, *SetInt32Request) (*emptypb.Empty, error)
+	GetVolume(context.Context, *ProjectorRequest) (*Int32Response, error)
+	CycleAudioMode(context.Context, *ProjectorRequest) (*emptypb.Empty, error)
+	mustEmbedUnimplementedAudioServer()
+}
+
+// UnimplementedAudioServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedAudioServer struct{}
+
+func (UnimplementedAudioServer) SetMute(context.Context, *SetBoolRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetMute not implemented")
+}
+func (UnimplementedAudioServer) GetMute(context.Context, *ProjectorRequest) (*BoolResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetMute not implemented")
+}
+func (UnimplementedAudioServer) IncreaseVolume(context.Context, *ProjectorRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method IncreaseVolume not implemented")
+}
+func (UnimplementedAudioServer) DecreaseVolume(context.Context, *ProjectorRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method DecreaseVolume not implemented")
+}
+func (UnimplementedAudioServer) SetVolume(context.Context, *SetInt32Request) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetVolume not implemented")
+}
+func (UnimplementedAudioServer) GetVolume(context.Context, *ProjectorRequest) (*Int32Response, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetVolume not implemented")
+}
+func (UnimplementedAudioServer) CycleAudioMode(context.Context, *ProjectorRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method CycleAudioMode not implemented")
+}
+func (UnimplementedAudioServer) mustEmbedUnimplementedAudioServer() {}
+func (UnimplementedAudioServer) testEmbeddedByValue()               {}
+
+// UnsafeAudioServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AudioServer will
+// result in compilation errors.
+type UnsafeAudioServer interface {
+	mustEmbedUnimplementedAudioServer()
+}
+
+func RegisterAudioServer(s grpc.ServiceRegistrar, srv AudioServer) {
+	// If the following call panics, it indicates UnimplementedAudioServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Audio_ServiceDesc, srv)
+}
+
+func _Audio_SetMute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetBoolRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AudioServer).SetMute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Audio_SetMute_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AudioServer).SetMute(ctx, req.(*SetBoolRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Audio_GetMute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProjectorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AudioServer).GetMute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Audio_GetMute_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AudioServer).GetMute(ctx, req.(*ProjectorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Audio_IncreaseVolume_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProjectorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AudioServer).IncreaseVolume(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Audio_IncreaseVolume_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AudioServer).IncreaseVolume(ctx, req.(*ProjectorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Audio_DecreaseVolume_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProjectorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AudioServer).DecreaseVolume(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Audio_DecreaseVolume_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AudioServer).DecreaseVolume(ctx, req.(*ProjectorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Audio_SetVolume_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetInt32Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AudioServer).SetVolume(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Audio_SetVolume_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AudioServer).SetVolume(ctx, req.(*SetInt32Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Audio_GetVolume_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProjectorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AudioServer).GetVolume(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Audio_GetVolume_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AudioServer).GetVolume(ctx, req.(*ProjectorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Audio_CycleAudioMode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProjectorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AudioServer).CycleAudioMode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Audio_CycleAudioMode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AudioServer).CycleAudioMode(ctx, req.(*ProjectorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Audio_ServiceDesc is the grpc.ServiceDesc for Audio service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Audio_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "viewsonic.v1.Audio",
+	HandlerType: (*AudioServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SetMute",
+			Handler:    _Audio_SetMute_Handler,
+		},
+		{
+			MethodName: "GetMute",
+			Handler:    _Audio_GetMute_Handler,
+		},
+		{
+			MethodName: "IncreaseVolume",
+			Handler:    _Audio_IncreaseVolume_Handler,
+		},
+		{
+			MethodName: "DecreaseVolume",
+			Handler:    _Audio_DecreaseVolume_Handler,
+		},
+		{
+			MethodName: "SetVolume",
+			Handler:    _Audio_SetVolume_Handler,
+		},
+		{
+			MethodName: "GetVolume",
+			Handler:    _Audio_GetVolume_Handler,
+		},
+		{
+			MethodName: "CycleAudioMode",
+			Handler:    _Audio_CycleAudioMode_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "viewsonic.proto",
+}
+
+const (
+	Miscellaneous_SetHighAltitudeMode_FullMethodName       = "/viewsonic.v1.Miscellaneous/SetHighAltitudeMode"
+	Miscellaneous_GetHighAltitudeMode_FullMethodName       = "/viewsonic.v1.Miscellaneous/GetHighAltitudeMode"
+	Miscellaneous_SetMessageDisplay_FullMethodName         = "/viewsonic.v1.Miscellaneous/SetMessageDisplay"
+	Miscellaneous_GetMessageDisplay_FullMethodName         = "/viewsonic.v1.Miscellaneous/GetMessageDisplay"
+	Miscellaneous_SetLanguage_FullMethodName               = "/viewsonic.v1.Miscellaneous/SetLanguage"
+	Miscellaneous_GetLanguage_FullMethodName               = "/viewsonic.v1.Miscellaneous/GetLanguage"
+	Miscellaneous_SetRemoteControlCode_FullMethodName      = "/viewsonic.v1.Miscellaneous/SetRemoteControlCode"
+	Miscellaneous_GetRemoteControlCode_FullMethodName      = "/viewsonic.v1.Miscellaneous/GetRemoteControlCode"
+	Miscellaneous_SendRemoteKey_FullMethodName             = "/viewsonic.v1.Miscellaneous/SendRemoteKey"
+	Miscellaneous_ResetLightSourceUsageTime_FullMethodName = "/viewsonic.v1.Miscellaneous/ResetLightSourceUsageTime"
+	Miscellaneous_SetLightSourceMode_FullMethodName        = "/viewsonic.v1.Miscellaneous/SetLightSourceMode"
+	Miscellaneous_GetLightSourceMode_FullMethodName        = "/viewsonic.v1.Miscellaneous/GetLightSourceMode"
+	Miscellaneous_CycleLampMode_FullMethodName             = "/viewsonic.v1.Miscellaneous/CycleLampMode"
+	Miscellaneous_ResetAllSettings_FullMethodName          = "/viewsonic.v1.Miscellaneous/ResetAllSettings"
+)
+
+// MiscellaneousClient is the client API for Miscellaneous service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type MiscellaneousClient interface {
+	SetHighAltitudeMode(ctx context.Context, in *SetBoolRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetHighAltitudeMode(ctx context.Context, in *ProjectorRequest, opts ...grpc.CallOption) (*BoolResponse, error)
+	SetMessageDisplay(ctx context.Context, in *SetBoolRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetMessageDisplay(ctx context.Context, in *ProjectorRequest, opts ...grpc.CallOption) (*BoolResponse, error)
+	SetLanguage(ctx context.Context, in *SetLanguageRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetLanguage(ctx context.Context, in *ProjectorRequest, opts ...grpc.CallOption) (*LanguageResponse, error)
+	SetRemoteControlCode(ctx context.Context, in *SetInt32Request, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetRemoteControlCode(ctx context.Context, in *ProjectorRequest, opts ...grpc.CallOption) (*Int32Response, error)
+	SendRemoteKey(ctx context.Context, in *SendRemoteKeyRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ResetLightSourceUsageTime(ctx context.Context, in *ProjectorRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	SetLightSourceMode(ctx context.Context, in *SetLightSourceModeRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetLightSourceMode(ctx context.Context, in *ProjectorRequest, opts ...grpc.CallOption) (*LightSourceModeResponse, error)
+	CycleLampMode(ctx context.Context, in *ProjectorRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ResetAllSettings(ctx context.Context, in *ProjectorRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+}
+
+type miscellaneousClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewMiscellaneousClient(cc grpc.ClientConnInterface) MiscellaneousClient {
+	return &miscellaneousClient{cc}
+}
+
+func (c *miscellaneousClient) SetHighAltitudeMode(ctx context.Context, in *SetBoolRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Miscellaneous_SetHighAltitudeMode_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *miscellaneousClient) GetHighAltitudeMode(ctx context.Context, in *ProjectorRequest, opts ...grpc.CallOption) (*BoolResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BoolResponse)
+	err := c.cc.Invoke(ctx, Miscellaneous_GetHighAltitudeMode_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *miscellaneousClient) SetMessageDisplay(ctx context.Context, in *SetBoolRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Miscellaneous_SetMessageDisplay_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *miscellaneousClient) GetMessageDisplay(ctx context.Context, in *ProjectorRequest, opts ...grpc.CallOption) (*BoolResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BoolResponse)
+	err := c.cc.Invoke(ctx, Miscellaneous_GetMessageDisplay_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *miscellaneousClient) SetLanguage(ctx context.Context, in *SetLanguageRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Miscellaneous_SetLanguage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *miscellaneousClient) GetLanguage(ctx context.Context, in *ProjectorRequest, opts ...grpc.CallOption) (*LanguageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LanguageResponse)
+	err := c.cc.Invoke(ctx, Miscellaneous_GetLanguage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *miscellaneousClient) SetRemoteControlCode(ctx context.Context, in *SetInt32Request, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Miscellaneous_SetRemoteControlCode_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *miscellaneousClient) GetRemoteControlCode(ctx context.Context, in *ProjectorRequest, opts ...grpc.CallOption) (*Int32Response, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Int32Response)
+	err := c.cc.Invoke(ctx, Miscellaneous_GetRemoteControlCode_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *miscellaneousClient) SendRemoteKey(ctx context.Context, in *SendRemoteKeyRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Miscellaneous_SendRemoteKey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *miscellaneousClient) ResetLightSourceUsageTime(ctx context.Context, in *ProjectorRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Miscellaneous_ResetLightSourceUsageTime_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *miscellaneousClient) SetLightSourceMode(ctx context.Context, in *SetLightSourceModeRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Miscellaneous_SetLightSourceMode_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *miscellaneousClient) GetLightSourceMode(ctx context.Context, in *ProjectorRequest, opts ...grpc.CallOption) (*LightSourceModeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LightSourceModeResponse)
+	err := c.cc.Invoke(ctx, Miscellaneous_GetLightSourceMode_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *miscellaneousClient) CycleLampMode(ctx context.Context, in *ProjectorRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Miscellaneous_CycleLampMode_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *miscellaneousClient) ResetAllSettings(ctx context.Context, in *ProjectorRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Miscellaneous_ResetAllSettings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MiscellaneousServer is the server API for Miscellaneous service.
+// All implementations must embed UnimplementedMiscellaneousServer
+// for forward compatibility.
+type MiscellaneousServer interface {
+	SetHighAltitudeMode(context.Context, *SetBoolRequest) (*emptypb.Empty, error)
+	GetHighAltitudeMode(context.Context, *ProjectorRequest) (*BoolResponse, error)
+	SetMessageDisplay(context.Context, *SetBoolRequest) (*emptypb.Empty, error)
+	GetMessageDisplay(context.Context, *ProjectorRequest) (*BoolResponse, error)
+	SetLanguage(context.Context, *SetLanguageRequest) (*emptypb.Empty, error)
+	GetLanguage(context.Context, *ProjectorRequest) (*LanguageResponse, error)
+	SetRemoteControlCode(context.Context, *SetInt32Request) (*emptypb.Empty, error)
+	GetRemoteControlCode(context.Context, *ProjectorRequest) (*Int32Response, error)
+	SendRemoteKey(context.Context, *SendRemoteKeyRequest) (*emptypb.Empty, error)
+	ResetLightSourceUsageTime(context.Context, *ProjectorRequest) (*emptypb.Empty, error)
+	SetLightSourceMode(context.Context, *SetLightSourceModeRequest) (*emptypb.Empty, error)
+	GetLightSourceMode(context.Context, *ProjectorRequest) (*LightSourceModeResponse, error)
+	CycleLampMode(context.Context, *ProjectorRequest) (*emptypb.Empty, error)
+	ResetAllSettings(context.Context, *ProjectorRequest) (*emptypb.Empty, error)
+	mustEmbedUnimplementedMiscellaneousServer()
+}
+
+// UnimplementedMiscellaneousServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedMiscellaneousServer struct{}
+
+func (UnimplementedMiscellaneousServer) SetHighAltitudeMode(context.Context, *SetBoolRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetHighAltitudeMode not implemented")
+}
+func (UnimplementedMiscellaneousServer) GetHighAltitudeMode(context.Context, *ProjectorRequest) (*BoolResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetHighAltitudeMode not implemented")
+}
+func (UnimplementedMiscellaneousServer) SetMessageDisplay(context.Context, *SetBoolRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetMessageDisplay not implemented")
+}
+func (UnimplementedMiscellaneousServer) GetMessageDisplay(context.Context, *ProjectorRequest) (*BoolResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetMessageDisplay not implemented")
+}
+func (UnimplementedMiscellaneousServer) SetLanguage(context.Context, *SetLanguageRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetLanguage not implemented")
+}
+func (UnimplementedMiscellaneousServer) GetLanguage(context.Context, *ProjectorRequest) (*LanguageResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetLanguage not implemented")
+}
+func (UnimplementedMiscellaneousServer) SetRemoteControlCode(context.Context, *SetInt32Request) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetRemoteControlCode not implemented")
+}
+func (UnimplementedMiscellaneousServer) GetRemoteControlCode(context.Context, *ProjectorRequest) (*Int32Response, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetRemoteControlCode not implemented")
+}
+func (UnimplementedMiscellaneousServer) SendRemoteKey(context.Context, *SendRemoteKeyRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method SendRemoteKey not implemented")
+}
+func (UnimplementedMiscellaneousServer) ResetLightSourceUsageTime(context.Context, *ProjectorRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method ResetLightSourceUsageTime not implemented")
+}
+func (UnimplementedMiscellaneousServer) SetLightSourceMode(context.Context, *SetLightSourceModeRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetLightSourceMode not implemented")
+}
+func (UnimplementedMiscellaneousServer) GetLightSourceMode(context.Context, *ProjectorRequest) (*LightSourceModeResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetLightSourceMode not implemented")
+}
+func (UnimplementedMiscellaneousServer) CycleLampMode(context.Context, *ProjectorRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method CycleLampMode not implemented")
+}
+func (UnimplementedMiscellaneousServer) ResetAllSettings(context.Context, *ProjectorRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method ResetAllSettings not implemented")
+}
+func (UnimplementedMiscellaneousServer) mustEmbedUnimplementedMiscellaneousServer() {}
+func (UnimplementedMiscellaneousServer) testEmbeddedByValue()                       {}
+
+// UnsafeMiscellaneousServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to MiscellaneousServer will
+// result in compilation errors.
+type UnsafeMiscellaneousServer interface {
+	mustEmbedUnimplementedMiscellaneousServer()
+}
+
+func RegisterMiscellaneousServer(s grpc.ServiceRegistrar, srv MiscellaneousServer) {
+	// If the following call panics, it indicates UnimplementedMiscellaneousServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Miscellaneous_ServiceDesc, srv)
+}
+
+func _Miscellaneous_SetHighAltitudeMode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetBoolRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MiscellaneousServer).SetHighAltitudeMode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Miscellaneous_SetHighAltitudeMode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MiscellaneousServer).SetHighAltitudeMode(ctx, req.(*SetBoolRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Miscellaneous_GetHighAltitudeMode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProjectorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MiscellaneousServer).GetHighAltitudeMode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Miscellaneous_GetHighAltitudeMode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MiscellaneousServer).GetHighAltitudeMode(ctx, req.(*ProjectorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Miscellaneous_SetMessageDisplay_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetBoolRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MiscellaneousServer).SetMessageDisplay(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Miscellaneous_SetMessageDisplay_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MiscellaneousServer).SetMessageDisplay(ctx, req.(*SetBoolRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Miscellaneous_GetMessageDisplay_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProjectorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MiscellaneousServer).GetMessageDisplay(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Miscellaneous_GetMessageDisplay_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MiscellaneousServer).GetMessageDisplay(ctx, req.(*ProjectorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Miscellaneous_SetLanguage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetLanguageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MiscellaneousServer).SetLanguage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Miscellaneous_SetLanguage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MiscellaneousServer).SetLanguage(ctx, req.(*SetLanguageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Miscellaneous_GetLanguage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProjectorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MiscellaneousServer).GetLanguage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Miscellaneous_GetLanguage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MiscellaneousServer).GetLanguage(ctx, req.(*ProjectorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Miscellaneous_SetRemoteControlCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetInt32Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MiscellaneousServer).SetRemoteControlCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Miscellaneous_SetRemoteControlCode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MiscellaneousServer).SetRemoteControlCode(ctx, req.(*SetInt32Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Miscellaneous_GetRemoteControlCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProjectorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MiscellaneousServer).GetRemoteControlCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Miscellaneous_GetRemoteControlCode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MiscellaneousServer).GetRemoteControlCode(ctx, req.(*ProjectorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Miscellaneous_SendRemoteKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendRemoteKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MiscellaneousServer).SendRemoteKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Miscellaneous_SendRemoteKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MiscellaneousServer).SendRemoteKey(ctx, req.(*SendRemoteKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Miscellaneous_ResetLightSourceUsageTime_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProjectorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MiscellaneousServer).ResetLightSourceUsageTime(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Miscellaneous_ResetLightSourceUsageTime_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MiscellaneousServer).ResetLightSourceUsageTime(ctx, req.(*ProjectorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Miscellaneous_SetLightSourceMode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetLightSourceModeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MiscellaneousServer).SetLightSourceMode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Miscellaneous_SetLightSourceMode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MiscellaneousServer).SetLightSourceMode(ctx, req.(*SetLightSourceModeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Miscellaneous_GetLightSourceMode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProjectorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MiscellaneousServer).GetLightSourceMode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Miscellaneous_GetLightSourceMode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MiscellaneousServer).GetLightSourceMode(ctx, req.(*ProjectorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Miscellaneous_CycleLampMode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProjectorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MiscellaneousServer).CycleLampMode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Miscellaneous_CycleLampMode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MiscellaneousServer).CycleLampMode(ctx, req.(*ProjectorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Miscellaneous_ResetAllSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProjectorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MiscellaneousServer).ResetAllSettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Miscellaneous_ResetAllSettings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MiscellaneousServer).ResetAllSettings(ctx, req.(*ProjectorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Miscellaneous_ServiceDesc is the grpc.ServiceDesc for Miscellaneous service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Miscellaneous_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "viewsonic.v1.Miscellaneous",
+	HandlerType: (*MiscellaneousServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SetHighAltitudeMode",
+			Handler:    _Miscellaneous_SetHighAltitudeMode_Handler,
+		},
+		{
+			MethodName: "GetHighAltitudeMode",
+			Handler:    _Miscellaneous_GetHighAltitudeMode_Handler,
+		},
+		{
+			MethodName: "SetMessageDisplay",
+			Handler:    _Miscellaneous_SetMessageDisplay_Handler,
+		},
+		{
+			MethodName: "GetMessageDisplay",
+			Handler:    _Miscellaneous_GetMessageDisplay_Handler,
+		},
+		{
+			MethodName: "SetLanguage",
+			Handler:    _Miscellaneous_SetLanguage_Handler,
+		},
+		{
+			MethodName: "GetLanguage",
+			Handler:    _Miscellaneous_GetLanguage_Handler,
+		},
+		{
+			MethodName: "SetRemoteControlCode",
+			Handler:    _Miscellaneous_SetRemoteControlCode_Handler,
+		},
+		{
+			MethodName: "GetRemoteControlCode",
+			Handler:    _Miscellaneous_GetRemoteControlCode_Handler,
+		},
+		{
+			MethodName: "SendRemoteKey",
+			Handler:    _Miscellaneous_SendRemoteKey_Handler,
+		},
+		{
+			MethodName: "ResetLightSourceUsageTime",
+			Handler:    _Miscellaneous_ResetLightSourceUsageTime_Handler,
+		},
+		{
+			MethodName: "SetLightSourceMode",
+			Handler:    _Miscellaneous_SetLightSourceMode_Handler,
+		},
+		{
+			MethodName: "GetLightSourceMode",
+			Handler:    _Miscellaneous_GetLightSourceMode_Handler,
+		},
+		{
+			MethodName: "CycleLampMode",
+			Handler:    _Miscellaneous_CycleLampMode_Handler,
+		},
+		{
+			MethodName: "ResetAllSettings",
+			Handler:    _Miscellaneous_ResetAllSettings_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "viewsonic.proto",
+}
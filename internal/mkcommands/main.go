@@ -0,0 +1,1096 @@
+// Command mkcommands generates the command registry, the typed methods of generated commands,
+// the names of all enum types, the emulator handlers and optionally tests and the Modbus
+// register map from the command spec.
+//
+// The spec is a CSV file, lines starting with # are comments. The columns are
+//
+//	code      command code, e.g. 0x1301
+//	name      Go name, e.g. SourceInput
+//	category  System, Image, Input, Color, Audio or Miscellaneous
+//	access    r, w, rw or k (WriteKey)
+//	width     value bytes of a read response
+//	type      trigger, bool, enum, number, step, bytes or key
+//	values    space separated Name=value pairs, e.g. "Off=0x00 On=0x01"
+//	enum      Go type of an enum or key if it differs from the name, e.g. ProjectorStatusValue
+//	range     min..max of a number, or of the emulated value of a step or the target of a trigger
+//	default   initial emulator value, a number or space separated bytes
+//	target    emulator effect of a write on other commands: 0x1403 stores the value,
+//	          0x1100=1 assigns a value, 0x1403+1 and 0x1403-1 add to the value
+//	rows      v1.19 PDF rows, e.g. "48-56 58"
+//	methods   gen to generate the typed methods, empty if they are hand-written
+//	modbus    Modbus register of the command, H or I for holding or input register and the
+//	          address, e.g. "H3 name=Volume". Options are name (default the command name),
+//	          type (enum type of a command that is not an enum) and format (default the
+//	          type: bool, enum or number, or the multi register formats hours, temperature
+//	          and errorstatus). A read and a write command may share a holding register.
+//	notes     free text
+//
+// Typed methods follow the hand-written ones: Set/Get for bool, enum and number commands,
+// Increase/Decrease/Get for steps, a method named after the command for triggers and Get
+// for bytes. Enums get a type with constants.
+//
+// Every enum and key type, generated or hand-written, gets String, MarshalText and
+// UnmarshalText methods, a Parse function and a Values list using the value names.
+package main
+
+import (
+	"bytes"
+	"encoding/csv"
+	"flag"
+	"fmt"
+	"go/format"
+	"io"
+	"log"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"text/template"
+	"unicode"
+)
+
+type value struct {
+	Name  string
+	Ident string
+	Value int8
+}
+
+type target struct {
+	Code  uint16
+	Op    byte // 's' stores the written value, '=' assigns, '+' adds
+	Value int
+}
+
+type command struct {
+	Code     uint16
+	Name     string
+	Category string
+	Access   string
+	Width    int
+	Type     string
+	Values   []value
+	EnumType string
+	HasRange bool
+	Min, Max int
+	Default  []byte
+	Targets  []target
+	Rows     []int
+	Gen      bool
+	Modbus   *register
+	Notes    string
+}
+
+// register is a Modbus register or, for multi register formats, the first of them.
+type register struct {
+	Kind    string // HoldingRegister or InputRegister
+	Address int
+	Name    string
+	Format  string
+	Type    string // Go type of enum registers
+	Read    *command
+	Write   *command
+}
+
+var columns = []string{"code", "name", "category", "access", "width", "type", "values", "enum", "range", "default", "target", "rows", "methods", "modbus", "notes"}
+
+func main() {
+	spec := flag.String("spec", "Commands.csv", "command spec")
+	table := flag.String("table", "CommandTable.go", "registry output")
+	methods := flag.String("methods", "CommandMethods.go", "typed methods output")
+	enums := flag.String("enums", "CommandEnums.go", "enum names output")
+	emulator := flag.String("emulator", "emulator/Handlers.go", "emulator handlers output")
+	tests := flag.String("tests", "", "tests output, no tests are generated if empty")
+	modbus := flag.String("modbus", "", "Modbus register map output, no map is generated if empty")
+	flag.Parse()
+
+	commands, err := readSpec(*spec)
+	if err != nil {
+		log.Fatal(err)
+	}
+	registers, err := modbusRegisters(commands)
+	if err != nil {
+		log.Fatalf("%v: %v", *spec, err)
+	}
+
+	outputs := []struct {
+		path string
+		tmpl *template.Template
+	}{
+		{*table, tableTemplate},
+		{*methods, methodsTemplate},
+		{*enums, enumsTemplate},
+		{*emulator, emulatorTemplate},
+		{*tests, testsTemplate},
+		{*modbus, modbusTemplate},
+	}
+	for _, o := range outputs {
+		if o.path == "" {
+			continue
+		}
+		if err := generate(o.path, o.tmpl, *spec, commands, registers); err != nil {
+			log.Fatal(err)
+		}
+	}
+}
+
+func generate(path string, tmpl *template.Template, spec string, commands []*command, registers []*register) error {
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, map[string]any{"Spec": spec, "Commands": commands, "Registers": registers}); err != nil {
+		return fmt.Errorf("%v: %w", path, err)
+	}
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		return fmt.Errorf("%v: %w\n%s", path, err, buf.Bytes())
+	}
+	return os.WriteFile(path, src, 0o644)
+}
+
+func readSpec(path string) ([]*command, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	r := csv.NewReader(f)
+	r.Comment = '#'
+	r.FieldsPerRecord = len(columns)
+
+	header, err := r.Read()
+	if err != nil {
+		return nil, fmt.Errorf("%v: %w", path, err)
+	}
+	for i, name := range columns {
+		if header[i] != name {
+			return nil, fmt.Errorf("%v: column %d is %q, expected %q", path, i+1, header[i], name)
+		}
+	}
+
+	var commands []*command
+	seen := map[uint16]bool{}
+	for {
+		record, err := r.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%v: %w", path, err)
+		}
+		c, err := parseCommand(record)
+		if err != nil {
+			line, _ := r.FieldPos(0)
+			return nil, fmt.Errorf("%v:%d: %w", path, line, err)
+		}
+		if seen[c.Code] {
+			return nil, fmt.Errorf("%v: duplicate command 0x%04X", path, c.Code)
+		}
+		seen[c.Code] = true
+		commands = append(commands, c)
+	}
+
+	for _, c := range commands {
+		for _, t := range c.Targets {
+			if !seen[t.Code] {
+				return nil, fmt.Errorf("%v: %v: unknown target 0x%04X", path, c.Name, t.Code)
+			}
+		}
+	}
+	return commands, nil
+}
+
+func parseCommand(record []string) (*command, error) {
+	field := map[string]string{}
+	for i, name := range columns {
+		field[name] = strings.TrimSpace(record[i])
+	}
+
+	code, err := strconv.ParseUint(field["code"], 0, 16)
+	if err != nil {
+		return nil, fmt.Errorf("invalid code %q", field["code"])
+	}
+	c := &command{
+		Code:     uint16(code),
+		Name:     field["name"],
+		Category: field["category"],
+		Access:   field["access"],
+		Type:     field["type"],
+		Gen:      field["methods"] == "gen",
+		Notes:    field["notes"],
+	}
+	if !isIdent(c.Name) {
+		return nil, fmt.Errorf("invalid name %q", c.Name)
+	}
+	switch c.Category {
+	case "System", "Image", "Input", "Color", "Audio", "Miscellaneous":
+	default:
+		return nil, fmt.Errorf("%v: invalid category %q", c.Name, c.Category)
+	}
+	switch c.Access {
+	case "r", "w", "rw", "k":
+	default:
+		return nil, fmt.Errorf("%v: invalid access %q", c.Name, c.Access)
+	}
+	switch c.Type {
+	case "trigger", "bool", "enum", "number", "step", "bytes", "key":
+	default:
+		return nil, fmt.Errorf("%v: invalid type %q", c.Name, c.Type)
+	}
+	if field["methods"] != "" && !c.Gen {
+		return nil, fmt.Errorf("%v: invalid methods %q", c.Name, field["methods"])
+	}
+
+	if field["width"] != "" {
+		if c.Width, err = strconv.Atoi(field["width"]); err != nil || c.Width < 1 {
+			return nil, fmt.Errorf("%v: invalid width %q", c.Name, field["width"])
+		}
+	}
+	if c.Readable() != (c.Width > 0) {
+		return nil, fmt.Errorf("%v: readable commands need a width, others none", c.Name)
+	}
+
+	for _, s := range strings.Fields(field["values"]) {
+		i := strings.LastIndex(s, "=")
+		if i < 1 {
+			return nil, fmt.Errorf("%v: invalid value %q", c.Name, s)
+		}
+		v, err := strconv.ParseInt(s[i+1:], 0, 16)
+		if err != nil || v < -128 || v > 255 {
+			return nil, fmt.Errorf("%v: invalid value %q", c.Name, s)
+		}
+		name := s[:i]
+		if !isIdent(ident(name)) {
+			return nil, fmt.Errorf("%v: invalid value name %q", c.Name, name)
+		}
+		c.Values = append(c.Values, value{Name: name, Ident: ident(name), Value: int8(v)})
+	}
+	switch c.Type {
+	case "bool", "enum", "key", "trigger":
+		if len(c.Values) == 0 {
+			return nil, fmt.Errorf("%v: %v needs values", c.Name, c.Type)
+		}
+	case "step":
+		if len(c.Values) == 0 {
+			c.Values = []value{{"Decrease", "Decrease", 0x00}, {"Increase", "Increase", 0x01}}
+		}
+		if len(c.Values) != 2 {
+			return nil, fmt.Errorf("%v: step needs two values", c.Name)
+		}
+	}
+	if s := field["enum"]; s != "" || c.HasEnum() {
+		if !c.HasEnum() {
+			return nil, fmt.Errorf("%v: enum type of a %v", c.Name, c.Type)
+		}
+		c.EnumType = c.Name
+		if s != "" {
+			if !isIdent(s) {
+				return nil, fmt.Errorf("%v: invalid enum %q", c.Name, s)
+			}
+			if c.Gen {
+				return nil, fmt.Errorf("%v: generated enums are named after the command", c.Name)
+			}
+			c.EnumType = s
+		}
+	}
+	if c.Type == "bool" && (len(c.Values) != 2 || c.Values[0].Value != 0 || c.Values[1].Value != 1) {
+		return nil, fmt.Errorf("%v: bool values must be 0 and 1", c.Name)
+	}
+
+	if s := field["range"]; s != "" {
+		lo, hi, ok := strings.Cut(s, "..")
+		min, err1 := strconv.Atoi(lo)
+		max, err2 := strconv.Atoi(hi)
+		if !ok || err1 != nil || err2 != nil || min > max {
+			return nil, fmt.Errorf("%v: invalid range %q", c.Name, s)
+		}
+		c.HasRange, c.Min, c.Max = true, min, max
+	}
+	if c.Type == "number" && c.HasRange && (c.Min < -128 || c.Max > 127) {
+		return nil, fmt.Errorf("%v: range exceeds int8", c.Name)
+	}
+
+	if c.Default, err = parseDefault(c, field["default"]); err != nil {
+		return nil, err
+	}
+
+	for _, s := range strings.Fields(field["target"]) {
+		t, err := parseTarget(s)
+		if err != nil {
+			return nil, fmt.Errorf("%v: %w", c.Name, err)
+		}
+		c.Targets = append(c.Targets, t)
+	}
+
+	if s := field["modbus"]; s != "" {
+		if c.Modbus, err = parseRegister(c, s); err != nil {
+			return nil, fmt.Errorf("%v: %w", c.Name, err)
+		}
+	}
+
+	for _, s := range strings.Fields(field["rows"]) {
+		lo, hi, isRange := strings.Cut(s, "-")
+		first, err1 := strconv.Atoi(lo)
+		last, err2 := first, error(nil)
+		if isRange {
+			last, err2 = strconv.Atoi(hi)
+		}
+		if err1 != nil || err2 != nil || first > last {
+			return nil, fmt.Errorf("%v: invalid rows %q", c.Name, s)
+		}
+		for r := first; r <= last; r++ {
+			c.Rows = append(c.Rows, r)
+		}
+	}
+	return c, nil
+}
+
+// parseRegister parses the modbus column, e.g. "H3 name=Volume".
+func parseRegister(c *command, s string) (*register, error) {
+	fields := strings.Fields(s)
+	r := &register{Name: c.Name, Type: c.EnumType}
+	switch fields[0][0] {
+	case 'H':
+		r.Kind = "HoldingRegister"
+	case 'I':
+		r.Kind = "InputRegister"
+	default:
+		return nil, fmt.Errorf("invalid modbus register %q", s)
+	}
+	address, err := strconv.ParseUint(fields[0][1:], 10, 16)
+	if err != nil {
+		return nil, fmt.Errorf("invalid modbus register %q", s)
+	}
+	r.Address = int(address)
+
+	for _, f := range fields[1:] {
+		key, v, _ := strings.Cut(f, "=")
+		switch {
+		case key == "name" && isIdent(v):
+			r.Name = v
+		case key == "type" && isIdent(v):
+			r.Type = v
+		case key == "format" && v != "":
+			r.Format = v
+		default:
+			return nil, fmt.Errorf("invalid modbus option %q", f)
+		}
+	}
+	if r.Format == "" {
+		switch {
+		case r.Type != "":
+			r.Format = "enum"
+		case c.Type == "bool" || c.Type == "number":
+			r.Format = c.Type
+		default:
+			return nil, fmt.Errorf("modbus register of a %v needs a format", c.Type)
+		}
+	}
+
+	switch r.Format {
+	case "bool", "enum", "number":
+		if c.Width > 1 {
+			return nil, fmt.Errorf("%v register of a %d byte value", r.Format, c.Width)
+		}
+		if r.Format == "number" && c.Type != "number" {
+			return nil, fmt.Errorf("number register of a %v", c.Type)
+		}
+	case "hours", "temperature", "errorstatus":
+		if c.Type != "bytes" {
+			return nil, fmt.Errorf("%v register of a %v", r.Format, c.Type)
+		}
+		if r.Kind != "InputRegister" {
+			return nil, fmt.Errorf("%v registers are input registers", r.Format)
+		}
+	default:
+		return nil, fmt.Errorf("invalid modbus format %q", r.Format)
+	}
+
+	if c.Readable() {
+		r.Read = c
+	}
+	if c.Writable() && r.Kind == "HoldingRegister" {
+		r.Write = c
+	}
+	return r, nil
+}
+
+// modbusRegisters merges the registers of the commands and orders them by kind and address.
+func modbusRegisters(commands []*command) ([]*register, error) {
+	var registers []*register
+	byAddress := map[string]*register{}
+	for _, c := range commands {
+		r := c.Modbus
+		if r == nil {
+			continue
+		}
+		key := fmt.Sprintf("%v %d", r.Kind, r.Address)
+		other := byAddress[key]
+		if other == nil {
+			byAddress[key] = r
+			registers = append(registers, r)
+			continue
+		}
+		// A read and a write command of one register
+		if other.Name != r.Name || other.Format != r.Format || other.Type != r.Type ||
+			(other.Read != nil && r.Read != nil) || (other.Write != nil && r.Write != nil) {
+			return nil, fmt.Errorf("%v: modbus register %v of %v", c.Name, key, other.Read)
+		}
+		if other.Read == nil {
+			other.Read = r.Read
+		}
+		if other.Write == nil {
+			other.Write = r.Write
+		}
+	}
+
+	for _, r := range registers {
+		switch {
+		case r.Read == nil:
+			return nil, fmt.Errorf("modbus register %v can not be read", r.Name)
+		case r.Kind == "HoldingRegister" && r.Write == nil:
+			return nil, fmt.Errorf("modbus holding register %v can not be written", r.Name)
+		}
+	}
+	slices.SortStableFunc(registers, func(a, b *register) int {
+		if a.Kind != b.Kind {
+			// Holding registers first
+			return strings.Compare(a.Kind, b.Kind)
+		}
+		return a.Address - b.Address
+	})
+	return registers, nil
+}
+
+// Command is the command shown in the register map, the write command of holding registers.
+func (r *register) Command() *command {
+	if r.Write != nil {
+		return r.Write
+	}
+	return r.Read
+}
+
+// Getter and Setter are the typed methods reading and writing the register.
+func (r *register) Getter() string { return accessor("Get", r.Read.Name) }
+func (r *register) Setter() string { return accessor("Set", r.Write.Name) }
+
+// accessor returns the name of a typed method, e.g. GetVolume for GetVolume and GetMute for Mute.
+func accessor(prefix, name string) string {
+	if strings.HasPrefix(name, prefix) {
+		return name
+	}
+	return prefix + name
+}
+
+// Func is the function building the registers of a multi register format.
+func (r *register) Func() string {
+	switch r.Format {
+	case "errorstatus":
+		return "errorStatusRegisters"
+	}
+	return r.Format + "Registers"
+}
+
+// Description is the Go expression of the register description.
+func (r *register) Description() string {
+	switch r.Format {
+	case "bool":
+		return strconv.Quote("0 = off, 1 = on")
+	case "enum":
+		return "valueList(viewsonic." + r.Type + "Values())"
+	}
+	var parts []string
+	if c := r.Command(); c.HasRange {
+		parts = append(parts, fmt.Sprintf("Value %d..%d", c.Min, c.Max))
+	}
+	if r.Write != nil && r.Read != r.Write {
+		parts = append(parts, fmt.Sprintf("read through command 0x%04X %v", r.Read.Code, r.Read.Name))
+	}
+	return strconv.Quote(strings.Join(parts, ", "))
+}
+
+// parseDefault returns the initial emulator value as little endian bytes of the width.
+func parseDefault(c *command, s string) ([]byte, error) {
+	data := make([]byte, c.Width)
+	if s == "" {
+		return data, nil
+	}
+	if c.Width == 0 {
+		return nil, fmt.Errorf("%v: default of a command that can not be read", c.Name)
+	}
+
+	if c.Type == "bytes" {
+		fields := strings.Fields(s)
+		if len(fields) != c.Width {
+			return nil, fmt.Errorf("%v: default needs %d bytes", c.Name, c.Width)
+		}
+		for i, f := range fields {
+			b, err := strconv.ParseUint(f, 0, 8)
+			if err != nil {
+				return nil, fmt.Errorf("%v: invalid default %q", c.Name, s)
+			}
+			data[i] = byte(b)
+		}
+		return data, nil
+	}
+
+	v, err := strconv.ParseInt(s, 0, 32)
+	if err != nil {
+		return nil, fmt.Errorf("%v: invalid default %q", c.Name, s)
+	}
+	for i := range data {
+		data[i] = byte(v >> (8 * i))
+	}
+	return data, nil
+}
+
+func parseTarget(s string) (target, error) {
+	i := strings.IndexAny(s[min(2, len(s)):], "=+-")
+	if i < 0 {
+		code, err := strconv.ParseUint(s, 0, 16)
+		return target{Code: uint16(code), Op: 's'}, err
+	}
+	i += min(2, len(s))
+
+	code, err := strconv.ParseUint(s[:i], 0, 16)
+	if err != nil {
+		return target{}, fmt.Errorf("invalid target %q", s)
+	}
+	v, err := strconv.ParseInt(s[i+1:], 0, 32)
+	if err != nil {
+		return target{}, fmt.Errorf("invalid target %q", s)
+	}
+	t := target{Code: uint16(code), Op: s[i], Value: int(v)}
+	if t.Op == '-' {
+		t.Op, t.Value = '+', -t.Value
+	}
+	return t, nil
+}
+
+// ident turns a value name like "2.35:1" or "sRGB" into an identifier suffix like "235To1" or "SRGB".
+func ident(name string) string {
+	name = strings.ReplaceAll(name, ":", "To")
+	name = strings.ReplaceAll(name, ".", "")
+	if name == "" {
+		return ""
+	}
+	r := []rune(name)
+	r[0] = unicode.ToUpper(r[0])
+	return string(r)
+}
+
+func isIdent(s string) bool {
+	if s == "" {
+		return false
+	}
+	for _, r := range s {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '_' {
+			return false
+		}
+	}
+	return true
+}
+
+func (c *command) Readable() bool { return strings.Contains(c.Access, "r") }
+func (c *command) Writable() bool { return strings.Contains(c.Access, "w") }
+
+// Var is the name of the registry variable.
+func (c *command) Var() string { return "cmd" + c.Name }
+
+// Relative reports whether a write triggers an action or steps the value instead of setting it.
+func (c *command) Relative() bool { return c.Type == "trigger" || c.Type == "step" }
+
+func (c *command) AccessExpr() string {
+	var parts []string
+	if c.Readable() {
+		parts = append(parts, "AccessRead")
+	}
+	if c.Writable() {
+		parts = append(parts, "AccessWrite")
+	}
+	if c.Access == "k" {
+		parts = append(parts, "AccessKey")
+	}
+	return strings.Join(parts, " | ")
+}
+
+// ReadType is the Go type returned by the getter of a number or step.
+func (c *command) ReadType() string {
+	if c.Width == 2 {
+		return "int16"
+	}
+	return "int8"
+}
+
+func (c *command) ReadMethod() string {
+	if c.Width == 2 {
+		return "Read2Bytes"
+	}
+	return "Read"
+}
+
+// ValueList lists the values for the emulator, e.g. "0x00, 0x01".
+func (c *command) ValueList() string {
+	parts := make([]string, len(c.Values))
+	for i, v := range c.Values {
+		parts[i] = hex(v.Value)
+	}
+	return strings.Join(parts, ", ")
+}
+
+// Bounds is the emulated range of a step or trigger target, the range of the width if not given.
+func (c *command) Bounds(width int) (int, int) {
+	if c.HasRange {
+		return c.Min, c.Max
+	}
+	if width >= 2 {
+		return -1 << 15, 1<<15 - 1
+	}
+	return -1 << 7, 1<<7 - 1
+}
+
+// EmulatorWrite is the emulator handler expression of writes.
+func (c *command) EmulatorWrite() string {
+	valid := "nil"
+	switch {
+	case len(c.Values) > 0:
+		valid = "values(" + c.ValueList() + ")"
+	case c.Type == "number" && c.HasRange:
+		valid = fmt.Sprintf("between(%d, %d)", c.Min, c.Max)
+	}
+
+	var actions []string
+	switch {
+	case c.Type == "step":
+		min, max := c.Bounds(c.Width)
+		actions = append(actions, fmt.Sprintf("step(0x%04X, %v, %d, %d)", c.Code, hex(c.Values[0].Value), min, max))
+	case len(c.Targets) == 0 && c.Type != "trigger" && c.Type != "key" && c.Type != "bytes":
+		actions = append(actions, fmt.Sprintf("set(0x%04X)", c.Code))
+	}
+	for _, t := range c.Targets {
+		switch t.Op {
+		case 's':
+			actions = append(actions, fmt.Sprintf("set(0x%04X)", t.Code))
+		case '=':
+			actions = append(actions, fmt.Sprintf("assign(0x%04X, %d)", t.Code, t.Value))
+		case '+':
+			min, max := c.Bounds(1)
+			actions = append(actions, fmt.Sprintf("add(0x%04X, %d, %d, %d)", t.Code, t.Value, min, max))
+		}
+	}
+	return "do(" + strings.Join(append([]string{valid}, actions...), ", ") + ")"
+}
+
+func (c *command) HasEnum() bool {
+	return c.Type == "enum" || c.Type == "key"
+}
+
+// EnumVar is the name of the enum variable of the enum type.
+func (c *command) EnumVar() string {
+	r := []rune(c.EnumType)
+	r[0] = unicode.ToLower(r[0])
+	return string(r) + "Enum"
+}
+
+// hex formats an int8 literal, values above 0x7F of the spec are negative.
+func hex(v int8) string {
+	if v < 0 {
+		return fmt.Sprintf("-0x%02X", -int(v))
+	}
+	return fmt.Sprintf("0x%02X", v)
+}
+
+func byteList(data []byte) string {
+	parts := make([]string, len(data))
+	for i, b := range data {
+		parts[i] = fmt.Sprintf("0x%02X", b)
+	}
+	return strings.Join(parts, ", ")
+}
+
+func intList(rows []int) string {
+	parts := make([]string, len(rows))
+	for i, r := range rows {
+		parts[i] = strconv.Itoa(r)
+	}
+	return strings.Join(parts, ", ")
+}
+
+// needsFmt reports whether the typed methods need to import fmt.
+func needsFmt(commands []*command) bool {
+	for _, c := range commands {
+		if c.Gen && c.Type == "bytes" && c.Readable() {
+			return true
+		}
+	}
+	return false
+}
+
+// categoryStart reports whether the command starts a new category block.
+func categoryStart(commands []*command, i int) bool {
+	return i == 0 || commands[i-1].Category != commands[i].Category
+}
+
+func categoryEnd(commands []*command, i int) bool {
+	return i == len(commands)-1 || commands[i+1].Category != commands[i].Category
+}
+
+var funcs = template.FuncMap{
+	"hex":           hex,
+	"bytes":         byteList,
+	"ints":          intList,
+	"needsFmt":      needsFmt,
+	"categoryStart": categoryStart,
+	"categoryEnd":   categoryEnd,
+	"quote":         strconv.Quote,
+}
+
+const header = `// Code generated by mkcommands from {{.Spec}}. DO NOT EDIT.
+`
+
+var tableTemplate = template.Must(template.New("table").Funcs(funcs).Parse(header + `
+package viewsonic
+
+{{range $i, $c := .Commands}}
+{{- if categoryStart $.Commands $i}}
+// {{$c.Category}}
+var (
+{{- end}}
+	{{$c.Var}} = &Command{
+		Code:     0x{{printf "%04X" $c.Code}},
+		Name:     {{quote $c.Name}},
+		Category: Category{{$c.Category}},
+		Access:   {{$c.AccessExpr}},
+{{- if $c.Width}}
+		Width:    {{$c.Width}},
+{{- end}}
+{{- if $c.Values}}
+		Values: []CommandValue{
+{{- range $c.Values}}
+			{ {{- quote .Name}}, {{hex .Value -}} },
+{{- end}}
+		},
+{{- end}}
+{{- if and (eq $c.Type "number") $c.HasRange}}
+		Min: {{$c.Min}}, Max: {{$c.Max}},
+{{- end}}
+{{- if $c.Relative}}
+		Relative: true,
+{{- end}}
+{{- if $c.Rows}}
+		Rows: []int{ {{- ints $c.Rows -}} },
+{{- end}}
+{{- if $c.Notes}}
+		Notes: {{quote $c.Notes}},
+{{- end}}
+	}
+{{- if categoryEnd $.Commands $i}}
+)
+{{end}}
+{{- end}}
+
+// Commands is the registry of all command codes, in the order of the spec.
+var Commands = []*Command{
+{{- range .Commands}}
+	{{.Var}},
+{{- end}}
+}
+`))
+
+var methodsTemplate = template.Must(template.New("methods").Funcs(funcs).Parse(header + `
+package viewsonic
+{{if needsFmt .Commands}}
+import "fmt"
+{{end}}
+{{- range .Commands}}{{if .Gen}}
+{{- $c := .}}
+// {{.Name}}{{if .Notes}}, {{.Notes}}{{end}}
+{{- if .HasEnum}}
+type {{.Name}} int8
+
+const (
+{{- range .Values}}
+	{{$c.Name}}{{.Ident}} {{$c.Name}} = {{hex .Value}}
+{{- end}}
+)
+{{end}}
+{{- if eq .Type "trigger"}}
+func (conn *ViewSonic) {{.Name}}() error {
+	return conn.Write({{.Var}}.Code, {{hex (index .Values 0).Value}})
+}
+{{- else if eq .Type "key"}}
+func (conn *ViewSonic) Send{{.Name}}(v {{.Name}}) error {
+	return conn.WriteKey({{.Var}}.Code, uint8(v))
+}
+{{- else if eq .Type "bool"}}
+{{- if .Writable}}
+func (conn *ViewSonic) Set{{.Name}}(enable bool) error {
+	value := int8(0x00)
+	if enable {
+		value = 0x01
+	}
+	return conn.Write({{.Var}}.Code, value)
+}
+{{end}}
+{{- if .Readable}}
+func (conn *ViewSonic) Get{{.Name}}() (bool, error) {
+	value, err := conn.Read({{.Var}}.Code)
+	return value == 0x01, err
+}
+{{- end}}
+{{- else if eq .Type "enum"}}
+{{- if .Writable}}
+func (conn *ViewSonic) Set{{.Name}}(v {{.Name}}) error {
+	return conn.Write({{.Var}}.Code, int8(v))
+}
+{{end}}
+{{- if .Readable}}
+func (conn *ViewSonic) Get{{.Name}}() ({{.Name}}, error) {
+	value, err := conn.Read({{.Var}}.Code)
+	if err != nil {
+		return 0, err
+	}
+	return {{.Name}}(value), nil
+}
+{{- end}}
+{{- else if eq .Type "number"}}
+{{- if .Writable}}
+func (conn *ViewSonic) Set{{.Name}}(value int8) error {
+	if err := {{.Var}}.Validate(value); err != nil {
+		return err
+	}
+	return conn.Write({{.Var}}.Code, value)
+}
+{{end}}
+{{- if .Readable}}
+func (conn *ViewSonic) Get{{.Name}}() ({{.ReadType}}, error) {
+	return conn.{{.ReadMethod}}({{.Var}}.Code)
+}
+{{- end}}
+{{- else if eq .Type "step"}}
+{{- range .Values}}
+func (conn *ViewSonic) {{.Ident}}{{$c.Name}}() error {
+	return conn.Write({{$c.Var}}.Code, {{hex .Value}})
+}
+{{end}}
+{{- if .Readable}}
+func (conn *ViewSonic) Get{{.Name}}() ({{.ReadType}}, error) {
+	return conn.{{.ReadMethod}}({{.Var}}.Code)
+}
+{{- end}}
+{{- else if eq .Type "bytes"}}
+{{- if .Writable}}
+func (conn *ViewSonic) Write{{.Name}}() error {
+	return conn.Write({{.Var}}.Code, {{hex (index .Values 0).Value}})
+}
+{{end}}
+{{- if .Readable}}
+func (conn *ViewSonic) Get{{.Name}}() ([]byte, error) {
+	data, err := conn.ReadNBytes({{.Var}}.Code)
+	if err != nil {
+		return nil, err
+	}
+	if len(data) < 2+{{.Var}}.Width {
+		return nil, fmt.Errorf("not enough data for {{.Name}}")
+	}
+	return data[2:], nil
+}
+{{- end}}
+{{- end}}
+{{end}}{{end}}
+`))
+
+var enumsTemplate = template.Must(template.New("enums").Funcs(funcs).Parse(header + `
+package viewsonic
+{{range .Commands}}{{if .HasEnum}}
+var {{.EnumVar}} = enum[{{.EnumType}}]{ {{- quote .EnumType}}, {{.Var}}.Values}
+
+func (v {{.EnumType}}) String() string { return {{.EnumVar}}.name(v) }
+
+func (v {{.EnumType}}) MarshalText() ([]byte, error) { return {{.EnumVar}}.marshal(v) }
+
+func (v *{{.EnumType}}) UnmarshalText(text []byte) error { return {{.EnumVar}}.unmarshal(v, text) }
+
+// Parse{{.EnumType}} returns the {{.EnumType}} with the case insensitive name or number.
+func Parse{{.EnumType}}(s string) ({{.EnumType}}, error) { return {{.EnumVar}}.parse(s) }
+
+// {{.EnumType}}Values returns all named {{.EnumType}} values.
+func {{.EnumType}}Values() []{{.EnumType}} { return {{.EnumVar}}.all() }
+{{end}}{{end}}
+`))
+
+var emulatorTemplate = template.Must(template.New("emulator").Funcs(funcs).Parse(header + `
+package emulator
+
+var handlers = map[uint16]handler{
+{{- range .Commands}}
+	0x{{printf "%04X" .Code}}: {
+		name: {{quote .Name}},
+{{- if .Width}}
+		width: {{.Width}},
+		value: []byte{ {{- bytes .Default -}} },
+{{- end}}
+{{- if eq .Access "k"}}
+		key:   true,
+{{- end}}
+{{- if or .Writable (eq .Access "k")}}
+		write: {{.EmulatorWrite}},
+{{- end}}
+	},
+{{- end}}
+}
+`))
+
+var testsTemplate = template.Must(template.New("tests").Funcs(funcs).Parse(header + `
+package viewsonic_test
+
+import (
+	"testing"
+
+	"github.com/m-baertschi/viewsonic"
+	"github.com/m-baertschi/viewsonic/emulator"
+)
+
+func emulated(t *testing.T) *viewsonic.ViewSonic {
+	t.Helper()
+	e := emulator.New()
+	if err := e.Listen("127.0.0.1:0"); err != nil {
+		t.Fatal(err)
+	}
+	conn := viewsonic.New(e.Addr())
+	t.Cleanup(func() {
+		conn.Close()
+		e.Close()
+	})
+	return conn
+}
+
+func TestCommandWidths(t *testing.T) {
+	conn := emulated(t)
+	for _, c := range viewsonic.Commands {
+		if c.Access&viewsonic.AccessRead == 0 {
+			continue
+		}
+		data, err := conn.ReadNBytes(c.Code)
+		if err != nil {
+			t.Errorf("%v: %v", c, err)
+		} else if len(data) != 2+c.Width {
+			t.Errorf("%v: got %d bytes, expected %d", c, len(data), 2+c.Width)
+		}
+	}
+}
+{{range .Commands}}{{if .Gen}}
+{{- $c := .}}
+func Test{{.Name}}(t *testing.T) {
+	conn := emulated(t)
+{{- if eq .Type "trigger"}}
+	if err := conn.{{.Name}}(); err != nil {
+		t.Fatal(err)
+	}
+{{- else if eq .Type "key"}}
+	for _, v := range []viewsonic.{{.Name}}{ {{- range .Values}}viewsonic.{{$c.Name}}{{.Ident}}, {{end -}} } {
+		if err := conn.Send{{.Name}}(v); err != nil {
+			t.Fatal(err)
+		}
+	}
+{{- else if eq .Type "bool"}}
+	for _, v := range []bool{true, false} {
+		if err := conn.Set{{.Name}}(v); err != nil {
+			t.Fatal(err)
+		}
+		if got, err := conn.Get{{.Name}}(); err != nil || got != v {
+			t.Errorf("got %v, %v, expected %v", got, err, v)
+		}
+	}
+{{- else if eq .Type "enum"}}
+	for _, v := range []viewsonic.{{.Name}}{ {{- range .Values}}viewsonic.{{$c.Name}}{{.Ident}}, {{end -}} } {
+{{- if .Writable}}
+		if err := conn.Set{{.Name}}(v); err != nil {
+			t.Fatal(err)
+		}
+{{- if .Readable}}
+		if got, err := conn.Get{{.Name}}(); err != nil || got != v {
+			t.Errorf("got %v, %v, expected %v", got, err, v)
+		}
+{{- end}}
+{{- end}}
+		if p, err := viewsonic.Parse{{.Name}}(v.String()); err != nil || p != v {
+			t.Errorf("parse %v: got %v, %v", v, p, err)
+		}
+	}
+{{- else if eq .Type "number"}}
+{{- if and .Writable .HasRange}}
+	for _, v := range []int8{ {{- .Min}}, {{.Max -}} } {
+		if err := conn.Set{{.Name}}(v); err != nil {
+			t.Fatal(err)
+		}
+	}
+{{- if lt .Max 127}}
+	if err := conn.Set{{.Name}}({{.Max}} + 1); err == nil {
+		t.Error("expected out of range error")
+	}
+{{- end}}
+{{- end}}
+{{- else if eq .Type "step"}}
+	before, err := conn.Get{{.Name}}()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := conn.{{(index .Values 1).Ident}}{{.Name}}(); err != nil {
+		t.Fatal(err)
+	}
+	if got, err := conn.Get{{.Name}}(); err != nil || got != before+1 {
+		t.Errorf("got %v, %v, expected %v", got, err, before+1)
+	}
+	if err := conn.{{(index .Values 0).Ident}}{{.Name}}(); err != nil {
+		t.Fatal(err)
+	}
+	if got, err := conn.Get{{.Name}}(); err != nil || got != before {
+		t.Errorf("got %v, %v, expected %v", got, err, before)
+	}
+{{- else if eq .Type "bytes"}}
+	if _, err := conn.Get{{.Name}}(); err != nil {
+		t.Fatal(err)
+	}
+{{- end}}
+}
+{{end}}{{end}}
+`))
+
+var modbusTemplate = template.Must(template.New("modbus").Funcs(funcs).Parse(header + `
+package modbus
+
+import (
+	"slices"
+
+	"github.com/m-baertschi/viewsonic"
+)
+
+// Registers is the register map served by the Server, ordered by kind and address.
+var Registers = slices.Concat(
+{{- range .Registers}}
+{{- if eq .Format "bool" "enum" "number"}}
+	[]*Register{ {
+		Kind: {{.Kind}}, Address: {{.Address}}, Name: {{quote .Name}}, Command: viewsonic.LookupCommand(0x{{printf "%04X" .Command.Code}}),
+		Description: {{.Description}},
+{{- if eq .Format "bool"}}
+		read: readBool((*viewsonic.ViewSonic).{{.Getter}}),
+{{- if .Write}}
+		write: writeBool((*viewsonic.ViewSonic).{{.Setter}}),
+{{- end}}
+{{- else if eq .Format "enum"}}
+		read: readEnum((*viewsonic.ViewSonic).{{.Getter}}),
+{{- if .Write}}
+		write: writeEnum(viewsonic.{{.Type}}Values(), (*viewsonic.ViewSonic).{{.Setter}}),
+{{- end}}
+{{- else}}
+		read: readNumber((*viewsonic.ViewSonic).{{.Getter}}),
+{{- if .Write}}
+		write: writeNumber((*viewsonic.ViewSonic).{{.Setter}}),
+{{- end}}
+{{- end}}
+	} },
+{{- else}}
+	{{.Func}}({{.Kind}}, {{.Address}}, {{quote .Name}}, viewsonic.LookupCommand(0x{{printf "%04X" .Command.Code}})),
+{{- end}}
+{{- end}}
+)
+`))
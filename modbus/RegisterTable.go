@@ -0,0 +1,57 @@
+// Code generated by mkcommands from Commands.csv. DO NOT EDIT.
+
+package modbus
+
+import (
+	"slices"
+
+	"github.com/m-baertschi/viewsonic"
+)
+
+// Registers is the register map served by the Server, ordered by kind and address.
+var Registers = slices.Concat(
+	[]*Register{{
+		Kind: HoldingRegister, Address: 0, Name: "Power", Command: viewsonic.LookupCommand(0x1100),
+		Description: valueList(viewsonic.PowerStateValues()),
+		read:        readEnum((*viewsonic.ViewSonic).GetPower),
+		write:       writeEnum(viewsonic.PowerStateValues(), (*viewsonic.ViewSonic).SetPower),
+	}},
+	[]*Register{{
+		Kind: HoldingRegister, Address: 1, Name: "SourceInput", Command: viewsonic.LookupCommand(0x1301),
+		Description: valueList(viewsonic.SourceInputValues()),
+		read:        readEnum((*viewsonic.ViewSonic).GetSourceInput),
+		write:       writeEnum(viewsonic.SourceInputValues(), (*viewsonic.ViewSonic).SetSourceInput),
+	}},
+	[]*Register{{
+		Kind: HoldingRegister, Address: 2, Name: "Mute", Command: viewsonic.LookupCommand(0x1400),
+		Description: "0 = off, 1 = on",
+		read:        readBool((*viewsonic.ViewSonic).GetMute),
+		write:       writeBool((*viewsonic.ViewSonic).SetMute),
+	}},
+	[]*Register{{
+		Kind: HoldingRegister, Address: 3, Name: "Volume", Command: viewsonic.LookupCommand(0x132A),
+		Description: "Value 0..20, read through command 0x1403 GetVolume",
+		read:        readNumber((*viewsonic.ViewSonic).GetVolume),
+		write:       writeNumber((*viewsonic.ViewSonic).SetVolume),
+	}},
+	[]*Register{{
+		Kind: HoldingRegister, Address: 4, Name: "Blank", Command: viewsonic.LookupCommand(0x1209),
+		Description: "0 = off, 1 = on",
+		read:        readBool((*viewsonic.ViewSonic).GetBlank),
+		write:       writeBool((*viewsonic.ViewSonic).SetBlank),
+	}},
+	[]*Register{{
+		Kind: HoldingRegister, Address: 5, Name: "Freeze", Command: viewsonic.LookupCommand(0x1300),
+		Description: "0 = off, 1 = on",
+		read:        readBool((*viewsonic.ViewSonic).GetFreeze),
+		write:       writeBool((*viewsonic.ViewSonic).SetFreeze),
+	}},
+	[]*Register{{
+		Kind: InputRegister, Address: 0, Name: "ProjectorStatus", Command: viewsonic.LookupCommand(0x1126),
+		Description: valueList(viewsonic.ProjectorStatusValueValues()),
+		read:        readEnum((*viewsonic.ViewSonic).GetProjectorStatus),
+	}},
+	hoursRegisters(InputRegister, 1, "LightSourceUsage", viewsonic.LookupCommand(0x1501)),
+	temperatureRegisters(InputRegister, 3, "Temperature", viewsonic.LookupCommand(0x1503)),
+	errorStatusRegisters(InputRegister, 5, "ErrorStatus", viewsonic.LookupCommand(0x0C0D)),
+)
@@ -0,0 +1,273 @@
+package modbus
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"slices"
+	"strings"
+
+	"github.com/m-baertschi/viewsonic"
+)
+
+//go:generate go run ./internal/mkdoc REGISTERS.md
+
+// Kind is the Modbus register table a register belongs to.
+type Kind int
+
+const (
+	HoldingRegister Kind = iota // read/write, function codes 3, 6 and 16
+	InputRegister               // read only, function code 4
+)
+
+func (k Kind) String() string {
+	switch k {
+	case HoldingRegister:
+		return "Holding"
+	case InputRegister:
+		return "Input"
+	}
+	return fmt.Sprintf("Kind(%d)", int(k))
+}
+
+// Register describes a single 16 bit register and the projector command behind it.
+type Register struct {
+	Kind        Kind
+	Address     uint16
+	Name        string
+	Command     *viewsonic.Command // RS-232 command
+	Description string
+
+	read  func(c *cache) (uint16, error)
+	write func(conn *viewsonic.ViewSonic, value uint16) error
+}
+
+// Writable reports whether the register accepts writes.
+func (r *Register) Writable() bool {
+	return r.write != nil
+}
+
+// cache memoizes projector reads that feed multiple registers during one request.
+type cache struct {
+	conn        *viewsonic.ViewSonic
+	errorStatus *viewsonic.ErrorStatus
+	temp1       float32
+	temp2       float32
+	tempRead    bool
+	usage       uint32
+	usageRead   bool
+}
+
+func (c *cache) getErrorStatus() (*viewsonic.ErrorStatus, error) {
+	if c.errorStatus == nil {
+		status, err := c.conn.GetErrorStatus()
+		if err != nil {
+			return nil, err
+		}
+		c.errorStatus = status
+	}
+	return c.errorStatus, nil
+}
+
+func (c *cache) getTemperature() (float32, float32, error) {
+	if !c.tempRead {
+		t1, t2, err := c.conn.GetOperatingTemperature()
+		if err != nil {
+			return 0, 0, err
+		}
+		c.temp1, c.temp2, c.tempRead = t1, t2, true
+	}
+	return c.temp1, c.temp2, nil
+}
+
+func (c *cache) getUsage() (uint32, error) {
+	if !c.usageRead {
+		usage, err := c.conn.GetLightSourceUsageTime()
+		if err != nil {
+			return 0, err
+		}
+		c.usage, c.usageRead = usage, true
+	}
+	return c.usage, nil
+}
+
+// valueList describes the named values of an enum, e.g. "0 = PowerOff, 1 = WarmUp".
+func valueList[T ~int8](values []T) string {
+	parts := make([]string, len(values))
+	for i, v := range values {
+		parts[i] = fmt.Sprintf("%d = %v", uint8(v), v)
+	}
+	return strings.Join(parts, ", ")
+}
+
+func readBool(get func(*viewsonic.ViewSonic) (bool, error)) func(*cache) (uint16, error) {
+	return func(c *cache) (uint16, error) {
+		b, err := get(c.conn)
+		if b {
+			return 1, err
+		}
+		return 0, err
+	}
+}
+
+func writeBool(set func(*viewsonic.ViewSonic, bool) error) func(*viewsonic.ViewSonic, uint16) error {
+	return func(conn *viewsonic.ViewSonic, value uint16) error {
+		if value > 1 {
+			return errIllegalValue
+		}
+		return set(conn, value == 1)
+	}
+}
+
+func readEnum[T ~int8](get func(*viewsonic.ViewSonic) (T, error)) func(*cache) (uint16, error) {
+	return func(c *cache) (uint16, error) {
+		v, err := get(c.conn)
+		return uint16(uint8(v)), err
+	}
+}
+
+// writeEnum accepts the codes of the values only.
+func writeEnum[T ~int8](values []T, set func(*viewsonic.ViewSonic, T) error) func(*viewsonic.ViewSonic, uint16) error {
+	return func(conn *viewsonic.ViewSonic, value uint16) error {
+		i := slices.IndexFunc(values, func(v T) bool { return uint16(uint8(v)) == value })
+		if value > math.MaxUint8 || i < 0 {
+			return errIllegalValue
+		}
+		return set(conn, values[i])
+	}
+}
+
+func readNumber(get func(*viewsonic.ViewSonic) (int8, error)) func(*cache) (uint16, error) {
+	return func(c *cache) (uint16, error) {
+		v, err := get(c.conn)
+		return uint16(uint8(v)), err
+	}
+}
+
+func writeNumber(set func(*viewsonic.ViewSonic, int8) error) func(*viewsonic.ViewSonic, uint16) error {
+	return func(conn *viewsonic.ViewSonic, value uint16) error {
+		if value > math.MaxInt8 {
+			return errIllegalValue
+		}
+		return set(conn, int8(value))
+	}
+}
+
+// hoursRegisters are the high and the low word of the light source usage.
+func hoursRegisters(kind Kind, address uint16, name string, cmd *viewsonic.Command) []*Register {
+	return []*Register{
+		{
+			Kind: kind, Address: address, Name: name + "High", Command: cmd,
+			Description: "Light source usage in hours, high word",
+			read: func(c *cache) (uint16, error) {
+				usage, err := c.getUsage()
+				return uint16(usage >> 16), err
+			},
+		},
+		{
+			Kind: kind, Address: address + 1, Name: name + "Low", Command: cmd,
+			Description: "Light source usage in hours, low word",
+			read: func(c *cache) (uint16, error) {
+				usage, err := c.getUsage()
+				return uint16(usage), err
+			},
+		},
+	}
+}
+
+// temperatureRegisters are the two operating temperatures.
+func temperatureRegisters(kind Kind, address uint16, name string, cmd *viewsonic.Command) []*Register {
+	registers := make([]*Register, 2)
+	for i := range registers {
+		registers[i] = &Register{
+			Kind: kind, Address: address + uint16(i), Name: fmt.Sprintf("%v%d", name, i+1), Command: cmd,
+			Description: fmt.Sprintf("Operating temperature %d in 0.1 °C", i+1),
+			read: func(c *cache) (uint16, error) {
+				t1, t2, err := c.getTemperature()
+				t := []float32{t1, t2}[i]
+				return uint16(int16(math.Round(float64(t) * 10))), err
+			},
+		}
+	}
+	return registers
+}
+
+// errorStatusRegisters are the lamp status, the lamp error status and the error counters in
+// the order of ErrorStatus.Counters. They are named after the status fields.
+func errorStatusRegisters(kind Kind, address uint16, _ string, cmd *viewsonic.Command) []*Register {
+	registers := []*Register{
+		{
+			Kind: kind, Address: address, Name: "LampStatus", Command: cmd,
+			Description: "LampModeStatus, 6 = normal lamp operation",
+			read: func(c *cache) (uint16, error) {
+				status, err := c.getErrorStatus()
+				if err != nil {
+					return 0, err
+				}
+				return uint16(uint8(status.LampStatus)), nil
+			},
+		},
+		{
+			Kind: kind, Address: address + 1, Name: "LampErrorStatus", Command: cmd,
+			Description: "LampModeErrorStatus, 0 = no error",
+			read: func(c *cache) (uint16, error) {
+				status, err := c.getErrorStatus()
+				if err != nil {
+					return 0, err
+				}
+				return uint16(status.LampErrorStatus), nil
+			},
+		},
+	}
+	for i, counter := range (&viewsonic.ErrorStatus{}).Counters() {
+		registers = append(registers, &Register{
+			Kind: kind, Address: address + 2 + uint16(i), Name: counter.Name, Command: cmd,
+			Description: fmt.Sprintf("Error counter (%v)", counter.Category),
+			read: func(c *cache) (uint16, error) {
+				status, err := c.getErrorStatus()
+				if err != nil {
+					return 0, err
+				}
+				return uint16(status.Counters()[i].Value), nil
+			},
+		})
+	}
+	return registers
+}
+
+func lookupRegister(kind Kind, address uint16) *Register {
+	for _, r := range Registers {
+		if r.Kind == kind && r.Address == address {
+			return r
+		}
+	}
+	return nil
+}
+
+// WriteRegisterMap writes the register map as a Markdown document.
+func WriteRegisterMap(w io.Writer) error {
+	fmt.Fprintln(w, "# Modbus Register Map")
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "Generated by `go generate`, do not edit.")
+	fmt.Fprintln(w, "The unit identifier selects the projector, all values are unsigned 16 bit unless stated otherwise.")
+
+	for _, kind := range []Kind{HoldingRegister, InputRegister} {
+		fmt.Fprintln(w)
+		fmt.Fprintf(w, "## %v Registers\n\n", kind)
+		fmt.Fprintln(w, "| Address | Name | Access | Command | Description |")
+		fmt.Fprintln(w, "| :---- | :---- | :---- | :---- | :---- |")
+		for _, r := range Registers {
+			if r.Kind != kind {
+				continue
+			}
+			access := "R"
+			if r.Writable() {
+				access = "R/W"
+			}
+			if _, err := fmt.Fprintf(w, "| %d | %v | %v | %v | %v |\n", r.Address, r.Name, access, r.Command, r.Description); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,57 @@
+package modbus
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"testing"
+
+	"github.com/m-baertschi/viewsonic"
+)
+
+func TestRegisterMapUpToDate(t *testing.T) {
+	var buf bytes.Buffer
+	if err := WriteRegisterMap(&buf); err != nil {
+		t.Fatal(err)
+	}
+	doc, err := os.ReadFile("REGISTERS.md")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(buf.Bytes(), doc) {
+		t.Error("REGISTERS.md is out of date, run go generate")
+	}
+}
+
+func TestRegisterAddressesUnique(t *testing.T) {
+	seen := map[Kind]map[uint16]string{HoldingRegister: {}, InputRegister: {}}
+	for _, r := range Registers {
+		if other, ok := seen[r.Kind][r.Address]; ok {
+			t.Errorf("%v register %d is %v and %v", r.Kind, r.Address, other, r.Name)
+		}
+		seen[r.Kind][r.Address] = r.Name
+		if r.Command == nil {
+			t.Errorf("%v has no command", r.Name)
+		}
+		if r.Writable() != (r.Kind == HoldingRegister) {
+			t.Errorf("%v register %v writable = %v", r.Kind, r.Name, r.Writable())
+		}
+	}
+}
+
+func TestWriteEnumAcceptsValuesOnly(t *testing.T) {
+	var written []viewsonic.PowerState
+	write := writeEnum(viewsonic.PowerStateValues(), func(conn *viewsonic.ViewSonic, v viewsonic.PowerState) error {
+		written = append(written, v)
+		return nil
+	})
+	for _, value := range []uint16{1, 2, 0x101} {
+		err := write(nil, value)
+		if value == 1 && err != nil || value != 1 && !errors.Is(err, errIllegalValue) {
+			t.Errorf("write %d = %v", value, err)
+		}
+	}
+	if len(written) != 1 || written[0] != viewsonic.PowerStateOn {
+		t.Errorf("written %v, want on", written)
+	}
+}
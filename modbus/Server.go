@@ -0,0 +1,239 @@
+// Package modbus exposes ViewSonic projectors as a Modbus TCP slave for building management systems.
+// See REGISTERS.md for the register map.
+package modbus
+
+import (
+	"encoding/binary"
+	"errors"
+	"io"
+	"log"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/m-baertschi/viewsonic"
+)
+
+// DefaultPort is the Modbus TCP port.
+const DefaultPort = 502
+
+// Function codes
+const (
+	fcReadHoldingRegisters   = 0x03
+	fcReadInputRegisters     = 0x04
+	fcWriteSingleRegister    = 0x06
+	fcWriteMultipleRegisters = 0x10
+)
+
+// Exception codes
+const (
+	exIllegalFunction     = 0x01
+	exIllegalDataAddress  = 0x02
+	exIllegalDataValue    = 0x03
+	exServerDeviceFailure = 0x04
+	exGatewayTargetFailed = 0x0B
+)
+
+var errIllegalValue = errors.New("modbus: illegal data value")
+
+// Server is a Modbus TCP server. The unit identifier of a request selects the projector.
+type Server struct {
+	// IdleTimeout closes connections without a request for this long.
+	IdleTimeout time.Duration
+
+	projectors map[uint8]*viewsonic.ViewSonic
+
+	mutex     sync.Mutex
+	listeners map[net.Listener]struct{}
+}
+
+// NewServer creates a server for the projectors, keyed by unit identifier.
+func NewServer(projectors map[uint8]*viewsonic.ViewSonic) *Server {
+	return &Server{
+		IdleTimeout: 60 * time.Second,
+		projectors:  projectors,
+		listeners:   map[net.Listener]struct{}{},
+	}
+}
+
+// ListenAndServe listens on addr (e.g. ":502") and serves connections.
+func (s *Server) ListenAndServe(addr string) error {
+	l, err := net.Listen("tcp", addr)
+	if err != nil {
+		return err
+	}
+	return s.Serve(l)
+}
+
+// Serve accepts connections on l until it is closed.
+func (s *Server) Serve(l net.Listener) error {
+	s.mutex.Lock()
+	s.listeners[l] = struct{}{}
+	s.mutex.Unlock()
+
+	defer func() {
+		s.mutex.Lock()
+		delete(s.listeners, l)
+		s.mutex.Unlock()
+	}()
+
+	for {
+		conn, err := l.Accept()
+		if err != nil {
+			if errors.Is(err, net.ErrClosed) {
+				return nil
+			}
+			return err
+		}
+		go s.serveConn(conn)
+	}
+}
+
+// Close stops all listeners.
+func (s *Server) Close() error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	var errs []error
+	for l := range s.listeners {
+		errs = append(errs, l.Close())
+	}
+	return errors.Join(errs...)
+}
+
+func (s *Server) serveConn(conn net.Conn) {
+	defer conn.Close()
+
+	header := make([]byte, 7)
+	for {
+		conn.SetReadDeadline(time.Now().Add(s.IdleTimeout))
+		if _, err := io.ReadFull(conn, header); err != nil {
+			return
+		}
+
+		// MBAP header: transaction id, protocol id, length, unit id
+		protocol := binary.BigEndian.Uint16(header[2:4])
+		length := binary.BigEndian.Uint16(header[4:6])
+		if protocol != 0 || length < 2 || length > 254 {
+			log.Printf("modbus: invalid header from %v: %x", conn.RemoteAddr(), header)
+			return
+		}
+
+		pdu := make([]byte, length-1)
+		if _, err := io.ReadFull(conn, pdu); err != nil {
+			return
+		}
+
+		resp := s.handle(header[6], pdu)
+
+		out := make([]byte, 0, 7+len(resp))
+		out = append(out, header[:4]...)
+		out = binary.BigEndian.AppendUint16(out, uint16(len(resp)+1))
+		out = append(out, header[6])
+		out = append(out, resp...)
+
+		conn.SetWriteDeadline(time.Now().Add(5 * time.Second))
+		if _, err := conn.Write(out); err != nil {
+			return
+		}
+	}
+}
+
+func exception(fc byte, code byte) []byte {
+	return []byte{fc | 0x80, code}
+}
+
+// handle executes a request PDU and returns the response PDU.
+func (s *Server) handle(unit byte, pdu []byte) []byte {
+	fc := pdu[0]
+
+	conn, ok := s.projectors[unit]
+	if !ok {
+		return exception(fc, exGatewayTargetFailed)
+	}
+
+	switch fc {
+	case fcReadHoldingRegisters, fcReadInputRegisters:
+		if len(pdu) != 5 {
+			return exception(fc, exIllegalDataValue)
+		}
+		start := binary.BigEndian.Uint16(pdu[1:3])
+		count := binary.BigEndian.Uint16(pdu[3:5])
+		if count < 1 || count > 125 {
+			return exception(fc, exIllegalDataValue)
+		}
+
+		kind := HoldingRegister
+		if fc == fcReadInputRegisters {
+			kind = InputRegister
+		}
+
+		c := &cache{conn: conn.WithPriority(viewsonic.PriorityPolling)}
+		resp := []byte{fc, byte(count * 2)}
+		for i := uint16(0); i < count; i++ {
+			r := lookupRegister(kind, start+i)
+			if r == nil {
+				return exception(fc, exIllegalDataAddress)
+			}
+			value, err := r.read(c)
+			if err != nil {
+				log.Printf("modbus: read %v: %v", r.Name, err)
+				return exception(fc, exServerDeviceFailure)
+			}
+			resp = binary.BigEndian.AppendUint16(resp, value)
+		}
+		return resp
+
+	case fcWriteSingleRegister:
+		if len(pdu) != 5 {
+			return exception(fc, exIllegalDataValue)
+		}
+		address := binary.BigEndian.Uint16(pdu[1:3])
+		value := binary.BigEndian.Uint16(pdu[3:5])
+		if code := write(conn, address, value); code != 0 {
+			return exception(fc, code)
+		}
+		return pdu
+
+	case fcWriteMultipleRegisters:
+		if len(pdu) < 6 {
+			return exception(fc, exIllegalDataValue)
+		}
+		start := binary.BigEndian.Uint16(pdu[1:3])
+		count := binary.BigEndian.Uint16(pdu[3:5])
+		if count < 1 || count > 123 || int(pdu[5]) != int(count)*2 || len(pdu) != 6+int(count)*2 {
+			return exception(fc, exIllegalDataValue)
+		}
+		for i := uint16(0); i < count; i++ {
+			if r := lookupRegister(HoldingRegister, start+i); r == nil || !r.Writable() {
+				return exception(fc, exIllegalDataAddress)
+			}
+		}
+		for i := uint16(0); i < count; i++ {
+			value := binary.BigEndian.Uint16(pdu[6+i*2:])
+			if code := write(conn, start+i, value); code != 0 {
+				return exception(fc, code)
+			}
+		}
+		return pdu[:5]
+	}
+
+	return exception(fc, exIllegalFunction)
+}
+
+// write sets a holding register and returns an exception code or 0.
+func write(conn *viewsonic.ViewSonic, address, value uint16) byte {
+	r := lookupRegister(HoldingRegister, address)
+	if r == nil || !r.Writable() {
+		return exIllegalDataAddress
+	}
+
+	if err := r.write(conn, value); err != nil {
+		if errors.Is(err, errIllegalValue) {
+			return exIllegalDataValue
+		}
+		log.Printf("modbus: write %v: %v", r.Name, err)
+		return exServerDeviceFailure
+	}
+	return 0
+}
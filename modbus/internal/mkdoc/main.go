@@ -0,0 +1,27 @@
+// Command mkdoc writes the Modbus register map documentation.
+package main
+
+import (
+	"log"
+	"os"
+
+	"github.com/m-baertschi/viewsonic/modbus"
+)
+
+func main() {
+	if len(os.Args) != 2 {
+		log.Fatal("usage: mkdoc <output file>")
+	}
+
+	f, err := os.Create(os.Args[1])
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if err := modbus.WriteRegisterMap(f); err != nil {
+		log.Fatal(err)
+	}
+	if err := f.Close(); err != nil {
+		log.Fatal(err)
+	}
+}
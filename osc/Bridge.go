@@ -0,0 +1,336 @@
+package osc
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"math"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/m-baertschi/viewsonic"
+)
+
+// sourceAliases are the short OSC names of sources, all other sources use the lower case
+// name of the SourceInput.
+var sourceAliases = map[string]viewsonic.SourceInput{
+	"mhl": viewsonic.SourceInputHDMIMHL4,
+	"lan": viewsonic.SourceInputLANWiFi,
+}
+
+func sourceName(input viewsonic.SourceInput) string {
+	for name, in := range sourceAliases {
+		if in == input {
+			return name
+		}
+	}
+	text, _ := input.MarshalText()
+	return strings.ToLower(string(text))
+}
+
+// Bridge listens for OSC messages such as "/projector/1/blank 1" and forwards them
+// to the projector with the given id. A message without arguments toggles the
+// on/off functions, e.g. "/projector/1/freeze".
+// Appending "/get" queries a function, the bridge replies to the sender with the
+// address without "/get" and the current value. Errors are replied on "/projector/<id>/error".
+//
+// Supported functions: power, blank, freeze, mute, source and volume.
+//
+// The messages of each projector are handled in order, a slow projector does not hold up
+// the others.
+type Bridge struct {
+	// Prefix of all addresses, defaults to "/projector".
+	Prefix string
+
+	projectors map[string]*viewsonic.ViewSonic
+
+	mutex sync.Mutex
+	conn  net.PacketConn
+}
+
+// received is a message waiting for its projector.
+type received struct {
+	msg  Message
+	from net.Addr
+}
+
+// queueSize is the number of messages per projector that may wait, further messages are
+// dropped like lost packets.
+const queueSize = 64
+
+// NewBridge creates a bridge for the projectors, keyed by the id used in the address.
+func NewBridge(projectors map[string]*viewsonic.ViewSonic) *Bridge {
+	return &Bridge{
+		Prefix:     "/projector",
+		projectors: projectors,
+	}
+}
+
+// ListenAndServe listens on the UDP address (e.g. ":53000") and serves messages.
+func (b *Bridge) ListenAndServe(addr string) error {
+	conn, err := net.ListenPacket("udp", addr)
+	if err != nil {
+		return err
+	}
+	return b.Serve(conn)
+}
+
+// Serve handles packets from conn until it is closed.
+func (b *Bridge) Serve(conn net.PacketConn) error {
+	b.mutex.Lock()
+	b.conn = conn
+	b.mutex.Unlock()
+
+	// One goroutine per projector handles its messages
+	queues := map[string]chan received{}
+	defer func() {
+		for _, q := range queues {
+			close(q)
+		}
+	}()
+
+	buf := make([]byte, 65536)
+	for {
+		n, from, err := conn.ReadFrom(buf)
+		if err != nil {
+			if errors.Is(err, net.ErrClosed) {
+				return nil
+			}
+			return err
+		}
+
+		messages, err := Parse(buf[:n])
+		if err != nil {
+			log.Printf("osc: invalid packet from %v: %v", from, err)
+			continue
+		}
+		for _, msg := range messages {
+			id, _, _, ok := b.split(msg.Address)
+			if !ok {
+				log.Printf("osc: unknown address %v", msg.Address)
+				continue
+			}
+			conn, ok := b.projectors[id]
+			if !ok {
+				log.Printf("osc: unknown projector %q", id)
+				continue
+			}
+			q, ok := queues[id]
+			if !ok {
+				q = make(chan received, queueSize)
+				queues[id] = q
+				go func() {
+					for r := range q {
+						b.handle(conn, r.msg, r.from)
+					}
+				}()
+			}
+			select {
+			case q <- received{msg: msg, from: from}:
+			default:
+				log.Printf("osc: projector %q busy, dropped %v", id, msg.Address)
+			}
+		}
+	}
+}
+
+// Close stops the bridge.
+func (b *Bridge) Close() error {
+	b.mutex.Lock()
+	conn := b.conn
+	b.mutex.Unlock()
+	if conn == nil {
+		return nil
+	}
+	return conn.Close()
+}
+
+func (b *Bridge) reply(to net.Addr, address string, args ...any) {
+	data, err := Message{Address: address, Arguments: args}.Encode()
+	if err != nil {
+		log.Printf("osc: %v", err)
+		return
+	}
+	b.mutex.Lock()
+	conn := b.conn
+	b.mutex.Unlock()
+	if _, err := conn.WriteTo(data, to); err != nil {
+		log.Printf("osc: reply to %v: %v", to, err)
+	}
+}
+
+// split splits an address /projector/<id>/<function>[/get].
+func (b *Bridge) split(address string) (id, function string, isQuery, ok bool) {
+	parts := strings.Split(strings.TrimPrefix(address, b.Prefix), "/")
+	isQuery = len(parts) == 4 && parts[3] == "get"
+	if !strings.HasPrefix(address, b.Prefix+"/") || (len(parts) != 3 && !isQuery) {
+		return "", "", false, false
+	}
+	return parts[1], parts[2], isQuery, true
+}
+
+func (b *Bridge) handle(conn *viewsonic.ViewSonic, msg Message, from net.Addr) {
+	id, function, isQuery, _ := b.split(msg.Address)
+	address := b.Prefix + "/" + id + "/" + function
+
+	var value any
+	var err error
+	switch {
+	case isQuery:
+		value, err = query(conn, function)
+	case len(msg.Arguments) == 0:
+		err = toggle(conn, function)
+	default:
+		err = set(conn, function, msg.Arguments[0])
+	}
+
+	if err != nil {
+		log.Printf("osc: %v: %v", msg.Address, err)
+		b.reply(from, b.Prefix+"/"+id+"/error", function+": "+err.Error())
+		return
+	}
+	if value != nil {
+		b.reply(from, address, value)
+	}
+}
+
+func query(conn *viewsonic.ViewSonic, function string) (any, error) {
+	switch function {
+	case "power":
+		power, err := conn.GetPower()
+		return boolArg(power == viewsonic.PowerStateOn), err
+	case "blank":
+		blank, err := conn.GetBlank()
+		return boolArg(blank), err
+	case "freeze":
+		freeze, err := conn.GetFreeze()
+		return boolArg(freeze), err
+	case "mute":
+		mute, err := conn.GetMute()
+		return boolArg(mute), err
+	case "source":
+		input, err := conn.GetSourceInput()
+		return sourceName(input), err
+	case "volume":
+		volume, err := conn.GetVolume()
+		return int32(volume), err
+	}
+	return nil, fmt.Errorf("unknown function %q", function)
+}
+
+func toggle(conn *viewsonic.ViewSonic, function string) error {
+	switch function {
+	case "power", "blank", "freeze", "mute":
+	default:
+		return fmt.Errorf("function %q needs an argument", function)
+	}
+
+	value, err := query(conn, function)
+	if err != nil {
+		return err
+	}
+	return set(conn, function, value.(int32) == 0)
+}
+
+func set(conn *viewsonic.ViewSonic, function string, arg any) error {
+	switch function {
+	case "power":
+		on, err := toBool(arg)
+		if err != nil {
+			return err
+		}
+		if on {
+			return conn.SetPower(viewsonic.PowerStateOn)
+		}
+		return conn.SetPower(viewsonic.PowerStateOff)
+	case "blank":
+		on, err := toBool(arg)
+		if err != nil {
+			return err
+		}
+		return conn.SetBlank(on)
+	case "freeze":
+		on, err := toBool(arg)
+		if err != nil {
+			return err
+		}
+		return conn.SetFreeze(on)
+	case "mute":
+		on, err := toBool(arg)
+		if err != nil {
+			return err
+		}
+		return conn.SetMute(on)
+	case "source":
+		input, err := toSource(arg)
+		if err != nil {
+			return err
+		}
+		return conn.SetSourceInput(input)
+	case "volume":
+		level, err := toInt(arg)
+		if err != nil {
+			return err
+		}
+		if level < 0 || level > math.MaxInt8 {
+			return fmt.Errorf("volume %d out of range", level)
+		}
+		return conn.SetVolume(int8(level))
+	}
+	return fmt.Errorf("unknown function %q", function)
+}
+
+// boolArg replies booleans as integers, which every OSC client understands.
+func boolArg(b bool) int32 {
+	if b {
+		return 1
+	}
+	return 0
+}
+
+func toBool(arg any) (bool, error) {
+	switch v := arg.(type) {
+	case bool:
+		return v, nil
+	case int32:
+		return v != 0, nil
+	case float32:
+		return v >= 0.5, nil
+	case string:
+		switch strings.ToLower(v) {
+		case "1", "on", "true":
+			return true, nil
+		case "0", "off", "false":
+			return false, nil
+		}
+	}
+	return false, fmt.Errorf("invalid boolean argument %v", arg)
+}
+
+func toInt(arg any) (int, error) {
+	switch v := arg.(type) {
+	case int32:
+		return int(v), nil
+	case float32:
+		return int(v + 0.5), nil
+	case string:
+		return strconv.Atoi(v)
+	}
+	return 0, fmt.Errorf("invalid integer argument %v", arg)
+}
+
+func toSource(arg any) (viewsonic.SourceInput, error) {
+	if name, ok := arg.(string); ok {
+		if input, ok := sourceAliases[strings.ToLower(name)]; ok {
+			return input, nil
+		}
+		return viewsonic.ParseSourceInput(name)
+	}
+	code, err := toInt(arg)
+	if err != nil {
+		return 0, err
+	}
+	return viewsonic.SourceInput(code), nil
+}
@@ -0,0 +1,132 @@
+package osc
+
+import (
+	"fmt"
+	"io"
+	"net"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/m-baertschi/viewsonic"
+	"github.com/m-baertschi/viewsonic/emulator"
+)
+
+func TestBridgeHandlesProjectorsIndependently(t *testing.T) {
+	e := emulator.New()
+	if err := e.Listen("127.0.0.1:0"); err != nil {
+		t.Fatal(err)
+	}
+	defer e.Close()
+
+	// A projector that never answers
+	silent, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer silent.Close()
+	go func() {
+		for {
+			conn, err := silent.Accept()
+			if err != nil {
+				return
+			}
+			go io.Copy(io.Discard, conn)
+		}
+	}()
+
+	fast, slow := viewsonic.New(e.Addr()), viewsonic.New(silent.Addr().String())
+	defer fast.Close()
+	defer slow.Close()
+
+	b := NewBridge(map[string]*viewsonic.ViewSonic{"1": fast, "2": slow})
+	server, err := net.ListenPacket("udp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	done := make(chan error)
+	go func() { done <- b.Serve(server) }()
+
+	client, err := net.ListenPacket("udp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer client.Close()
+	for _, address := range []string{"/projector/2/blank/get", "/projector/1/blank/get"} {
+		data, _ := Message{Address: address}.Encode()
+		if _, err := client.WriteTo(data, server.LocalAddr()); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	client.SetReadDeadline(time.Now().Add(time.Second))
+	buf := make([]byte, 1024)
+	n, _, err := client.ReadFrom(buf)
+	if err != nil {
+		t.Fatalf("no reply while the other projector is busy: %v", err)
+	}
+	messages, err := Parse(buf[:n])
+	if err != nil || len(messages) != 1 || messages[0].Address != "/projector/1/blank" {
+		t.Errorf("reply %v, %v", messages, err)
+	}
+
+	if err := b.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if err := <-done; err != nil {
+		t.Errorf("Serve: %v", err)
+	}
+}
+
+func TestBridgeIgnoresUnknownProjectors(t *testing.T) {
+	e := emulator.New()
+	if err := e.Listen("127.0.0.1:0"); err != nil {
+		t.Fatal(err)
+	}
+	defer e.Close()
+	conn := viewsonic.New(e.Addr())
+	defer conn.Close()
+
+	b := NewBridge(map[string]*viewsonic.ViewSonic{"1": conn})
+	server, err := net.ListenPacket("udp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	done := make(chan error)
+	go func() { done <- b.Serve(server) }()
+	defer func() {
+		b.Close()
+		<-done
+	}()
+
+	client, err := net.ListenPacket("udp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer client.Close()
+	send := func(address string) {
+		data, _ := Message{Address: address}.Encode()
+		if _, err := client.WriteTo(data, server.LocalAddr()); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	goroutines := runtime.NumGoroutine()
+	for i := 0; i < 100; i++ {
+		send(fmt.Sprintf("/projector/spoofed%d/blank/get", i))
+	}
+	// Messages are read in order, the reply means the unknown ids were handled
+	send("/projector/1/blank/get")
+	client.SetReadDeadline(time.Now().Add(2 * time.Second))
+	buf := make([]byte, 1024)
+	n, _, err := client.ReadFrom(buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if messages, err := Parse(buf[:n]); err != nil || messages[0].Address != "/projector/1/blank" {
+		t.Errorf("reply %v, %v, want the blank state of projector 1", messages, err)
+	}
+	if added := runtime.NumGoroutine() - goroutines; added > 10 {
+		t.Errorf("%d goroutines added for unknown projectors", added)
+	}
+}
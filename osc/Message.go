@@ -0,0 +1,162 @@
+// Package osc bridges Open Sound Control messages over UDP to ViewSonic projectors,
+// so show control software can cue blank, freeze, source and volume changes.
+package osc
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"math"
+	"strings"
+)
+
+// Message is a single OSC message. Arguments are int32, float32, string or bool.
+type Message struct {
+	Address   string
+	Arguments []any
+}
+
+// Encode returns the binary OSC representation of the message.
+func (m Message) Encode() ([]byte, error) {
+	var buf bytes.Buffer
+	writeString(&buf, m.Address)
+
+	tags := []byte{','}
+	var args bytes.Buffer
+	for _, arg := range m.Arguments {
+		switch v := arg.(type) {
+		case int32:
+			tags = append(tags, 'i')
+			binary.Write(&args, binary.BigEndian, v)
+		case int:
+			tags = append(tags, 'i')
+			binary.Write(&args, binary.BigEndian, int32(v))
+		case float32:
+			tags = append(tags, 'f')
+			binary.Write(&args, binary.BigEndian, math.Float32bits(v))
+		case string:
+			tags = append(tags, 's')
+			writeString(&args, v)
+		case bool:
+			if v {
+				tags = append(tags, 'T')
+			} else {
+				tags = append(tags, 'F')
+			}
+		default:
+			return nil, fmt.Errorf("osc: unsupported argument type %T", arg)
+		}
+	}
+
+	writeString(&buf, string(tags))
+	buf.Write(args.Bytes())
+	return buf.Bytes(), nil
+}
+
+// writeString writes a null terminated string padded to a multiple of 4 bytes.
+func writeString(buf *bytes.Buffer, s string) {
+	buf.WriteString(s)
+	buf.Write(make([]byte, 4-len(s)%4))
+}
+
+func readString(data []byte) (string, []byte, error) {
+	end := bytes.IndexByte(data, 0)
+	if end < 0 {
+		return "", nil, fmt.Errorf("osc: unterminated string")
+	}
+	size := (end/4 + 1) * 4
+	if size > len(data) {
+		return "", nil, fmt.Errorf("osc: string padding exceeds packet")
+	}
+	return string(data[:end]), data[size:], nil
+}
+
+// Parse decodes a packet into messages. Bundles are flattened, their time tags are ignored.
+func Parse(data []byte) ([]Message, error) {
+	if bytes.HasPrefix(data, []byte("#bundle\x00")) {
+		if len(data) < 16 {
+			return nil, fmt.Errorf("osc: bundle too short")
+		}
+		data = data[16:] // "#bundle\0" and the time tag
+
+		var messages []Message
+		for len(data) > 0 {
+			if len(data) < 4 {
+				return nil, fmt.Errorf("osc: truncated bundle element")
+			}
+			size := int(binary.BigEndian.Uint32(data))
+			if size > len(data)-4 {
+				return nil, fmt.Errorf("osc: bundle element exceeds packet")
+			}
+			inner, err := Parse(data[4 : 4+size])
+			if err != nil {
+				return nil, err
+			}
+			messages = append(messages, inner...)
+			data = data[4+size:]
+		}
+		return messages, nil
+	}
+
+	msg, err := parseMessage(data)
+	if err != nil {
+		return nil, err
+	}
+	return []Message{msg}, nil
+}
+
+func parseMessage(data []byte) (Message, error) {
+	address, data, err := readString(data)
+	if err != nil {
+		return Message{}, err
+	}
+	if !strings.HasPrefix(address, "/") {
+		return Message{}, fmt.Errorf("osc: invalid address %q", address)
+	}
+
+	msg := Message{Address: address}
+	if len(data) == 0 {
+		// Old implementations omit the type tag string if there are no arguments
+		return msg, nil
+	}
+
+	tags, data, err := readString(data)
+	if err != nil {
+		return Message{}, err
+	}
+	if !strings.HasPrefix(tags, ",") {
+		return Message{}, fmt.Errorf("osc: invalid type tags %q", tags)
+	}
+
+	for _, tag := range tags[1:] {
+		switch tag {
+		case 'i', 'f':
+			if len(data) < 4 {
+				return Message{}, fmt.Errorf("osc: truncated argument")
+			}
+			v := binary.BigEndian.Uint32(data)
+			if tag == 'i' {
+				msg.Arguments = append(msg.Arguments, int32(v))
+			} else {
+				msg.Arguments = append(msg.Arguments, math.Float32frombits(v))
+			}
+			data = data[4:]
+		case 's':
+			var s string
+			s, data, err = readString(data)
+			if err != nil {
+				return Message{}, err
+			}
+			msg.Arguments = append(msg.Arguments, s)
+		case 'T':
+			msg.Arguments = append(msg.Arguments, true)
+		case 'F':
+			msg.Arguments = append(msg.Arguments, false)
+		case 'N', 'I':
+			// Nil and Impulse carry no data
+		default:
+			return Message{}, fmt.Errorf("osc: unsupported type tag %q", tag)
+		}
+	}
+	return msg, nil
+}
@@ -0,0 +1,365 @@
+package pjlink
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/m-baertschi/viewsonic"
+)
+
+// Client is a connection to a PJLink projector. It is designed to be thread-safe.
+// The TCP connection is opened on demand and reopened if the projector closed it,
+// which most projectors do after 30 seconds of inactivity.
+type Client struct {
+	// Timeout applies to connecting and to every command.
+	Timeout time.Duration
+
+	addr     string
+	password string
+
+	mutex  sync.Mutex
+	conn   net.Conn
+	reader *bufio.Reader
+	digest string // pending digest, sent with the first command of a connection
+}
+
+var _ viewsonic.Projector = (*Client)(nil)
+
+// NewClient creates a client for the projector at addr (host or host:port).
+// password may be empty if the projector does not use authentication.
+func NewClient(addr, password string) *Client {
+	if _, _, err := net.SplitHostPort(addr); err != nil {
+		addr = net.JoinHostPort(addr, strconv.Itoa(DefaultPort))
+	}
+	return &Client{
+		Timeout:  5 * time.Second,
+		addr:     addr,
+		password: password,
+	}
+}
+
+// connect opens the connection and handles the authentication greeting.
+func (c *Client) connect() error {
+	conn, err := net.DialTimeout("tcp", c.addr, c.Timeout)
+	if err != nil {
+		return err
+	}
+
+	reader := bufio.NewReader(conn)
+	conn.SetReadDeadline(time.Now().Add(c.Timeout))
+	greeting, err := readLine(reader)
+	if err != nil {
+		conn.Close()
+		return fmt.Errorf("pjlink: reading greeting: %w", err)
+	}
+
+	switch {
+	case greeting == "PJLINK 0":
+		c.digest = ""
+	case strings.HasPrefix(greeting, "PJLINK 1 "):
+		if c.password == "" {
+			conn.Close()
+			return fmt.Errorf("%w: projector requires a password", ErrAuthentication)
+		}
+		c.digest = digest(strings.TrimPrefix(greeting, "PJLINK 1 "), c.password)
+	case greeting == "PJLINK ERRA":
+		conn.Close()
+		return ErrAuthentication
+	default:
+		conn.Close()
+		return fmt.Errorf("pjlink: unexpected greeting %q", greeting)
+	}
+
+	c.conn = conn
+	c.reader = reader
+	return nil
+}
+
+func readLine(r *bufio.Reader) (string, error) {
+	line, err := r.ReadString('\r')
+	if err != nil {
+		return "", err
+	}
+	// Some implementations send "\r\n", drop a leading "\n" left from the previous line
+	return strings.TrimLeft(strings.TrimSuffix(line, "\r"), "\n"), nil
+}
+
+// Command sends a single command such as ("POWR", "1") and returns the response data.
+// Error responses are returned as errors.
+func (c *Client) Command(class byte, command, param string) (string, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	reused := c.conn != nil
+	data, sent, err := c.command(class, command, param)
+	if err != nil && reused && !sent {
+		// The projector closed the idle connection before the command reached it, try once
+		// more. Commands that were written are never resent, e.g. a POWR 1 without response.
+		data, _, err = c.command(class, command, param)
+	}
+	return data, err
+}
+
+// command sends a command and reads the response. sent reports whether the command was
+// written, after that an error may follow a command the projector executed.
+func (c *Client) command(class byte, command, param string) (data string, sent bool, err error) {
+	if c.conn != nil && !c.alive() {
+		c.close()
+	}
+	if c.conn == nil {
+		if err := c.connect(); err != nil {
+			return "", false, err
+		}
+	}
+
+	line := fmt.Sprintf("%s%%%c%s %s\r", c.digest, class, command, param)
+	c.digest = ""
+
+	c.conn.SetDeadline(time.Now().Add(c.Timeout))
+	if _, err := c.conn.Write([]byte(line)); err != nil {
+		c.close()
+		return "", false, err
+	}
+	data, err = c.response(command)
+	return data, true, err
+}
+
+// alive reports whether the projector kept the idle connection open, a closed connection
+// reads EOF right away.
+func (c *Client) alive() bool {
+	c.conn.SetReadDeadline(time.Now().Add(time.Millisecond))
+	_, err := c.reader.Peek(1)
+	var netErr net.Error
+	return err == nil || errors.As(err, &netErr) && netErr.Timeout()
+}
+
+// response reads the response to command.
+func (c *Client) response(command string) (string, error) {
+
+	for {
+		raw, err := readLine(c.reader)
+		if err != nil {
+			c.close()
+			return "", fmt.Errorf("pjlink: %v: %w", command, err)
+		}
+
+		if raw == "PJLINK ERRA" {
+			c.close()
+			return "", ErrAuthentication
+		}
+
+		resp, err := parseResponse(raw)
+		if err != nil {
+			c.close()
+			return "", err
+		}
+		if resp.Command != command {
+			// Skip unrelated lines such as Class 2 notifications
+			continue
+		}
+		if err := resp.Err(); err != nil {
+			return "", err
+		}
+		return resp.Data, nil
+	}
+}
+
+func (c *Client) close() {
+	if c.conn != nil {
+		c.conn.Close()
+		c.conn = nil
+		c.reader = nil
+	}
+}
+
+// Close closes the connection to the projector.
+func (c *Client) Close() {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.close()
+}
+
+func (c *Client) set(command, param string) error {
+	data, err := c.Command('1', command, param)
+	if err != nil {
+		return err
+	}
+	if data != "OK" {
+		return fmt.Errorf("pjlink: unexpected response to %v %v: %q", command, param, data)
+	}
+	return nil
+}
+
+func (c *Client) get(command string) (string, error) {
+	return c.Command('1', command, "?")
+}
+
+// SetPower turns the projector on or off (POWR).
+func (c *Client) SetPower(state viewsonic.PowerState) error {
+	if state == viewsonic.PowerStateOn {
+		return c.set("POWR", "1")
+	}
+	return c.set("POWR", "0")
+}
+
+// GetPower returns PowerStateOn while the projector is on or warming up.
+func (c *Client) GetPower() (viewsonic.PowerState, error) {
+	status, err := c.GetProjectorStatus()
+	if err != nil {
+		return 0, err
+	}
+	if status == viewsonic.ProjectorStatusPowerOn || status == viewsonic.ProjectorStatusWarmUp {
+		return viewsonic.PowerStateOn, nil
+	}
+	return viewsonic.PowerStateOff, nil
+}
+
+// GetProjectorStatus maps the PJLink power status onto the ViewSonic status values.
+func (c *Client) GetProjectorStatus() (viewsonic.ProjectorStatusValue, error) {
+	data, err := c.get("POWR")
+	if err != nil {
+		return 0, err
+	}
+	switch data {
+	case PowerOff:
+		return viewsonic.ProjectorStatusPowerOff, nil
+	case PowerOn:
+		return viewsonic.ProjectorStatusPowerOn, nil
+	case PowerCooling:
+		return viewsonic.ProjectorStatusCoolDown, nil
+	case PowerWarmUp:
+		return viewsonic.ProjectorStatusWarmUp, nil
+	}
+	return 0, fmt.Errorf("pjlink: unexpected power status %q", data)
+}
+
+// SetSourceInput switches the input (INPT).
+func (c *Client) SetSourceInput(input viewsonic.SourceInput) error {
+	code, err := InputCode(input)
+	if err != nil {
+		return err
+	}
+	return c.set("INPT", code)
+}
+
+// GetSourceInput returns the current input (INPT).
+func (c *Client) GetSourceInput() (viewsonic.SourceInput, error) {
+	data, err := c.get("INPT")
+	if err != nil {
+		return 0, err
+	}
+	return SourceInput(data)
+}
+
+func (c *Client) mute() (string, error) {
+	return c.get("AVMT")
+}
+
+// SetBlank mutes or unmutes the video (AVMT 11/10).
+func (c *Client) SetBlank(blank bool) error {
+	if blank {
+		return c.set("AVMT", MuteVideoOn)
+	}
+	return c.set("AVMT", MuteVideoOff)
+}
+
+// GetBlank reports whether the video is muted.
+func (c *Client) GetBlank() (bool, error) {
+	data, err := c.mute()
+	return data == MuteVideoOn || data == MuteAllOn, err
+}
+
+// SetMute mutes or unmutes the audio (AVMT 21/20).
+func (c *Client) SetMute(mute bool) error {
+	if mute {
+		return c.set("AVMT", MuteAudioOn)
+	}
+	return c.set("AVMT", MuteAudioOff)
+}
+
+// GetMute reports whether the audio is muted.
+func (c *Client) GetMute() (bool, error) {
+	data, err := c.mute()
+	return data == MuteAudioOn || data == MuteAllOn, err
+}
+
+// GetErrorStatus returns the error status (ERST).
+func (c *Client) GetErrorStatus() (*ErrorStatus, error) {
+	data, err := c.get("ERST")
+	if err != nil {
+		return nil, err
+	}
+	return parseErrorStatus(data)
+}
+
+// GetLamps returns the usage hours and state of every lamp (LAMP).
+func (c *Client) GetLamps() ([]Lamp, error) {
+	data, err := c.get("LAMP")
+	if err != nil {
+		return nil, err
+	}
+
+	fields := strings.Fields(data)
+	if len(fields) == 0 || len(fields)%2 != 0 {
+		return nil, fmt.Errorf("pjlink: invalid lamp response %q", data)
+	}
+
+	lamps := make([]Lamp, 0, len(fields)/2)
+	for i := 0; i < len(fields); i += 2 {
+		hours, err := strconv.ParseUint(fields[i], 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("pjlink: invalid lamp hours %q", fields[i])
+		}
+		lamps = append(lamps, Lamp{Hours: uint32(hours), On: fields[i+1] == "1"})
+	}
+	return lamps, nil
+}
+
+// GetLightSourceUsageTime returns the hours of the first lamp.
+func (c *Client) GetLightSourceUsageTime() (uint32, error) {
+	lamps, err := c.GetLamps()
+	if err != nil {
+		return 0, err
+	}
+	return lamps[0].Hours, nil
+}
+
+// GetInputs returns the available input codes (INST).
+func (c *Client) GetInputs() ([]string, error) {
+	data, err := c.get("INST")
+	if err != nil {
+		return nil, err
+	}
+	return strings.Fields(data), nil
+}
+
+// GetName returns the projector name (NAME).
+func (c *Client) GetName() (string, error) {
+	return c.get("NAME")
+}
+
+// GetManufacturer returns the manufacturer name (INF1).
+func (c *Client) GetManufacturer() (string, error) {
+	return c.get("INF1")
+}
+
+// GetProductName returns the product name (INF2).
+func (c *Client) GetProductName() (string, error) {
+	return c.get("INF2")
+}
+
+// GetInfo returns other information (INFO).
+func (c *Client) GetInfo() (string, error) {
+	return c.get("INFO")
+}
+
+// GetClass returns the supported PJLink class (CLSS).
+func (c *Client) GetClass() (string, error) {
+	return c.get("CLSS")
+}
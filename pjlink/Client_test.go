@@ -0,0 +1,121 @@
+package pjlink
+
+import (
+	"bufio"
+	"net"
+	"strings"
+	"testing"
+	"time"
+)
+
+// standIn is a local PJLink projector. answer returns the response line to a command, an
+// empty line for no response. close ends the connection after the response.
+type standIn struct {
+	addr     string
+	commands chan string
+	conns    chan struct{}
+}
+
+func newStandIn(t *testing.T, answer func(command string) (response string, close bool)) *standIn {
+	t.Helper()
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { l.Close() })
+
+	s := &standIn{addr: l.Addr().String(), commands: make(chan string, 10), conns: make(chan struct{}, 10)}
+	go func() {
+		for {
+			conn, err := l.Accept()
+			if err != nil {
+				return
+			}
+			s.conns <- struct{}{}
+			go func() {
+				defer conn.Close()
+				conn.Write([]byte("PJLINK 0\r"))
+				reader := bufio.NewReader(conn)
+				for {
+					line, err := reader.ReadString('\r')
+					if err != nil {
+						return
+					}
+					command := strings.TrimSuffix(line, "\r")
+					s.commands <- command
+					response, close := answer(command)
+					if response != "" {
+						conn.Write([]byte(response + "\r"))
+					}
+					if close {
+						return
+					}
+				}
+			}()
+		}
+	}()
+	return s
+}
+
+func (s *standIn) received() []string {
+	var commands []string
+	for {
+		select {
+		case c := <-s.commands:
+			commands = append(commands, c)
+		case <-time.After(100 * time.Millisecond):
+			return commands
+		}
+	}
+}
+
+func TestCommandNotResentAfterTimeout(t *testing.T) {
+	s := newStandIn(t, func(command string) (string, bool) {
+		if command == "%1POWR 1" {
+			return "", false
+		}
+		return "%1POWR=0", false
+	})
+
+	c := NewClient(s.addr, "")
+	c.Timeout = 200 * time.Millisecond
+	defer c.Close()
+
+	if _, err := c.GetPower(); err != nil {
+		t.Fatal(err)
+	}
+	// The command is written on the reused connection and times out
+	if err := c.set("POWR", "1"); err == nil {
+		t.Fatal("POWR 1 without response succeeded")
+	}
+
+	got := s.received()
+	if len(got) != 2 || got[1] != "%1POWR 1" {
+		t.Errorf("projector received %q, want POWR 1 once", got)
+	}
+}
+
+func TestCommandReconnectsAfterIdleClose(t *testing.T) {
+	s := newStandIn(t, func(command string) (string, bool) {
+		return "%1POWR=OK", true
+	})
+
+	c := NewClient(s.addr, "")
+	c.Timeout = time.Second
+	defer c.Close()
+
+	for i := 0; i < 2; i++ {
+		if err := c.set("POWR", "1"); err != nil {
+			t.Fatalf("command %d: %v", i, err)
+		}
+		// Let the projector close the connection
+		time.Sleep(50 * time.Millisecond)
+	}
+
+	if got := s.received(); len(got) != 2 {
+		t.Errorf("projector received %q, want 2 commands", got)
+	}
+	if len(s.conns) != 2 {
+		t.Errorf("%d connections, want 2", len(s.conns))
+	}
+}
@@ -0,0 +1,51 @@
+package pjlink
+
+import (
+	"fmt"
+
+	"github.com/m-baertschi/viewsonic"
+)
+
+// PJLink input codes are two characters: the input type followed by the input number.
+//
+//	1: RGB, 2: VIDEO, 3: DIGITAL, 4: STORAGE, 5: NETWORK
+var inputCodes = []struct {
+	input viewsonic.SourceInput
+	code  string
+}{
+	{viewsonic.SourceInputDSub1, "11"},
+	{viewsonic.SourceInputDSub2, "12"},
+	{viewsonic.SourceInputComposite, "21"},
+	{viewsonic.SourceInputSVideo, "22"},
+	{viewsonic.SourceInputComponent, "23"},
+	{viewsonic.SourceInputHDMI1, "31"},
+	{viewsonic.SourceInputHDMI2, "32"},
+	{viewsonic.SourceInputHDMI3, "33"},
+	{viewsonic.SourceInputHDMIMHL4, "34"},
+	{viewsonic.SourceInputDVI, "35"},
+	{viewsonic.SourceInputHDBaseT, "36"},
+	{viewsonic.SourceInputUSBC, "37"},
+	{viewsonic.SourceInputUSBReader, "41"},
+	{viewsonic.SourceInputLANWiFi, "51"},
+	{viewsonic.SourceInputUSBDisplay, "52"},
+}
+
+// InputCode returns the PJLink input code of a source input.
+func InputCode(input viewsonic.SourceInput) (string, error) {
+	for _, c := range inputCodes {
+		if c.input == input {
+			return c.code, nil
+		}
+	}
+	return "", fmt.Errorf("source input 0x%02X has no PJLink input code", uint8(input))
+}
+
+// SourceInput returns the source input of a PJLink input code.
+func SourceInput(code string) (viewsonic.SourceInput, error) {
+	for _, c := range inputCodes {
+		if c.code == code {
+			return c.input, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown PJLink input code %q", code)
+}
@@ -0,0 +1,54 @@
+package pjlink
+
+import (
+	"context"
+	"log"
+	"net"
+	"strings"
+)
+
+// Notification is a Class 2 status notification sent by a projector via UDP,
+// e.g. "%2POWR=1" or "%2LKUP=00:11:22:33:44:55".
+type Notification struct {
+	From    net.Addr
+	Command string
+	Data    string
+}
+
+// ListenNotifications listens for Class 2 status notifications on addr (e.g. ":4352")
+// and calls fn for each of them until the context is cancelled.
+func ListenNotifications(ctx context.Context, addr string, fn func(Notification)) error {
+	conn, err := net.ListenPacket("udp", addr)
+	if err != nil {
+		return err
+	}
+
+	go func() {
+		<-ctx.Done()
+		conn.Close()
+	}()
+
+	buf := make([]byte, 1500)
+	for {
+		n, from, err := conn.ReadFrom(buf)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			return err
+		}
+
+		for _, line := range strings.Split(string(buf[:n]), "\r") {
+			line = strings.TrimSpace(line)
+			if line == "" {
+				continue
+			}
+			resp, err := parseResponse(line)
+			if err != nil || resp.Class != '2' {
+				log.Printf("pjlink: ignoring notification from %v: %q", from, line)
+				continue
+			}
+			fn(Notification{From: from, Command: resp.Command, Data: resp.Data})
+		}
+	}
+}
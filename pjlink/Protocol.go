@@ -0,0 +1,171 @@
+// Package pjlink implements the PJLink Class 1 and Class 2 protocol on TCP port 4352.
+//
+// Client controls a PJLink projector through the viewsonic.Projector interface,
+// Server exposes a viewsonic.Projector to PJLink controllers.
+package pjlink
+
+import (
+	"crypto/md5"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// DefaultPort is the PJLink TCP and UDP port.
+const DefaultPort = 4352
+
+// Errors returned by the projector, see PJLink specification "ERR1" to "ERR4" and "ERRA".
+var (
+	ErrUndefinedCommand = errors.New("pjlink: undefined command (ERR1)")
+	ErrOutOfParameter   = errors.New("pjlink: out of parameter (ERR2)")
+	ErrUnavailableTime  = errors.New("pjlink: unavailable time (ERR3)")
+	ErrProjectorFailure = errors.New("pjlink: projector or display failure (ERR4)")
+	ErrAuthentication   = errors.New("pjlink: authentication error (ERRA)")
+)
+
+var errorCodes = []struct {
+	code string
+	err  error
+}{
+	{"ERR1", ErrUndefinedCommand},
+	{"ERR2", ErrOutOfParameter},
+	{"ERR3", ErrUnavailableTime},
+	{"ERR4", ErrProjectorFailure},
+	{"ERRA", ErrAuthentication},
+}
+
+func errorFromCode(code string) error {
+	for _, e := range errorCodes {
+		if e.code == code {
+			return e.err
+		}
+	}
+	return nil
+}
+
+func codeFromError(err error) string {
+	for _, e := range errorCodes {
+		if errors.Is(err, e.err) {
+			return e.code
+		}
+	}
+	return "ERR4"
+}
+
+// Power status values of "POWR ?".
+const (
+	PowerOff     = "0"
+	PowerOn      = "1"
+	PowerCooling = "2"
+	PowerWarmUp  = "3"
+)
+
+// Mute values of "AVMT".
+const (
+	MuteVideoOff = "10"
+	MuteVideoOn  = "11"
+	MuteAudioOff = "20"
+	MuteAudioOn  = "21"
+	MuteAllOff   = "30"
+	MuteAllOn    = "31"
+)
+
+// ErrorLevel is a single item of the "ERST ?" response.
+type ErrorLevel byte
+
+const (
+	ErrorLevelOK      ErrorLevel = '0'
+	ErrorLevelWarning ErrorLevel = '1'
+	ErrorLevelError   ErrorLevel = '2'
+)
+
+func (l ErrorLevel) String() string {
+	switch l {
+	case ErrorLevelOK:
+		return "OK"
+	case ErrorLevelWarning:
+		return "Warning"
+	case ErrorLevelError:
+		return "Error"
+	}
+	return fmt.Sprintf("ErrorLevel(%q)", byte(l))
+}
+
+// ErrorStatus is the response of "ERST ?".
+type ErrorStatus struct {
+	Fan         ErrorLevel
+	Lamp        ErrorLevel
+	Temperature ErrorLevel
+	Cover       ErrorLevel
+	Filter      ErrorLevel
+	Other       ErrorLevel
+}
+
+func parseErrorStatus(data string) (*ErrorStatus, error) {
+	if len(data) != 6 {
+		return nil, fmt.Errorf("pjlink: invalid error status %q", data)
+	}
+	for _, c := range []byte(data) {
+		if c < '0' || c > '2' {
+			return nil, fmt.Errorf("pjlink: invalid error status %q", data)
+		}
+	}
+	return &ErrorStatus{
+		Fan:         ErrorLevel(data[0]),
+		Lamp:        ErrorLevel(data[1]),
+		Temperature: ErrorLevel(data[2]),
+		Cover:       ErrorLevel(data[3]),
+		Filter:      ErrorLevel(data[4]),
+		Other:       ErrorLevel(data[5]),
+	}, nil
+}
+
+func (s *ErrorStatus) String() string {
+	return string([]byte{byte(s.Fan), byte(s.Lamp), byte(s.Temperature), byte(s.Cover), byte(s.Filter), byte(s.Other)})
+}
+
+// Lamp is a single lamp of the "LAMP ?" response.
+type Lamp struct {
+	Hours uint32
+	On    bool
+}
+
+// digest returns the authentication digest for the random number sent by the projector.
+func digest(random, password string) string {
+	sum := md5.Sum([]byte(random + password))
+	return hex.EncodeToString(sum[:])
+}
+
+// Request is a parsed PJLink command line such as "%1POWR 1".
+type Request struct {
+	Class   byte
+	Command string
+	Param   string
+}
+
+func parseRequest(line string) (Request, error) {
+	if len(line) < 7 || line[0] != '%' || line[6] != ' ' {
+		return Request{}, fmt.Errorf("pjlink: invalid request %q", line)
+	}
+	return Request{Class: line[1], Command: strings.ToUpper(line[2:6]), Param: line[7:]}, nil
+}
+
+// Response is a parsed PJLink response or notification line such as "%1POWR=OK".
+type Response struct {
+	Class   byte
+	Command string
+	Data    string
+}
+
+// Err returns the error encoded in the response data, if any.
+func (r Response) Err() error {
+	return errorFromCode(r.Data)
+}
+
+func parseResponse(line string) (Response, error) {
+	if len(line) < 7 || line[0] != '%' || line[6] != '=' {
+		return Response{}, fmt.Errorf("pjlink: invalid response %q", line)
+	}
+	return Response{Class: line[1], Command: strings.ToUpper(line[2:6]), Data: line[7:]}, nil
+}
@@ -0,0 +1,402 @@
+package pjlink
+
+import (
+	"bufio"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log"
+	"net"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/m-baertschi/viewsonic"
+)
+
+// errorStatusReader is implemented by projectors that report a ViewSonic error status.
+type errorStatusReader interface {
+	GetErrorStatus() (*viewsonic.ErrorStatus, error)
+}
+
+// modeler is implemented by projectors restricted to a model profile.
+type modeler interface {
+	Model() *viewsonic.Model
+}
+
+// Server is a PJLink Class 1 facade in front of a viewsonic.Projector,
+// typically a ViewSonic controlled by its RS-232 protocol.
+type Server struct {
+	// Password enables PJLink authentication if not empty.
+	Password string
+	// Name, Manufacturer, ProductName and Info are returned by NAME, INF1, INF2 and INFO.
+	Name         string
+	Manufacturer string
+	ProductName  string
+	Info         string
+	// IdleTimeout closes connections without a command for this long.
+	IdleTimeout time.Duration
+
+	projector viewsonic.Projector
+
+	mutex     sync.Mutex
+	listeners map[net.Listener]struct{}
+	baseline  *viewsonic.ErrorStatus
+}
+
+// NewServer creates a server for the projector.
+func NewServer(projector viewsonic.Projector, password string) *Server {
+	return &Server{
+		Password:     password,
+		Name:         "ViewSonic",
+		Manufacturer: "ViewSonic",
+		IdleTimeout:  30 * time.Second,
+		projector:    projector,
+		listeners:    map[net.Listener]struct{}{},
+	}
+}
+
+// ListenAndServe listens on addr (e.g. ":4352") and serves connections.
+func (s *Server) ListenAndServe(addr string) error {
+	l, err := net.Listen("tcp", addr)
+	if err != nil {
+		return err
+	}
+	return s.Serve(l)
+}
+
+// Serve accepts connections on l until it is closed.
+func (s *Server) Serve(l net.Listener) error {
+	s.mutex.Lock()
+	s.listeners[l] = struct{}{}
+	s.mutex.Unlock()
+
+	defer func() {
+		s.mutex.Lock()
+		delete(s.listeners, l)
+		s.mutex.Unlock()
+	}()
+
+	for {
+		conn, err := l.Accept()
+		if err != nil {
+			if errors.Is(err, net.ErrClosed) {
+				return nil
+			}
+			return err
+		}
+		go s.serveConn(conn)
+	}
+}
+
+// Close stops all listeners. Open connections end with their idle timeout.
+func (s *Server) Close() error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	var errs []error
+	for l := range s.listeners {
+		errs = append(errs, l.Close())
+	}
+	return errors.Join(errs...)
+}
+
+func (s *Server) serveConn(conn net.Conn) {
+	defer conn.Close()
+
+	expectedDigest := ""
+	if s.Password == "" {
+		conn.Write([]byte("PJLINK 0\r"))
+	} else {
+		random := make([]byte, 4)
+		rand.Read(random)
+		seed := hex.EncodeToString(random)
+		expectedDigest = digest(seed, s.Password)
+		conn.Write([]byte("PJLINK 1 " + seed + "\r"))
+	}
+
+	reader := bufio.NewReader(conn)
+	for {
+		conn.SetReadDeadline(time.Now().Add(s.IdleTimeout))
+		line, err := readLine(reader)
+		if err != nil {
+			return
+		}
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+
+		if expectedDigest != "" {
+			// The digest is only sent with the first command
+			if !strings.HasPrefix(line, expectedDigest) {
+				conn.Write([]byte("PJLINK ERRA\r"))
+				return
+			}
+			line = line[len(expectedDigest):]
+			expectedDigest = ""
+		}
+
+		req, err := parseRequest(line)
+		if err != nil {
+			log.Printf("pjlink: invalid request from %v: %q", conn.RemoteAddr(), line)
+			return
+		}
+
+		data := s.handle(req)
+		conn.SetWriteDeadline(time.Now().Add(5 * time.Second))
+		if _, err := fmt.Fprintf(conn, "%%%c%s=%s\r", req.Class, req.Command, data); err != nil {
+			return
+		}
+	}
+}
+
+// handle executes a request and returns the response data.
+func (s *Server) handle(req Request) string {
+	if req.Class != '1' {
+		return "ERR1"
+	}
+
+	var data string
+	var err error
+	if req.Param == "?" {
+		data, err = s.query(req.Command)
+	} else {
+		err = s.set(req.Command, req.Param)
+		data = "OK"
+	}
+
+	if err != nil {
+		switch {
+		case errors.Is(err, viewsonic.ErrFunctionDisabled):
+			return "ERR3"
+		case errors.Is(err, viewsonic.ErrUnsupported) && req.Param == "?":
+			return "ERR1"
+		case errors.Is(err, viewsonic.ErrUnsupported):
+			return "ERR2"
+		}
+		code := codeFromError(err)
+		if code == "ERR4" {
+			log.Printf("pjlink: %v %v: %v", req.Command, req.Param, err)
+		}
+		return code
+	}
+	return data
+}
+
+func (s *Server) set(command, param string) error {
+	p := s.projector
+
+	switch command {
+	case "POWR":
+		switch param {
+		case "1":
+			return p.SetPower(viewsonic.PowerStateOn)
+		case "0":
+			return p.SetPower(viewsonic.PowerStateOff)
+		}
+	case "INPT":
+		input, err := SourceInput(param)
+		if err != nil {
+			return ErrOutOfParameter
+		}
+		return p.SetSourceInput(input)
+	case "AVMT":
+		switch param {
+		case MuteVideoOn, MuteVideoOff:
+			return p.SetBlank(param == MuteVideoOn)
+		case MuteAudioOn, MuteAudioOff:
+			return p.SetMute(param == MuteAudioOn)
+		case MuteAllOn, MuteAllOff:
+			if err := p.SetBlank(param == MuteAllOn); err != nil {
+				return err
+			}
+			return p.SetMute(param == MuteAllOn)
+		}
+	case "ERST", "LAMP", "INST", "NAME", "INF1", "INF2", "INFO", "CLSS":
+		// Read only commands
+	default:
+		return ErrUndefinedCommand
+	}
+	return ErrOutOfParameter
+}
+
+// power returns the POWR status. Models without the projector status, like the LS920WU,
+// only report on and off.
+func (s *Server) power() (string, error) {
+	status, err := s.projector.GetProjectorStatus()
+	if errors.Is(err, viewsonic.ErrUnsupported) {
+		power, err := s.projector.GetPower()
+		if err != nil {
+			return "", err
+		}
+		if power == viewsonic.PowerStateOn {
+			return PowerOn, nil
+		}
+		return PowerOff, nil
+	}
+	if err != nil {
+		return "", err
+	}
+
+	switch status {
+	case viewsonic.ProjectorStatusPowerOn:
+		return PowerOn, nil
+	case viewsonic.ProjectorStatusWarmUp:
+		return PowerWarmUp, nil
+	case viewsonic.ProjectorStatusCoolDown:
+		return PowerCooling, nil
+	}
+	return PowerOff, nil
+}
+
+func (s *Server) query(command string) (string, error) {
+	p := s.projector
+
+	switch command {
+	case "POWR":
+		return s.power()
+	case "INPT":
+		input, err := p.GetSourceInput()
+		if err != nil {
+			return "", err
+		}
+		code, err := InputCode(input)
+		if err != nil {
+			return "", ErrProjectorFailure
+		}
+		return code, nil
+	case "AVMT":
+		blank, err := p.GetBlank()
+		if err != nil {
+			return "", err
+		}
+		mute, err := p.GetMute()
+		if err != nil {
+			return "", err
+		}
+		switch {
+		case blank && mute:
+			return MuteAllOn, nil
+		case blank:
+			return MuteVideoOn, nil
+		case mute:
+			return MuteAudioOn, nil
+		}
+		return MuteAllOff, nil
+	case "ERST":
+		status, err := s.errorStatus()
+		if err != nil {
+			return "", err
+		}
+		return status.String(), nil
+	case "LAMP":
+		hours, err := p.GetLightSourceUsageTime()
+		if err != nil {
+			return "", err
+		}
+		on := "0"
+		if power, err := s.power(); err == nil && power == PowerOn {
+			on = "1"
+		}
+		return strconv.FormatUint(uint64(hours), 10) + " " + on, nil
+	case "INST":
+		model := s.model()
+		codes := make([]string, 0, len(inputCodes))
+		for _, c := range inputCodes {
+			if model == nil || slices.Contains(model.Sources(), c.input) {
+				codes = append(codes, c.code)
+			}
+		}
+		return strings.Join(codes, " "), nil
+	case "NAME":
+		return s.Name, nil
+	case "INF1":
+		return s.Manufacturer, nil
+	case "INF2":
+		return s.ProductName, nil
+	case "INFO":
+		return s.Info, nil
+	case "CLSS":
+		return "1", nil
+	}
+	return "", ErrUndefinedCommand
+}
+
+// errorStatus maps the ViewSonic error status onto the PJLink error items.
+// The ViewSonic counters are cumulative, so counters incremented since the
+// first reading of this server are reported as warnings.
+func (s *Server) errorStatus() (*ErrorStatus, error) {
+	status := &ErrorStatus{
+		Fan:         ErrorLevelOK,
+		Lamp:        ErrorLevelOK,
+		Temperature: ErrorLevelOK,
+		Cover:       ErrorLevelOK,
+		Filter:      ErrorLevelOK,
+		Other:       ErrorLevelOK,
+	}
+
+	reader, ok := s.projector.(errorStatusReader)
+	if !ok {
+		return status, nil
+	}
+
+	cur, err := reader.GetErrorStatus()
+	if err != nil {
+		return nil, err
+	}
+
+	s.mutex.Lock()
+	if s.baseline == nil {
+		s.baseline = cur
+	}
+	increments := viewsonic.DiffErrorStatus(s.baseline, cur)
+	s.mutex.Unlock()
+
+	raise := func(item *ErrorLevel, level ErrorLevel) {
+		if level > *item {
+			*item = level
+		}
+	}
+
+	for _, inc := range increments {
+		switch inc.Category {
+		case viewsonic.CounterCategoryFan:
+			raise(&status.Fan, ErrorLevelWarning)
+		case viewsonic.CounterCategoryLamp:
+			raise(&status.Lamp, ErrorLevelWarning)
+		case viewsonic.CounterCategoryTemperature:
+			raise(&status.Temperature, ErrorLevelWarning)
+		default:
+			raise(&status.Other, ErrorLevelWarning)
+		}
+	}
+
+	if cur.LampStatus == viewsonic.LampModeStatusShutdownUnrecoverableError {
+		raise(&status.Lamp, ErrorLevelError)
+	}
+	switch cur.LampErrorStatus {
+	case viewsonic.LampModeErrorStatusNoError:
+	case viewsonic.LampModeErrorStatusTemperatureShutdown:
+		raise(&status.Temperature, ErrorLevelError)
+	default:
+		if report := viewsonic.AssessHealth(nil, cur); report.Verdict == viewsonic.HealthCritical {
+			raise(&status.Lamp, ErrorLevelError)
+		} else {
+			raise(&status.Lamp, ErrorLevelWarning)
+		}
+	}
+
+	return status, nil
+}
+
+// model returns the model profile of the projector, or nil if it accepts every command.
+func (s *Server) model() *viewsonic.Model {
+	if m, ok := s.projector.(modeler); ok {
+		return m.Model()
+	}
+	return nil
+}
@@ -0,0 +1,70 @@
+package pjlink
+
+import (
+	"testing"
+
+	"github.com/m-baertschi/viewsonic"
+	"github.com/m-baertschi/viewsonic/emulator"
+)
+
+func TestServerInputsOfModel(t *testing.T) {
+	e := emulator.New()
+	if err := e.Listen("127.0.0.1:0"); err != nil {
+		t.Fatal(err)
+	}
+	defer e.Close()
+
+	conn := viewsonic.New(e.Addr())
+	defer conn.Close()
+	conn.SetModel(viewsonic.ModelLS920WU)
+	s := NewServer(conn, "")
+
+	if got := s.handle(Request{Class: '1', Command: "INST", Param: "?"}); got != "31 32 37" {
+		t.Errorf("INST = %q, want the inputs of the LS920WU", got)
+	}
+	if got := s.handle(Request{Class: '1', Command: "INPT", Param: "11"}); got != "ERR2" {
+		t.Errorf("INPT 11 = %q, want ERR2", got)
+	}
+
+	// Errors without a PJLink code are a projector failure
+	e.Close()
+	if got := s.handle(Request{Class: '1', Command: "INPT", Param: "?"}); got != "ERR4" {
+		t.Errorf("INPT ? without projector = %q, want ERR4", got)
+	}
+}
+
+func TestServerPowerWithoutProjectorStatus(t *testing.T) {
+	e := emulator.New()
+	if err := e.Listen("127.0.0.1:0"); err != nil {
+		t.Fatal(err)
+	}
+	defer e.Close()
+
+	conn := viewsonic.New(e.Addr())
+	defer conn.Close()
+	conn.SetModel(viewsonic.ModelLS920WU)
+	s := NewServer(conn, "")
+
+	for _, want := range []string{PowerOff, PowerOn} {
+		if got := s.handle(Request{Class: '1', Command: "POWR", Param: want}); got != "OK" {
+			t.Fatalf("POWR %v = %q", want, got)
+		}
+		if got := s.handle(Request{Class: '1', Command: "POWR", Param: "?"}); got != want {
+			t.Errorf("POWR ? = %q, want %q", got, want)
+		}
+	}
+}
+
+func TestServerUnsupportedCommands(t *testing.T) {
+	conn := viewsonic.New("127.0.0.1:1")
+	defer conn.Close()
+	conn.SetModel(&viewsonic.Model{Name: "none"})
+	s := NewServer(conn, "")
+
+	if got := s.handle(Request{Class: '1', Command: "AVMT", Param: "?"}); got != "ERR1" {
+		t.Errorf("unsupported AVMT ? = %q, want ERR1", got)
+	}
+	if got := s.handle(Request{Class: '1', Command: "AVMT", Param: MuteVideoOn}); got != "ERR2" {
+		t.Errorf("unsupported AVMT %v = %q, want ERR2", MuteVideoOn, got)
+	}
+}
@@ -0,0 +1,650 @@
+// Package script is a small line based language for projector automation:
+//
+//	use hall, lobby
+//	power on
+//	wait status == poweron timeout 90s
+//	source hdmi1
+//	if get(blank) then blank off
+//	volume 10; wait 2s
+//	try keys "Menu, Down×3, Right, Enter"
+//
+// Statements are separated by newlines or semicolons, # starts a comment.
+//
+// Statements:
+//
+//	use <name>[, <name>...] | use all   select the projectors of the following statements
+//	power|blank|freeze|mute on|off
+//	volume <n>
+//	source|colormode|colortemp|lightsource <name or number>
+//	key <remote key>                    e.g. key menu
+//	keys "<sequence>"                   remote key sequence, see viewsonic.ParseMacroSequence
+//	macro <name>                        named macro of the Runner
+//	wait <duration>
+//	wait <condition> [timeout <duration>]
+//	if <condition> then <statement> [else <statement>]
+//	if <condition> ... [else ...] end
+//	try <statement>                     log errors instead of stopping the script
+//	log "<message>"
+//
+// Conditions compare the state fields power, status, source, blank, freeze, mute, volume,
+// temp1 and temp2 with ==, !=, <, <=, > and >=, combined with not, and, or and parentheses.
+// A field may be written as get(field), a bare field is true if it is on, non zero or,
+// for status, power on. With multiple projectors a condition must hold for all of them.
+package script
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/m-baertschi/viewsonic"
+)
+
+// DefaultWaitTimeout is the timeout of a conditional wait without timeout clause.
+const DefaultWaitTimeout = 60 * time.Second
+
+// Script is a parsed script, it can be run any number of times.
+type Script struct {
+	stmts []stmt
+}
+
+// Parse parses the source of a script. Errors contain the line number.
+func Parse(src string) (*Script, error) {
+	lines, err := split(src)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &parser{lines: lines}
+	stmts, end, err := p.block()
+	if err != nil {
+		return nil, err
+	}
+	if end != "" {
+		return nil, fmt.Errorf("line %d: unexpected %v", p.lines[p.pos-1].line, end)
+	}
+	return &Script{stmts: stmts}, nil
+}
+
+// sourceLine is a single statement with its line number.
+type sourceLine struct {
+	line   int
+	tokens []string
+}
+
+// split splits the source into statements and tokenizes them.
+func split(src string) ([]sourceLine, error) {
+	var lines []sourceLine
+	for i, text := range strings.Split(src, "\n") {
+		tokens, err := tokenize(text)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", i+1, err)
+		}
+
+		start := 0
+		for j, t := range append(tokens, ";") {
+			if t != ";" {
+				continue
+			}
+			if j > start {
+				lines = append(lines, sourceLine{line: i + 1, tokens: tokens[start:j]})
+			}
+			start = j + 1
+		}
+	}
+	return lines, nil
+}
+
+func tokenize(s string) ([]string, error) {
+	var tokens []string
+	for i := 0; i < len(s); {
+		c := s[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\r':
+			i++
+		case c == '#':
+			return tokens, nil
+		case c == '"':
+			end := strings.IndexByte(s[i+1:], '"')
+			if end < 0 {
+				return nil, errors.New("unterminated string")
+			}
+			tokens = append(tokens, s[i:i+end+2])
+			i += end + 2
+		case strings.HasPrefix(s[i:], "==") || strings.HasPrefix(s[i:], "!=") ||
+			strings.HasPrefix(s[i:], "<=") || strings.HasPrefix(s[i:], ">="):
+			tokens = append(tokens, s[i:i+2])
+			i += 2
+		case strings.IndexByte("<>(),;", c) >= 0:
+			tokens = append(tokens, s[i:i+1])
+			i++
+		default:
+			end := i
+			for end < len(s) && strings.IndexByte(" \t\r#\"=!<>(),;", s[end]) < 0 {
+				end++
+			}
+			if end == i {
+				return nil, fmt.Errorf("unexpected %q", c)
+			}
+			tokens = append(tokens, s[i:end])
+			i = end
+		}
+	}
+	return tokens, nil
+}
+
+func unquote(token string) (string, bool) {
+	if len(token) >= 2 && token[0] == '"' && token[len(token)-1] == '"' {
+		return token[1 : len(token)-1], true
+	}
+	return "", false
+}
+
+type parser struct {
+	lines []sourceLine
+	pos   int
+}
+
+// block parses statements until the end of the input or an else/end line, which is returned.
+func (p *parser) block() ([]stmt, string, error) {
+	var stmts []stmt
+	for p.pos < len(p.lines) {
+		l := p.lines[p.pos]
+		p.pos++
+
+		switch strings.ToLower(l.tokens[0]) {
+		case "else", "end":
+			if len(l.tokens) > 1 {
+				return nil, "", fmt.Errorf("line %d: unexpected %v after %v", l.line, l.tokens[1], l.tokens[0])
+			}
+			return stmts, strings.ToLower(l.tokens[0]), nil
+		}
+
+		s, err := p.statement(l.line, l.tokens)
+		if err != nil {
+			return nil, "", err
+		}
+		stmts = append(stmts, s)
+	}
+	return stmts, "", nil
+}
+
+func (p *parser) statement(line int, tokens []string) (stmt, error) {
+	s, err := p.parseStatement(line, tokens)
+	if err != nil {
+		var lineErr *lineError
+		if errors.As(err, &lineErr) {
+			return nil, err
+		}
+		return nil, &lineError{line: line, err: err}
+	}
+	return s, nil
+}
+
+type lineError struct {
+	line int
+	err  error
+}
+
+func (e *lineError) Error() string { return fmt.Sprintf("line %d: %v", e.line, e.err) }
+func (e *lineError) Unwrap() error { return e.err }
+
+func (p *parser) parseStatement(line int, tokens []string) (stmt, error) {
+	name := strings.ToLower(tokens[0])
+	args := tokens[1:]
+	text := strings.Join(tokens, " ")
+
+	switch name {
+	case "use":
+		var names []string
+		for _, a := range args {
+			if a != "," {
+				names = append(names, a)
+			}
+		}
+		if len(names) == 0 {
+			return nil, errors.New("use needs projector names or all")
+		}
+		if len(names) == 1 && strings.EqualFold(names[0], "all") {
+			names = nil
+		}
+		return &useStmt{line: line, names: names}, nil
+
+	case "wait":
+		if len(args) == 1 {
+			if d, err := time.ParseDuration(args[0]); err == nil {
+				return &sleepStmt{line: line, d: d}, nil
+			}
+		}
+		timeout := DefaultWaitTimeout
+		for i, a := range args {
+			if strings.EqualFold(a, "timeout") {
+				if i != len(args)-2 {
+					return nil, errors.New("timeout must be followed by a single duration")
+				}
+				d, err := time.ParseDuration(args[i+1])
+				if err != nil {
+					return nil, err
+				}
+				timeout, args = d, args[:i]
+				break
+			}
+		}
+		cond, err := parseCondition(args)
+		if err != nil {
+			return nil, err
+		}
+		return &waitStmt{line: line, text: text, cond: cond, timeout: timeout}, nil
+
+	case "if":
+		then := indexOf(args, "then")
+		if then < 0 {
+			// Block form, the body follows on the next lines
+			cond, err := parseCondition(args)
+			if err != nil {
+				return nil, err
+			}
+			s := &ifStmt{line: line, cond: cond}
+			var end string
+			if s.then, end, err = p.block(); err != nil {
+				return nil, err
+			}
+			if end == "else" {
+				if s.els, end, err = p.block(); err != nil {
+					return nil, err
+				}
+			}
+			if end != "end" {
+				return nil, fmt.Errorf("if without end")
+			}
+			return s, nil
+		}
+
+		cond, err := parseCondition(args[:then])
+		if err != nil {
+			return nil, err
+		}
+		body := args[then+1:]
+		var elseBody []string
+		if i := indexOf(body, "else"); i >= 0 {
+			body, elseBody = body[:i], body[i+1:]
+			if len(elseBody) == 0 {
+				return nil, errors.New("missing statement after else")
+			}
+		}
+		if len(body) == 0 {
+			return nil, errors.New("missing statement after then")
+		}
+
+		s := &ifStmt{line: line, cond: cond}
+		then1, err := p.parseStatement(line, body)
+		if err != nil {
+			return nil, err
+		}
+		s.then = []stmt{then1}
+		if elseBody != nil {
+			else1, err := p.parseStatement(line, elseBody)
+			if err != nil {
+				return nil, err
+			}
+			s.els = []stmt{else1}
+		}
+		return s, nil
+
+	case "try":
+		if len(args) == 0 {
+			return nil, errors.New("missing statement after try")
+		}
+		body, err := p.parseStatement(line, args)
+		if err != nil {
+			return nil, err
+		}
+		return &tryStmt{line: line, body: body}, nil
+
+	case "log":
+		msg, ok := "", false
+		if len(args) == 1 {
+			msg, ok = unquote(args[0])
+		}
+		if !ok {
+			return nil, errors.New(`log needs a quoted message`)
+		}
+		return &logStmt{line: line, msg: msg}, nil
+
+	case "macro":
+		if len(args) != 1 {
+			return nil, errors.New("macro needs a name")
+		}
+		return &macroStmt{line: line, name: args[0]}, nil
+
+	case "keys":
+		sequence, ok := "", false
+		if len(args) == 1 {
+			sequence, ok = unquote(args[0])
+		}
+		if !ok {
+			return nil, errors.New("keys needs a quoted sequence")
+		}
+		steps, err := viewsonic.ParseMacroSequence(sequence)
+		if err != nil {
+			return nil, err
+		}
+		return &macroStmt{line: line, macro: &viewsonic.Macro{Name: "keys", Steps: steps}}, nil
+	}
+
+	do, err := parseCommand(name, args)
+	if err != nil {
+		return nil, err
+	}
+	return &commandStmt{line: line, text: text, do: do}, nil
+}
+
+func indexOf(tokens []string, word string) int {
+	for i, t := range tokens {
+		if strings.EqualFold(t, word) {
+			return i
+		}
+	}
+	return -1
+}
+
+// parseCommand parses a write command into the function executing it.
+func parseCommand(name string, args []string) (func(*viewsonic.ViewSonic) error, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf("%v needs exactly one argument", name)
+	}
+	arg := args[0]
+
+	onOff := func(fn func(*viewsonic.ViewSonic, bool) error) (func(*viewsonic.ViewSonic) error, error) {
+		on, err := boolValue(arg)
+		if err != nil {
+			return nil, err
+		}
+		return func(conn *viewsonic.ViewSonic) error { return fn(conn, on) }, nil
+	}
+
+	switch name {
+	case "power":
+		return onOff(func(conn *viewsonic.ViewSonic, on bool) error {
+			if on {
+				return conn.SetPower(viewsonic.PowerStateOn)
+			}
+			return conn.SetPower(viewsonic.PowerStateOff)
+		})
+	case "blank":
+		return onOff((*viewsonic.ViewSonic).SetBlank)
+	case "freeze":
+		return onOff((*viewsonic.ViewSonic).SetFreeze)
+	case "mute":
+		return onOff((*viewsonic.ViewSonic).SetMute)
+	case "volume":
+		v, err := strconv.ParseInt(arg, 10, 8)
+		if err != nil || v < 0 {
+			return nil, fmt.Errorf("invalid volume %q", arg)
+		}
+		return func(conn *viewsonic.ViewSonic) error { return conn.SetVolume(int8(v)) }, nil
+	case "source":
+		return setEnum(arg, viewsonic.ParseSourceInput, sourceAliases, (*viewsonic.ViewSonic).SetSourceInput)
+	case "colormode":
+		return setEnum(arg, viewsonic.ParseColorMode, colorModeAliases, (*viewsonic.ViewSonic).SetColorMode)
+	case "colortemp":
+		return setEnum(arg, viewsonic.ParseColorTemperature, nil, (*viewsonic.ViewSonic).SetColorTemperature)
+	case "lightsource":
+		return setEnum(arg, viewsonic.ParseLightSourceMode, nil, (*viewsonic.ViewSonic).SetLightSourceMode)
+	case "key":
+		steps, err := viewsonic.ParseMacroSequence(arg)
+		if err != nil || len(steps) != 1 || steps[0].Count != 1 {
+			return nil, fmt.Errorf("invalid remote key %q", arg)
+		}
+		return func(conn *viewsonic.ViewSonic) error { return conn.SendRemoteKey(steps[0].Key) }, nil
+	}
+	return nil, fmt.Errorf("unknown statement %q", name)
+}
+
+// setEnum parses arg into the function writing the enum value.
+func setEnum[T ~int8](arg string, parse func(string) (T, error), aliases map[string]T,
+	set func(*viewsonic.ViewSonic, T) error) (func(*viewsonic.ViewSonic) error, error) {
+	v, err := enumValue(arg, parse, aliases)
+	if err != nil {
+		return nil, err
+	}
+	return func(conn *viewsonic.ViewSonic) error { return set(conn, v) }, nil
+}
+
+// Conditions
+
+type expr interface {
+	eval(conn *viewsonic.ViewSonic) (any, error)
+}
+
+// fieldExpr reads a state field.
+type fieldExpr struct {
+	field viewsonic.StateField
+}
+
+func (e fieldExpr) eval(conn *viewsonic.ViewSonic) (any, error) {
+	return viewsonic.ReadStateField(conn, e.field)
+}
+
+// literalExpr is an untyped word or number, converted when compared to a field.
+type literalExpr struct {
+	text string
+}
+
+func (e literalExpr) eval(conn *viewsonic.ViewSonic) (any, error) {
+	return nil, fmt.Errorf("%q is not a state field", e.text)
+}
+
+type compareExpr struct {
+	op          string
+	left, right expr
+}
+
+func (e compareExpr) eval(conn *viewsonic.ViewSonic) (any, error) {
+	left, err := e.left.eval(conn)
+	if err != nil {
+		return nil, err
+	}
+
+	var right any
+	if lit, ok := e.right.(literalExpr); ok {
+		if left == nil {
+			// Disabled functions only compare equal to "disabled"
+			isDisabled := strings.EqualFold(lit.text, "disabled")
+			return isDisabled == (e.op == "=="), nil
+		}
+		if right, err = literal(left, lit.text); err != nil {
+			return nil, err
+		}
+	} else if right, err = e.right.eval(conn); err != nil {
+		return nil, err
+	}
+
+	if l, ok := number(left); ok {
+		if r, ok := number(right); ok {
+			switch e.op {
+			case "==":
+				return l == r, nil
+			case "!=":
+				return l != r, nil
+			case "<":
+				return l < r, nil
+			case "<=":
+				return l <= r, nil
+			case ">":
+				return l > r, nil
+			case ">=":
+				return l >= r, nil
+			}
+		}
+	}
+
+	switch e.op {
+	case "==":
+		return left == right, nil
+	case "!=":
+		return left != right, nil
+	}
+	return nil, fmt.Errorf("%v cannot compare %v and %v", e.op, left, right)
+}
+
+type notExpr struct {
+	e expr
+}
+
+func (e notExpr) eval(conn *viewsonic.ViewSonic) (any, error) {
+	v, err := e.e.eval(conn)
+	return !truthy(v), err
+}
+
+type logicExpr struct {
+	and         bool
+	left, right expr
+}
+
+func (e logicExpr) eval(conn *viewsonic.ViewSonic) (any, error) {
+	l, err := e.left.eval(conn)
+	if err != nil {
+		return nil, err
+	}
+	// Short circuit, saving a read on the serial link
+	if truthy(l) != e.and {
+		return truthy(l), nil
+	}
+	r, err := e.right.eval(conn)
+	if err != nil {
+		return nil, err
+	}
+	return truthy(r), nil
+}
+
+func parseCondition(tokens []string) (expr, error) {
+	if len(tokens) == 0 {
+		return nil, errors.New("missing condition")
+	}
+	c := &condParser{tokens: tokens}
+	e, err := c.or()
+	if err != nil {
+		return nil, err
+	}
+	if c.pos < len(c.tokens) {
+		return nil, fmt.Errorf("unexpected %v in condition", c.tokens[c.pos])
+	}
+	return e, nil
+}
+
+type condParser struct {
+	tokens []string
+	pos    int
+}
+
+func (c *condParser) peek() string {
+	if c.pos < len(c.tokens) {
+		return strings.ToLower(c.tokens[c.pos])
+	}
+	return ""
+}
+
+func (c *condParser) next() string {
+	t := c.peek()
+	c.pos++
+	return t
+}
+
+func (c *condParser) or() (expr, error) {
+	left, err := c.and()
+	for err == nil && c.peek() == "or" {
+		c.next()
+		var right expr
+		if right, err = c.and(); err == nil {
+			left = logicExpr{and: false, left: left, right: right}
+		}
+	}
+	return left, err
+}
+
+func (c *condParser) and() (expr, error) {
+	left, err := c.unary()
+	for err == nil && c.peek() == "and" {
+		c.next()
+		var right expr
+		if right, err = c.unary(); err == nil {
+			left = logicExpr{and: true, left: left, right: right}
+		}
+	}
+	return left, err
+}
+
+func (c *condParser) unary() (expr, error) {
+	if c.peek() == "not" {
+		c.next()
+		e, err := c.unary()
+		return notExpr{e: e}, err
+	}
+
+	left, err := c.operand()
+	if err != nil {
+		return nil, err
+	}
+	switch op := c.peek(); op {
+	case "==", "!=", "<", "<=", ">", ">=":
+		c.next()
+		right, err := c.operand()
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := left.(fieldExpr); !ok {
+			return nil, fmt.Errorf("left side of %v must be a state field", op)
+		}
+		return compareExpr{op: op, left: left, right: right}, nil
+	}
+
+	if lit, ok := left.(literalExpr); ok {
+		return nil, fmt.Errorf("unknown state field %q", lit.text)
+	}
+	return left, nil
+}
+
+func (c *condParser) operand() (expr, error) {
+	switch t := c.next(); t {
+	case "":
+		return nil, errors.New("incomplete condition")
+	case "(":
+		e, err := c.or()
+		if err != nil {
+			return nil, err
+		}
+		if c.next() != ")" {
+			return nil, errors.New("missing )")
+		}
+		return e, nil
+	case "get":
+		if c.next() != "(" {
+			return nil, errors.New("get needs a field in parentheses")
+		}
+		name := c.next()
+		if c.next() != ")" {
+			return nil, errors.New("missing ) after get")
+		}
+		if !isField(name) {
+			return nil, fmt.Errorf("unknown state field %q", name)
+		}
+		return fieldExpr{field: viewsonic.StateField(name)}, nil
+	default:
+		if isField(t) {
+			return fieldExpr{field: viewsonic.StateField(t)}, nil
+		}
+		return literalExpr{text: c.tokens[c.pos-1]}, nil
+	}
+}
+
+func isField(name string) bool {
+	for _, f := range viewsonic.StateFields {
+		if string(f) == name {
+			return true
+		}
+	}
+	return false
+}
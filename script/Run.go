@@ -0,0 +1,295 @@
+package script
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"slices"
+	"sort"
+	"time"
+
+	"github.com/m-baertschi/viewsonic"
+)
+
+// Runner runs scripts against a set of projectors.
+type Runner struct {
+	// Projectors by the name used in use statements. Scripts start with all of them selected.
+	Projectors map[string]*viewsonic.ViewSonic
+	// Macros available to macro statements.
+	Macros map[string]*viewsonic.Macro
+	// DryRun logs writes, key presses and waits instead of executing them. Conditions are still
+	// read from the projectors, a failed read counts as false, and conditional waits check once.
+	DryRun bool
+	// PollInterval between two checks of a conditional wait.
+	PollInterval time.Duration
+	// Logf receives log statements, dry run output and ignored errors, log.Printf if nil.
+	Logf func(format string, args ...any)
+}
+
+// Error is a runtime error of a script statement.
+type Error struct {
+	Line      int
+	Projector string // empty if not specific to a projector
+	Err       error
+}
+
+func (e *Error) Error() string {
+	if e.Projector == "" {
+		return fmt.Sprintf("line %d: %v", e.Line, e.Err)
+	}
+	return fmt.Sprintf("line %d: %v: %v", e.Line, e.Projector, e.Err)
+}
+
+func (e *Error) Unwrap() error { return e.Err }
+
+// ErrTimeout is wrapped by the error of a conditional wait that timed out.
+var ErrTimeout = errors.New("timeout")
+
+// Run runs the script until it ends, fails or the context is cancelled. Commands are sent
+// with PriorityAutomation.
+func (r *Runner) Run(ctx context.Context, s *Script) error {
+	x := &execution{Runner: r, targets: r.all()}
+	return x.block(ctx, s.stmts)
+}
+
+// RunString parses and runs a script.
+func (r *Runner) RunString(ctx context.Context, src string) error {
+	s, err := Parse(src)
+	if err != nil {
+		return err
+	}
+	return r.Run(ctx, s)
+}
+
+// all returns the names of all projectors in a new, sorted slice.
+func (r *Runner) all() []string {
+	names := make([]string, 0, len(r.Projectors))
+	for name := range r.Projectors {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+type execution struct {
+	*Runner
+	targets []string
+}
+
+func (x *execution) logf(format string, args ...any) {
+	if x.Logf != nil {
+		x.Logf(format, args...)
+	} else {
+		log.Printf(format, args...)
+	}
+}
+
+func (x *execution) block(ctx context.Context, stmts []stmt) error {
+	for _, s := range stmts {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if err := s.exec(ctx, x); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// each calls fn for every selected projector.
+func (x *execution) each(line int, fn func(name string, conn *viewsonic.ViewSonic) error) error {
+	for _, name := range x.targets {
+		if err := fn(name, x.Projectors[name].WithPriority(viewsonic.PriorityAutomation)); err != nil {
+			return &Error{Line: line, Projector: name, Err: err}
+		}
+	}
+	return nil
+}
+
+// check evaluates a condition, it holds if it holds for every selected projector.
+func (x *execution) check(line int, cond expr) (bool, error) {
+	result := true
+	err := x.each(line, func(name string, conn *viewsonic.ViewSonic) error {
+		v, err := cond.eval(conn)
+		if err != nil && x.DryRun {
+			x.logf("dry run: line %d: %v: %v, assuming false", line, name, err)
+			v, err = false, nil
+		}
+		if err != nil {
+			return err
+		}
+		result = result && truthy(v)
+		return nil
+	})
+	return result, err
+}
+
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// Statements
+
+type stmt interface {
+	exec(ctx context.Context, x *execution) error
+}
+
+type useStmt struct {
+	line  int
+	names []string // nil for all
+}
+
+func (s *useStmt) exec(ctx context.Context, x *execution) error {
+	if s.names == nil {
+		x.targets = x.all()
+		return nil
+	}
+
+	for _, name := range s.names {
+		if _, ok := x.Projectors[name]; !ok {
+			return &Error{Line: s.line, Err: fmt.Errorf("unknown projector %q", name)}
+		}
+	}
+	// The statement is shared by every run of the script
+	x.targets = slices.Clone(s.names)
+	return nil
+}
+
+type commandStmt struct {
+	line int
+	text string
+	do   func(conn *viewsonic.ViewSonic) error
+}
+
+func (s *commandStmt) exec(ctx context.Context, x *execution) error {
+	return x.each(s.line, func(name string, conn *viewsonic.ViewSonic) error {
+		if x.DryRun {
+			x.logf("dry run: line %d: %v: %v", s.line, name, s.text)
+			return nil
+		}
+		return s.do(conn)
+	})
+}
+
+// macroStmt plays a macro, either given inline or looked up by name when run.
+type macroStmt struct {
+	line  int
+	name  string
+	macro *viewsonic.Macro
+}
+
+func (s *macroStmt) exec(ctx context.Context, x *execution) error {
+	m := s.macro
+	if m == nil {
+		if m = x.Macros[s.name]; m == nil {
+			return &Error{Line: s.line, Err: fmt.Errorf("unknown macro %q", s.name)}
+		}
+	}
+
+	return x.each(s.line, func(name string, conn *viewsonic.ViewSonic) error {
+		if x.DryRun {
+			x.logf("dry run: line %d: %v: play %v (%v)", s.line, name, viewsonic.FormatMacroSequence(m.Steps), m.Duration())
+			return nil
+		}
+		return m.Play(ctx, conn)
+	})
+}
+
+type sleepStmt struct {
+	line int
+	d    time.Duration
+}
+
+func (s *sleepStmt) exec(ctx context.Context, x *execution) error {
+	if x.DryRun {
+		x.logf("dry run: line %d: wait %v", s.line, s.d)
+		return nil
+	}
+	return sleep(ctx, s.d)
+}
+
+type waitStmt struct {
+	line    int
+	text    string
+	cond    expr
+	timeout time.Duration
+}
+
+func (s *waitStmt) exec(ctx context.Context, x *execution) error {
+	interval := x.PollInterval
+	if interval <= 0 {
+		interval = time.Second
+	}
+	deadline := time.Now().Add(s.timeout)
+
+	for {
+		ok, err := x.check(s.line, s.cond)
+		if err != nil {
+			return err
+		}
+		if x.DryRun {
+			x.logf("dry run: line %d: %v, currently %v", s.line, s.text, ok)
+			return nil
+		}
+		if ok {
+			return nil
+		}
+		if time.Now().After(deadline) {
+			return &Error{Line: s.line, Err: fmt.Errorf("%v: %w after %v", s.text, ErrTimeout, s.timeout)}
+		}
+		if err := sleep(ctx, interval); err != nil {
+			return err
+		}
+	}
+}
+
+type ifStmt struct {
+	line int
+	cond expr
+	then []stmt
+	els  []stmt
+}
+
+func (s *ifStmt) exec(ctx context.Context, x *execution) error {
+	ok, err := x.check(s.line, s.cond)
+	if err != nil {
+		return err
+	}
+	if ok {
+		return x.block(ctx, s.then)
+	}
+	return x.block(ctx, s.els)
+}
+
+type tryStmt struct {
+	line int
+	body stmt
+}
+
+func (s *tryStmt) exec(ctx context.Context, x *execution) error {
+	err := s.body.exec(ctx, x)
+	if err != nil && ctx.Err() == nil {
+		x.logf("%v (ignored)", err)
+		return nil
+	}
+	return err
+}
+
+type logStmt struct {
+	line int
+	msg  string
+}
+
+func (s *logStmt) exec(ctx context.Context, x *execution) error {
+	x.logf("%v", s.msg)
+	return nil
+}
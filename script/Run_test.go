@@ -0,0 +1,56 @@
+package script
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/m-baertschi/viewsonic"
+)
+
+func TestUseDoesNotChangeScript(t *testing.T) {
+	a, b := viewsonic.New("127.0.0.1:1"), viewsonic.New("127.0.0.1:1")
+	defer a.Close()
+	defer b.Close()
+
+	var blanked []string
+	r := &Runner{
+		Projectors: map[string]*viewsonic.ViewSonic{"a": a, "b": b},
+		DryRun:     true,
+		Logf: func(format string, args ...any) {
+			line := fmt.Sprintf(format, args...)
+			if strings.HasSuffix(line, "blank on") {
+				blanked = append(blanked, line)
+			}
+		},
+	}
+	s, err := Parse("use b; blank on; use all; blank on")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []string{
+		"dry run: line 1: b: blank on",
+		"dry run: line 1: a: blank on",
+		"dry run: line 1: b: blank on",
+	}
+	for run := 1; run <= 2; run++ {
+		blanked = nil
+		if err := r.Run(context.Background(), s); err != nil {
+			t.Fatal(err)
+		}
+		if !slices.Equal(blanked, want) {
+			t.Errorf("run %d blanked %q, want %q", run, blanked, want)
+		}
+	}
+}
+
+func TestUseUnknownProjector(t *testing.T) {
+	r := &Runner{Projectors: map[string]*viewsonic.ViewSonic{}, DryRun: true}
+	err := r.RunString(context.Background(), "use hall")
+	if e, ok := err.(*Error); !ok || e.Line != 1 {
+		t.Errorf("use of an unknown projector: %v", err)
+	}
+}
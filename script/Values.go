@@ -0,0 +1,80 @@
+package script
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/m-baertschi/viewsonic"
+)
+
+// sourceAliases and colorModeAliases are short script names in addition to the value names
+// of the viewsonic package.
+var sourceAliases = map[string]viewsonic.SourceInput{
+	"mhl": viewsonic.SourceInputHDMIMHL4,
+	"lan": viewsonic.SourceInputLANWiFi,
+}
+
+var colorModeAliases = map[string]viewsonic.ColorMode{
+	"srgb": viewsonic.ColorModeSRGBViewMatch,
+}
+
+// enumValue resolves a case insensitive alias, or a name or number of the enum type.
+func enumValue[T ~int8](s string, parse func(string) (T, error), aliases map[string]T) (T, error) {
+	if v, ok := aliases[strings.ToLower(s)]; ok {
+		return v, nil
+	}
+	return parse(s)
+}
+
+func boolValue(s string) (bool, error) {
+	switch strings.ToLower(s) {
+	case "on", "true", "1":
+		return true, nil
+	case "off", "false", "0":
+		return false, nil
+	}
+	return false, fmt.Errorf("expected on or off, got %q", s)
+}
+
+// literal converts the right hand side of a comparison to the type of the read value.
+func literal(value any, s string) (any, error) {
+	switch value.(type) {
+	case bool:
+		return boolValue(s)
+	case viewsonic.ProjectorStatusValue:
+		return enumValue(s, viewsonic.ParseProjectorStatusValue, nil)
+	case viewsonic.SourceInput:
+		return enumValue(s, viewsonic.ParseSourceInput, sourceAliases)
+	case int8, float32:
+		return strconv.ParseFloat(s, 32)
+	}
+	return nil, fmt.Errorf("cannot compare %T", value)
+}
+
+// number returns the numeric value for ordered comparisons.
+func number(value any) (float64, bool) {
+	switch v := value.(type) {
+	case int8:
+		return float64(v), true
+	case float32:
+		return float64(v), true
+	case float64:
+		return v, true
+	}
+	return 0, false
+}
+
+// truthy is the value of a bare field in a condition.
+func truthy(value any) bool {
+	switch v := value.(type) {
+	case nil:
+		return false
+	case bool:
+		return v
+	case viewsonic.ProjectorStatusValue:
+		return v == viewsonic.ProjectorStatusPowerOn
+	}
+	f, ok := number(value)
+	return ok && f != 0
+}
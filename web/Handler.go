@@ -0,0 +1,28 @@
+// Package web is a self-contained control panel for the projectors, built on the
+// WebSocket API of package wsapi. All assets are embedded, no external resources are loaded.
+package web
+
+import (
+	"embed"
+	"io/fs"
+	"net/http"
+
+	"github.com/m-baertschi/viewsonic/wsapi"
+)
+
+//go:embed static
+var static embed.FS
+
+// Handler serves the control panel and the WebSocket API at "ws" below the same path.
+// Mount it with http.StripPrefix when serving it below the root.
+func Handler(api *wsapi.Server) http.Handler {
+	assets, err := fs.Sub(static, "static")
+	if err != nil {
+		panic(err)
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle("/", http.FileServerFS(assets))
+	mux.Handle("/ws", api)
+	return mux
+}
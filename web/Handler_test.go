@@ -0,0 +1,93 @@
+package web
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/websocket"
+	"github.com/m-baertschi/viewsonic"
+	"github.com/m-baertschi/viewsonic/emulator"
+	"github.com/m-baertschi/viewsonic/wsapi"
+)
+
+// TestPanelControls checks that the controls of the panel use the command and value names
+// of the WebSocket API, which hides the ones the model does not support.
+func TestPanelControls(t *testing.T) {
+	server := httptest.NewServer(Handler(wsapi.NewServer(nil)))
+	defer server.Close()
+
+	resp, err := http.Get(server.URL + "/")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	page, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	controls := wsapi.Controls(viewsonic.ModelV119)
+	for _, m := range regexp.MustCompile(`data-(?:toggle|select|range|step)="(\w+)"`).FindAllSubmatch(page, -1) {
+		if _, ok := controls[string(m[1])]; !ok {
+			t.Errorf("control %s is no command", m[1])
+		}
+	}
+	for _, m := range regexp.MustCompile(`data-key="(\w+)"`).FindAllSubmatch(page, -1) {
+		if !slices.Contains(controls["remoteKey"], string(m[1])) {
+			t.Errorf("key %s is no remote key", m[1])
+		}
+	}
+	source := page[strings.Index(string(page), `data-select="source"`):]
+	source = source[:strings.Index(string(source), "</select>")]
+	for _, m := range regexp.MustCompile(`value="(\w+)"`).FindAllSubmatch(source, -1) {
+		if !slices.Contains(controls["source"], string(m[1])) {
+			t.Errorf("source option %s is no source", m[1])
+		}
+	}
+}
+
+func TestStateListsModelControls(t *testing.T) {
+	e := emulator.New()
+	if err := e.Listen("127.0.0.1:0"); err != nil {
+		t.Fatal(err)
+	}
+	defer e.Close()
+	conn := viewsonic.New(e.Addr())
+	defer conn.Close()
+	conn.SetModel(viewsonic.ModelLS920WU)
+
+	api := wsapi.NewServer(map[string]*viewsonic.ViewSonic{"hall": conn})
+	server := httptest.NewServer(Handler(api))
+	defer server.Close()
+
+	ws, _, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(server.URL, "http")+"/ws", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ws.Close()
+	if err := ws.WriteJSON(wsapi.Message{Type: "subscribe"}); err != nil {
+		t.Fatal(err)
+	}
+	var msg wsapi.Message
+	if err := ws.ReadJSON(&msg); err != nil {
+		t.Fatal(err)
+	}
+
+	if msg.Type != "state" {
+		t.Fatalf("first message %+v, want the state", msg)
+	}
+	if want := []string{"HDMI1", "HDMI2", "USBC"}; !slices.Equal(msg.Controls["source"], want) {
+		t.Errorf("sources %q, want %q", msg.Controls["source"], want)
+	}
+	if slices.Contains(msg.Controls["colorMode"], "Dynamic") {
+		t.Errorf("color modes %q offer Dynamic", msg.Controls["colorMode"])
+	}
+	if _, ok := msg.Controls["freeze"]; !ok {
+		t.Errorf("controls %v without freeze", msg.Controls)
+	}
+}
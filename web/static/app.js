@@ -0,0 +1,163 @@
+"use strict";
+
+const statusNames = {PowerOff: "Power off", WarmUp: "Warm up", PowerOn: "Power on", CoolDown: "Cool down"};
+const projectors = new Map(); // name -> {element, state}
+let socket = null;
+let nextID = 1;
+const pending = new Map(); // command id -> projector name
+
+function connect() {
+	const url = new URL("ws", location.href);
+	url.protocol = url.protocol === "https:" ? "wss:" : "ws:";
+	socket = new WebSocket(url);
+
+	socket.onopen = () => {
+		setConnection(true);
+		socket.send(JSON.stringify({type: "subscribe"}));
+	};
+	socket.onclose = () => {
+		setConnection(false);
+		setTimeout(connect, 2000);
+	};
+	socket.onmessage = (event) => handle(JSON.parse(event.data));
+}
+
+function setConnection(online) {
+	const el = document.getElementById("connection");
+	el.textContent = online ? "online" : "offline";
+	el.className = online ? "online" : "offline";
+}
+
+function handle(msg) {
+	switch (msg.type) {
+	case "state":
+		projector(msg.projector).state = msg.state || {};
+		showControls(msg.projector, msg.controls);
+		render(msg.projector);
+		break;
+	case "change":
+		projector(msg.projector).state[msg.field] = msg.value;
+		render(msg.projector);
+		break;
+	case "result": {
+		const name = pending.get(msg.id) || msg.projector;
+		pending.delete(msg.id);
+		if (name && projectors.has(name)) {
+			showError(name, msg.error || "");
+		}
+		break;
+	}
+	}
+}
+
+function send(name, command, value) {
+	if (!socket || socket.readyState !== WebSocket.OPEN) {
+		showError(name, "not connected");
+		return;
+	}
+	const id = String(nextID++);
+	pending.set(id, name);
+	socket.send(JSON.stringify({type: "command", id, projector: name, command, value}));
+}
+
+function showError(name, error) {
+	projectors.get(name).element.querySelector(".error").textContent = error;
+}
+
+// projector returns the entry of a projector, creating its card on first use.
+function projector(name) {
+	let p = projectors.get(name);
+	if (p) {
+		return p;
+	}
+
+	const element = document.getElementById("projector").content.firstElementChild.cloneNode(true);
+	element.querySelector(".name").textContent = name;
+	p = {element, state: {}};
+	projectors.set(name, p);
+
+	element.querySelectorAll("[data-toggle]").forEach((button) => {
+		button.addEventListener("click", () => send(name, button.dataset.toggle, !p.state[button.dataset.toggle]));
+	});
+	element.querySelectorAll("[data-select]").forEach((select) => {
+		select.addEventListener("change", () => send(name, select.dataset.select, select.value));
+	});
+	element.querySelectorAll("[data-range]").forEach((input) => {
+		input.addEventListener("change", () => send(name, input.dataset.range, Number(input.value)));
+	});
+	element.querySelectorAll("[data-step]").forEach((button) => {
+		button.addEventListener("click", () => send(name, button.dataset.step, Number(button.dataset.value)));
+	});
+	element.querySelectorAll("[data-key]").forEach((button) => {
+		button.addEventListener("click", () => send(name, "remoteKey", button.dataset.key));
+	});
+
+	const container = document.getElementById("projectors");
+	const after = [...projectors.keys()].sort().find((other) => other > name);
+	container.insertBefore(element, after ? projectors.get(after).element : null);
+	return p;
+}
+
+// showControls hides the controls the model of the projector does not support. Without
+// controls the model is unknown and everything is shown.
+function showControls(name, controls) {
+	const {element} = projectors.get(name);
+	const supported = (command, value) => !controls ||
+		(command in controls && (value == null || !controls[command] || controls[command].includes(value)));
+
+	for (const kind of ["toggle", "select", "range", "step"]) {
+		element.querySelectorAll(`[data-${kind}]`).forEach((control) => {
+			control.hidden = !supported(control.dataset[kind]);
+			const container = control.closest(".steps, label");
+			if (container) {
+				container.hidden = control.hidden;
+			}
+		});
+	}
+	element.querySelectorAll("[data-select] option").forEach((option) => {
+		option.hidden = !supported(option.parentElement.dataset.select, option.value);
+	});
+	element.querySelectorAll("[data-key]").forEach((button) => {
+		button.hidden = !supported("remoteKey", button.dataset.key);
+	});
+	element.querySelectorAll("fieldset").forEach((fieldset) => {
+		fieldset.hidden = ![...fieldset.querySelectorAll("button, select, input")].some((control) => !control.hidden);
+	});
+}
+
+function render(name) {
+	const {element, state} = projectors.get(name);
+
+	const text = {
+		status: state.status == null ? "–" : statusNames[state.status] || String(state.status),
+		source: state.source == null ? "–" : sourceName(element, state.source),
+		volume: state.volume == null ? "–" : String(state.volume),
+		temp1: state.temp1 == null ? "–" : state.temp1.toFixed(1) + " °C",
+		temp2: state.temp2 == null ? "–" : state.temp2.toFixed(1) + " °C",
+	};
+	for (const [field, value] of Object.entries(text)) {
+		element.querySelector(`[data-field="${field}"]`).textContent = value;
+	}
+
+	element.querySelectorAll("[data-toggle]").forEach((button) => {
+		const value = state[button.dataset.toggle];
+		button.classList.toggle("active", value === true);
+		button.disabled = value == null && button.dataset.toggle !== "power";
+	});
+
+	const source = element.querySelector('[data-select="source"]');
+	if (state.source != null && document.activeElement !== source) {
+		source.value = state.source;
+	}
+	const volume = element.querySelector('[data-range="volume"]');
+	if (state.volume != null && document.activeElement !== volume) {
+		volume.value = String(state.volume);
+	}
+}
+
+function sourceName(element, value) {
+	const option = element.querySelector(`[data-select="source"] option[value="${value}"]`);
+	return option ? option.textContent : String(value);
+}
+
+connect();
@@ -0,0 +1,212 @@
+package wsapi
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/m-baertschi/viewsonic"
+)
+
+// Execute runs a named command with a JSON decoded value on the projector.
+//
+// Commands: power, blank, freeze, mute (bool), volume (number), source, colorMode,
+// colorTemperature, aspectRatio, lightSourceMode and remoteKey (enum value name or number),
+// brightness, contrast, keystoneVertical and keystoneHorizontal (step, positive
+// increases and negative decreases).
+func Execute(conn *viewsonic.ViewSonic, command string, value any) error {
+	switch command {
+	case "power":
+		on, err := toBool(value)
+		if err != nil {
+			return err
+		}
+		if on {
+			return conn.SetPower(viewsonic.PowerStateOn)
+		}
+		return conn.SetPower(viewsonic.PowerStateOff)
+	case "blank":
+		on, err := toBool(value)
+		if err != nil {
+			return err
+		}
+		return conn.SetBlank(on)
+	case "freeze":
+		on, err := toBool(value)
+		if err != nil {
+			return err
+		}
+		return conn.SetFreeze(on)
+	case "mute":
+		on, err := toBool(value)
+		if err != nil {
+			return err
+		}
+		return conn.SetMute(on)
+	case "volume":
+		level, err := toInt8(value)
+		if err != nil {
+			return err
+		}
+		return conn.SetVolume(level)
+	case "source":
+		v, err := toEnum(value, viewsonic.ParseSourceInput)
+		if err != nil {
+			return err
+		}
+		return conn.SetSourceInput(v)
+	case "colorMode":
+		v, err := toEnum(value, viewsonic.ParseColorMode)
+		if err != nil {
+			return err
+		}
+		return conn.SetColorMode(v)
+	case "colorTemperature":
+		v, err := toEnum(value, viewsonic.ParseColorTemperature)
+		if err != nil {
+			return err
+		}
+		return conn.SetColorTemperature(v)
+	case "aspectRatio":
+		v, err := toEnum(value, viewsonic.ParseAspectRatio)
+		if err != nil {
+			return err
+		}
+		return conn.SetAspectRatio(v)
+	case "lightSourceMode":
+		v, err := toEnum(value, viewsonic.ParseLightSourceMode)
+		if err != nil {
+			return err
+		}
+		return conn.SetLightSourceMode(v)
+	case "remoteKey":
+		v, err := toEnum(value, viewsonic.ParseRemoteKey)
+		if err != nil {
+			return err
+		}
+		return conn.SendRemoteKey(v)
+	case "brightness":
+		return step(value, conn.IncreaseBrightness, conn.DecreaseBrightness)
+	case "contrast":
+		return step(value, conn.IncreaseContrast, conn.DecreaseContrast)
+	case "keystoneVertical":
+		return step(value, conn.IncreaseKeystoneVertical, conn.DecreaseKeystoneVertical)
+	case "keystoneHorizontal":
+		return step(value, conn.IncreaseKeystoneHorizontal, conn.DecreaseKeystoneHorizontal)
+	}
+	return fmt.Errorf("unknown command %q", command)
+}
+
+// affected returns the state fields a command changes. Commands without state fields are
+// left to the polling loop.
+func affected(command string) []viewsonic.StateField {
+	switch command {
+	case "power":
+		return []viewsonic.StateField{viewsonic.StateFieldPower, viewsonic.StateFieldStatus}
+	case "blank":
+		return []viewsonic.StateField{viewsonic.StateFieldBlank}
+	case "freeze":
+		return []viewsonic.StateField{viewsonic.StateFieldFreeze}
+	case "mute":
+		return []viewsonic.StateField{viewsonic.StateFieldMute}
+	case "volume":
+		return []viewsonic.StateField{viewsonic.StateFieldVolume}
+	case "source":
+		return []viewsonic.StateField{viewsonic.StateFieldSource}
+	}
+	return nil
+}
+
+// commands maps the commands to the RS-232 command they send and whether they take the
+// named values of that command.
+var commands = map[string]struct {
+	name string
+	enum bool
+}{
+	"power":              {"Power", false},
+	"blank":              {"Blank", false},
+	"freeze":             {"Freeze", false},
+	"mute":               {"Mute", false},
+	"volume":             {"SetVolume", false},
+	"source":             {"SourceInput", true},
+	"colorMode":          {"ColorMode", true},
+	"colorTemperature":   {"ColorTemperature", true},
+	"aspectRatio":        {"AspectRatio", true},
+	"lightSourceMode":    {"LightSourceMode", true},
+	"remoteKey":          {"RemoteKey", true},
+	"brightness":         {"Brightness", false},
+	"contrast":           {"Contrast", false},
+	"keystoneVertical":   {"KeystoneVertical", false},
+	"keystoneHorizontal": {"KeystoneHorizontal", false},
+}
+
+// Controls returns the commands the model supports, with the value names the model accepts
+// for enum commands and nil for the others. A nil model returns nil, every command is sent.
+func Controls(m *viewsonic.Model) map[string][]string {
+	if m == nil {
+		return nil
+	}
+	controls := map[string][]string{}
+	for command, c := range commands {
+		cmd := viewsonic.CommandByName(c.name)
+		if !m.Supports(cmd.Code) {
+			continue
+		}
+		var names []string
+		if c.enum {
+			names = []string{}
+			for _, v := range cmd.Values {
+				if m.SupportsValue(cmd.Code, v.Value) {
+					names = append(names, v.Name)
+				}
+			}
+		}
+		controls[command] = names
+	}
+	return controls
+}
+
+// step calls increase or decrease once per unit of value.
+func step(value any, increase, decrease func() error) error {
+	n, err := toInt8(value)
+	if err != nil {
+		return err
+	}
+	// -128 has no positive int8
+	steps, fn := int(n), increase
+	if steps < 0 {
+		steps, fn = -steps, decrease
+	}
+	for range steps {
+		if err := fn(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func toBool(value any) (bool, error) {
+	switch v := value.(type) {
+	case bool:
+		return v, nil
+	case float64:
+		return v != 0, nil
+	}
+	return false, fmt.Errorf("expected a boolean, got %v", value)
+}
+
+// toEnum accepts the name of an enum value, e.g. "HDMI1", or its number.
+func toEnum[T ~int8](value any, parse func(string) (T, error)) (T, error) {
+	if s, ok := value.(string); ok {
+		return parse(s)
+	}
+	v, err := toInt8(value)
+	return T(v), err
+}
+
+func toInt8(value any) (int8, error) {
+	v, ok := value.(float64)
+	if !ok || v != math.Trunc(v) || v < math.MinInt8 || v > math.MaxInt8 {
+		return 0, fmt.Errorf("expected an integer, got %v", value)
+	}
+	return int8(v), nil
+}
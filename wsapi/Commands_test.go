@@ -0,0 +1,66 @@
+package wsapi
+
+import (
+	"testing"
+
+	"github.com/m-baertschi/viewsonic"
+	"github.com/m-baertschi/viewsonic/emulator"
+)
+
+func TestStep(t *testing.T) {
+	for _, tc := range []struct {
+		value              float64
+		increase, decrease int
+	}{
+		{3, 3, 0},
+		{0, 0, 0},
+		{-2, 0, 2},
+		{-128, 0, 128},
+		{127, 127, 0},
+	} {
+		increase, decrease := 0, 0
+		err := step(tc.value,
+			func() error { increase++; return nil },
+			func() error { decrease++; return nil })
+		if err != nil || increase != tc.increase || decrease != tc.decrease {
+			t.Errorf("step(%v) = %d increases, %d decreases, %v", tc.value, increase, decrease, err)
+		}
+	}
+}
+
+func TestCommandRefreshesAffectedFields(t *testing.T) {
+	e := emulator.New()
+	if err := e.Listen("127.0.0.1:0"); err != nil {
+		t.Fatal(err)
+	}
+	defer e.Close()
+	conn := viewsonic.New(e.Addr())
+	defer conn.Close()
+	poller := viewsonic.NewStatePoller(conn, "hall")
+
+	if err := Execute(conn, "blank", true); err != nil {
+		t.Fatal(err)
+	}
+	changes := poller.Poll(affected("blank")...)
+	if len(changes) != 1 || changes[0].Field != viewsonic.StateFieldBlank || changes[0].Value != true {
+		t.Errorf("changes %+v, want blank on only", changes)
+	}
+	if fields := affected("brightness"); fields != nil {
+		t.Errorf("brightness refreshes %v, want no state fields", fields)
+	}
+}
+
+func TestControls(t *testing.T) {
+	if controls := Controls(nil); controls != nil {
+		t.Errorf("controls without model %v, want nil", controls)
+	}
+	controls := Controls(viewsonic.ModelV119)
+	for command := range commands {
+		if _, ok := controls[command]; !ok {
+			t.Errorf("v1.19 without %s", command)
+		}
+	}
+	if names := controls["blank"]; names != nil {
+		t.Errorf("blank values %q, want nil", names)
+	}
+}
@@ -0,0 +1,275 @@
+// Package wsapi is a WebSocket API that pushes projector state changes as JSON
+// and accepts commands mapped to the ViewSonic setters.
+//
+// Client messages:
+//
+//	{"type": "subscribe", "projectors": ["hall"], "fields": ["power", "blank"]}
+//	{"type": "command", "id": "1", "projector": "hall", "command": "blank", "value": true}
+//
+// Empty projectors or fields subscribe to everything. A subscription replaces the
+// previous one and is answered with a "state" message per projector. If the projector
+// has a model, the state message lists its controls, see Controls.
+//
+// Server messages:
+//
+//	{"type": "state", "projector": "hall", "state": {"power": true, ...}, "controls": {"blank": null, "source": ["HDMI1", ...], ...}}
+//	{"type": "change", "projector": "hall", "field": "blank", "value": true, "time": "..."}
+//	{"type": "result", "id": "1", "error": ""}
+package wsapi
+
+import (
+	"context"
+	"log"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/m-baertschi/viewsonic"
+)
+
+// Message is sent in both directions, unused fields are omitted.
+type Message struct {
+	Type       string                       `json:"type"`
+	ID         string                       `json:"id,omitempty"`
+	Projector  string                       `json:"projector,omitempty"`
+	Projectors []string                     `json:"projectors,omitempty"`
+	Fields     []viewsonic.StateField       `json:"fields,omitempty"`
+	Field      viewsonic.StateField         `json:"field,omitempty"`
+	Command    string                       `json:"command,omitempty"`
+	Value      any                          `json:"value,omitempty"`
+	State      map[viewsonic.StateField]any `json:"state,omitempty"`
+	Controls   map[string][]string          `json:"controls,omitempty"`
+	Time       *time.Time                   `json:"time,omitempty"`
+	Error      string                       `json:"error,omitempty"`
+}
+
+type target struct {
+	conn   *viewsonic.ViewSonic
+	poller *viewsonic.StatePoller
+}
+
+// Server is an http.Handler serving the WebSocket API.
+type Server struct {
+	// Interval between two polls of each projector.
+	Interval time.Duration
+	// CheckOrigin is passed to the WebSocket upgrader, nil allows same origin only.
+	CheckOrigin func(r *http.Request) bool
+
+	targets map[string]*target
+}
+
+// NewServer creates a server for the projectors, keyed by name.
+func NewServer(projectors map[string]*viewsonic.ViewSonic) *Server {
+	s := &Server{
+		Interval: 2 * time.Second,
+		targets:  map[string]*target{},
+	}
+	for name, conn := range projectors {
+		s.targets[name] = &target{conn: conn, poller: viewsonic.NewStatePoller(conn, name)}
+	}
+	return s
+}
+
+// Run polls all projectors until the context is cancelled.
+func (s *Server) Run(ctx context.Context) {
+	var wg sync.WaitGroup
+	for _, t := range s.targets {
+		wg.Add(1)
+		go func(t *target) {
+			defer wg.Done()
+			t.poller.Run(ctx, s.Interval)
+		}(t)
+	}
+	wg.Wait()
+}
+
+// Poller returns the state poller of a projector, e.g. to read the state for other APIs.
+func (s *Server) Poller(name string) *viewsonic.StatePoller {
+	if t, ok := s.targets[name]; ok {
+		return t.poller
+	}
+	return nil
+}
+
+// ServeHTTP upgrades the request to a WebSocket connection.
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	upgrader := websocket.Upgrader{CheckOrigin: s.CheckOrigin}
+	ws, err := upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		return
+	}
+
+	c := &client{
+		server: s,
+		ws:     ws,
+		send:   make(chan Message, 64),
+		done:   make(chan struct{}),
+	}
+	go c.writeLoop()
+	c.readLoop()
+}
+
+// client is a single WebSocket connection.
+type client struct {
+	server *Server
+	ws     *websocket.Conn
+	send   chan Message
+	done   chan struct{}
+
+	mutex       sync.Mutex
+	unsubscribe []func()
+	fields      map[viewsonic.StateField]bool
+	closeOnce   sync.Once
+}
+
+func (c *client) close() {
+	c.closeOnce.Do(func() {
+		close(c.done)
+		c.ws.Close()
+	})
+}
+
+// push queues a message. Slow clients that fill their queue are disconnected.
+func (c *client) push(msg Message) {
+	select {
+	case c.send <- msg:
+	case <-c.done:
+	default:
+		log.Printf("wsapi: client %v too slow, closing", c.ws.RemoteAddr())
+		c.close()
+	}
+}
+
+func (c *client) writeLoop() {
+	ping := time.NewTicker(30 * time.Second)
+	defer ping.Stop()
+
+	for {
+		select {
+		case <-c.done:
+			return
+		case msg := <-c.send:
+			c.ws.SetWriteDeadline(time.Now().Add(10 * time.Second))
+			if err := c.ws.WriteJSON(msg); err != nil {
+				c.close()
+				return
+			}
+		case <-ping.C:
+			c.ws.SetWriteDeadline(time.Now().Add(10 * time.Second))
+			if err := c.ws.WriteMessage(websocket.PingMessage, nil); err != nil {
+				c.close()
+				return
+			}
+		}
+	}
+}
+
+func (c *client) readLoop() {
+	defer func() {
+		c.close()
+		c.subscribe(nil, nil) // only removes the subscriptions once closed
+	}()
+
+	c.ws.SetReadLimit(64 * 1024)
+	for {
+		var msg Message
+		if err := c.ws.ReadJSON(&msg); err != nil {
+			return
+		}
+
+		switch msg.Type {
+		case "subscribe":
+			c.subscribe(msg.Projectors, msg.Fields)
+		case "command":
+			// Commands may take a while on the serial link, do not block the connection
+			go c.command(msg)
+		default:
+			c.push(Message{Type: "result", ID: msg.ID, Error: "unknown message type " + msg.Type})
+		}
+	}
+}
+
+// subscribe replaces the subscription of the client. nil projectors and fields mean all.
+func (c *client) subscribe(projectors []string, fields []viewsonic.StateField) {
+	c.mutex.Lock()
+	for _, unsubscribe := range c.unsubscribe {
+		unsubscribe()
+	}
+	c.unsubscribe = nil
+	c.fields = nil
+	if len(fields) > 0 {
+		c.fields = map[viewsonic.StateField]bool{}
+		for _, f := range fields {
+			c.fields[f] = true
+		}
+	}
+	c.mutex.Unlock()
+
+	select {
+	case <-c.done:
+		return
+	default:
+	}
+
+	if len(projectors) == 0 {
+		for name := range c.server.targets {
+			projectors = append(projectors, name)
+		}
+	}
+
+	for _, name := range projectors {
+		t, ok := c.server.targets[name]
+		if !ok {
+			c.push(Message{Type: "result", Projector: name, Error: "unknown projector"})
+			continue
+		}
+
+		unsubscribe := t.poller.Subscribe(func(change viewsonic.StateChange) {
+			if !c.wants(change.Field) {
+				return
+			}
+			c.push(Message{
+				Type:      "change",
+				Projector: change.Projector,
+				Field:     change.Field,
+				Value:     change.Value,
+				Time:      &change.Time,
+			})
+		})
+
+		c.mutex.Lock()
+		c.unsubscribe = append(c.unsubscribe, unsubscribe)
+		c.mutex.Unlock()
+
+		state := t.poller.State()
+		for field := range state {
+			if !c.wants(field) {
+				delete(state, field)
+			}
+		}
+		c.push(Message{Type: "state", Projector: name, State: state, Controls: Controls(t.conn.Model())})
+	}
+}
+
+func (c *client) wants(field viewsonic.StateField) bool {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	return c.fields == nil || c.fields[field]
+}
+
+func (c *client) command(msg Message) {
+	result := Message{Type: "result", ID: msg.ID, Projector: msg.Projector}
+
+	// Clients take turns, so one sending a burst of commands does not hold up the others
+	t, ok := c.server.targets[msg.Projector]
+	if !ok {
+		result.Error = "unknown projector"
+	} else if err := Execute(t.conn.WithCaller(c.ws.RemoteAddr().String()), msg.Command, msg.Value); err != nil {
+		result.Error = err.Error()
+	} else if fields := affected(msg.Command); len(fields) > 0 {
+		// Push the new state right away instead of waiting for the next poll
+		t.poller.Poll(fields...)
+	}
+	c.push(result)
+}